@@ -0,0 +1,273 @@
+// Code generated by hertz generator.
+
+package cart
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/youperceive/cloudwego_instance/api/biz/handler/shared"
+	"github.com/youperceive/cloudwego_instance/api/biz/middleware"
+	"github.com/youperceive/cloudwego_instance/api/biz/model/base"
+	cart "github.com/youperceive/cloudwego_instance/api/biz/model/cart"
+	pkgCart "github.com/youperceive/cloudwego_instance/api/pkg/cart"
+	pkgCheckout "github.com/youperceive/cloudwego_instance/api/pkg/checkout"
+	pkgProduct "github.com/youperceive/cloudwego_instance/api/pkg/product"
+
+	order_k "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order"
+)
+
+var (
+	cartStore   *pkgCart.Store
+	cartService *pkgCart.Service
+)
+
+// Init 连接购物车 Redis 并创建购物车服务，main 在 shared.Init 之后调用
+func Init() {
+	cartStore = pkgCart.NewStoreFromEnv()
+	cartService = pkgCart.NewService(cartStore, shared.Orchestrator)
+}
+
+// errResp 把购物车的错误转换为 HTTP 状态码和 BaseResponse，未知错误记录日志后返回 Internal Error
+func errResp(method string, err error) (int, *base.BaseResponse) {
+	switch {
+	case errors.Is(err, pkgCart.ErrInvalidCount),
+		errors.Is(err, pkgCart.ErrCartFull),
+		errors.Is(err, pkgCart.ErrCountExceeded),
+		errors.Is(err, pkgCart.ErrEmptyCart),
+		errors.Is(err, pkgCheckout.ErrInvalidRequest):
+		return consts.StatusOK, &base.BaseResponse{Code: base.Code_INVALID_PARAM, Msg: err.Error()}
+	case errors.Is(err, pkgCart.ErrItemNotFound),
+		errors.Is(err, pkgProduct.ErrSkuNotFound):
+		return consts.StatusOK, &base.BaseResponse{Code: base.Code_NOT_FOUND, Msg: err.Error()}
+	case errors.Is(err, pkgCart.ErrCheckoutBusy):
+		return consts.StatusOK, &base.BaseResponse{Code: base.Code_SERVICE_ERR, Msg: err.Error()}
+	}
+	log.Printf("%s failed: %v", method, err)
+	return consts.StatusInternalServerError, &base.BaseResponse{Code: base.Code_SERVICE_ERR, Msg: "Internal Error"}
+}
+
+func toCartItem(l *pkgCart.Line) *cart.CartItem {
+	item := &cart.CartItem{
+		SkuID:     l.SkuID,
+		Count:     l.Count,
+		Available: l.Available(),
+		AddedAt:   l.AddedAt,
+	}
+	if l.Sku != nil {
+		item.ProductID = l.Sku.ProductID
+		item.MerchantID = l.Sku.MerchantID
+		item.SkuCode = l.Sku.SkuCode
+		item.Price = l.Sku.Price
+		item.Stock = int64(l.Sku.Stock - l.Sku.ReservedStock)
+	}
+	return item
+}
+
+// toCheckoutOrders 子订单按商户 id 升序，与父订单的 order_ids 一致
+func toCheckoutOrders(orders []*order_k.Order) []*cart.CartCheckoutOrder {
+	result := make([]*cart.CartCheckoutOrder, 0, len(orders))
+	for _, o := range orders {
+		co := &cart.CartCheckoutOrder{
+			MerchantID: o.RespUserId,
+			SkuIds:     make([]int64, 0, len(o.Items)),
+			OrderID:    o.Id,
+		}
+		for _, it := range o.Items {
+			co.SkuIds = append(co.SkuIds, it.SkuId)
+		}
+		result = append(result, co)
+	}
+	return result
+}
+
+// AddCartItem .
+// @router /cart_add [POST]
+func AddCartItem(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req cart.AddCartItemRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &cart.AddCartItemResponse{BaseResp: middleware.UserIDErrResp(err)})
+		return
+	}
+
+	count, err := cartService.Add(ctx, userID, req.SkuID, req.Count)
+	if err != nil {
+		status, baseResp := errResp("AddCartItem", err)
+		c.JSON(status, &cart.AddCartItemResponse{BaseResp: baseResp})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &cart.AddCartItemResponse{
+		BaseResp: middleware.SuccessResp(),
+		Count:    count,
+	})
+}
+
+// UpdateCartItem .
+// @router /cart_update [POST]
+func UpdateCartItem(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req cart.UpdateCartItemRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &cart.UpdateCartItemResponse{BaseResp: middleware.UserIDErrResp(err)})
+		return
+	}
+
+	if err := cartStore.Update(ctx, userID, req.SkuID, req.Count); err != nil {
+		status, baseResp := errResp("UpdateCartItem", err)
+		c.JSON(status, &cart.UpdateCartItemResponse{BaseResp: baseResp})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &cart.UpdateCartItemResponse{BaseResp: middleware.SuccessResp()})
+}
+
+// RemoveCartItem .
+// @router /cart_remove [POST]
+func RemoveCartItem(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req cart.RemoveCartItemRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &cart.RemoveCartItemResponse{BaseResp: middleware.UserIDErrResp(err)})
+		return
+	}
+
+	if err := cartStore.Remove(ctx, userID, req.SkuIds); err != nil {
+		status, baseResp := errResp("RemoveCartItem", err)
+		c.JSON(status, &cart.RemoveCartItemResponse{BaseResp: baseResp})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &cart.RemoveCartItemResponse{BaseResp: middleware.SuccessResp()})
+}
+
+// ListCart .
+// @router /cart_list [POST]
+func ListCart(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req cart.ListCartRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &cart.ListCartResponse{BaseResp: middleware.UserIDErrResp(err)})
+		return
+	}
+
+	lines, err := cartService.List(ctx, userID)
+	if err != nil {
+		status, baseResp := errResp("ListCart", err)
+		c.JSON(status, &cart.ListCartResponse{BaseResp: baseResp})
+		return
+	}
+
+	resp := &cart.ListCartResponse{
+		BaseResp: middleware.SuccessResp(),
+		Items:    make([]*cart.CartItem, 0, len(lines)),
+	}
+	for _, l := range lines {
+		item := toCartItem(l)
+		if item.Available {
+			resp.TotalAmount += item.Price * item.Count
+		}
+		resp.Items = append(resp.Items, item)
+	}
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ClearCart .
+// @router /cart_clear [POST]
+func ClearCart(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req cart.ClearCartRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &cart.ClearCartResponse{BaseResp: middleware.UserIDErrResp(err)})
+		return
+	}
+
+	if err := cartStore.Clear(ctx, userID); err != nil {
+		status, baseResp := errResp("ClearCart", err)
+		c.JSON(status, &cart.ClearCartResponse{BaseResp: baseResp})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &cart.ClearCartResponse{BaseResp: middleware.SuccessResp()})
+}
+
+// CartCheckout .
+// @router /cart_checkout [POST]
+func CartCheckout(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req cart.CartCheckoutRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &cart.CartCheckoutResponse{BaseResp: middleware.UserIDErrResp(err)})
+		return
+	}
+
+	saga, err := cartService.Checkout(ctx, userID, req.Type, req.SkuIds, req.Ext, req.GetAddressID())
+	if err != nil {
+		status, baseResp := errResp("CartCheckout", err)
+		resp := &cart.CartCheckoutResponse{BaseResp: baseResp}
+		if saga != nil {
+			resp.SagaID = saga.ID
+		}
+		c.JSON(status, resp)
+		return
+	}
+
+	resp := &cart.CartCheckoutResponse{
+		BaseResp: middleware.SuccessResp(),
+		SagaID:   saga.ID,
+	}
+	if saga.Status != pkgCheckout.StatusCompleted {
+		// 已补偿，返回触发补偿的原因（如库存不足），商品仍留在购物车中
+		resp.BaseResp = &base.BaseResponse{Code: base.Code_SERVICE_ERR, Msg: saga.Error}
+	} else {
+		resp.ParentOrderID = saga.OrderID
+		resp.Orders = toCheckoutOrders(saga.Orders)
+	}
+	c.JSON(consts.StatusOK, resp)
+}
@@ -0,0 +1,105 @@
+// Code generated by hertz generator.
+
+package checkout
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/youperceive/cloudwego_instance/api/biz/handler/shared"
+	"github.com/youperceive/cloudwego_instance/api/biz/middleware"
+	"github.com/youperceive/cloudwego_instance/api/biz/model/base"
+	checkout "github.com/youperceive/cloudwego_instance/api/biz/model/checkout"
+	pkgCheckout "github.com/youperceive/cloudwego_instance/api/pkg/checkout"
+)
+
+var orchestrator *pkgCheckout.Orchestrator
+
+// Init 使用共用的结算编排器，main 在 shared.Init 之后调用
+func Init() {
+	orchestrator = shared.Orchestrator
+}
+
+// Checkout .
+// @router /checkout [POST]
+func Checkout(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req checkout.CheckoutRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &checkout.CheckoutResponse{
+			BaseResp: middleware.UserIDErrResp(err),
+		})
+		return
+	}
+
+	items := make([]*pkgCheckout.Item, 0, len(req.Items))
+	for _, it := range req.Items {
+		if it == nil {
+			continue
+		}
+		items = append(items, &pkgCheckout.Item{
+			ProductID: it.ProductID,
+			SkuID:     it.SkuID,
+			Count:     it.Count,
+			Ext:       it.Ext,
+		})
+	}
+
+	saga, err := orchestrator.Checkout(ctx, &pkgCheckout.Request{
+		Type:       req.Type,
+		ReqUserID:  userID,
+		RespUserID: req.RespUserID,
+		Items:      items,
+		Ext:        req.Ext,
+		AddressID:  req.GetAddressID(),
+	})
+	if err != nil {
+		if errors.Is(err, pkgCheckout.ErrInvalidRequest) {
+			c.JSON(consts.StatusOK, &checkout.CheckoutResponse{
+				BaseResp: &base.BaseResponse{
+					Code: base.Code_INVALID_PARAM,
+					Msg:  err.Error(),
+				},
+			})
+			return
+		}
+		log.Printf("Checkout failed: %v", err)
+		resp := &checkout.CheckoutResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  "Internal Error",
+			},
+		}
+		if saga != nil {
+			resp.SagaID = saga.ID
+		}
+		c.JSON(consts.StatusInternalServerError, resp)
+		return
+	}
+
+	resp := &checkout.CheckoutResponse{
+		BaseResp: &base.BaseResponse{
+			Code: base.Code_SUCCESS,
+			Msg:  "success",
+		},
+		SagaID:  saga.ID,
+		OrderID: saga.OrderID,
+	}
+	if saga.Status != pkgCheckout.StatusCompleted {
+		// 已补偿，返回触发补偿的原因（如库存不足）
+		resp.BaseResp.Code = base.Code_SERVICE_ERR
+		resp.BaseResp.Msg = saga.Error
+		resp.OrderID = ""
+	}
+	c.JSON(consts.StatusOK, resp)
+}
@@ -0,0 +1,282 @@
+// Code generated by hertz generator.
+
+package groupbuy
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/youperceive/cloudwego_instance/api/biz/handler/shared"
+	"github.com/youperceive/cloudwego_instance/api/biz/middleware"
+	"github.com/youperceive/cloudwego_instance/api/biz/model/base"
+	groupbuy "github.com/youperceive/cloudwego_instance/api/biz/model/groupbuy"
+	pkgCheckout "github.com/youperceive/cloudwego_instance/api/pkg/checkout"
+	pkgGroupBuy "github.com/youperceive/cloudwego_instance/api/pkg/groupbuy"
+	pkgProduct "github.com/youperceive/cloudwego_instance/api/pkg/product"
+)
+
+// watchInterval 后台判定成团、处理成员订单的间隔
+const watchInterval = 10 * time.Second
+
+var groupBuyService *pkgGroupBuy.Service
+
+// Init 创建团购服务并启动成团判定，main 在 shared.Init 之后调用
+func Init() {
+	groupBuyService = pkgGroupBuy.NewService(pkgProduct.DB, shared.OrderClient, shared.Orchestrator)
+
+	go groupBuyService.RunWatcher(context.Background(), watchInterval)
+}
+
+// errResp 把团购的错误转换为 HTTP 状态码和 BaseResponse，未知错误记录日志后返回 Internal Error
+func errResp(method string, err error) (int, *base.BaseResponse) {
+	var orderErr *pkgGroupBuy.OrderFailedError
+	switch {
+	case errors.Is(err, pkgGroupBuy.ErrInvalidRequest),
+		errors.Is(err, pkgCheckout.ErrInvalidRequest),
+		errors.Is(err, pkgGroupBuy.ErrCampaignNotActive),
+		errors.Is(err, pkgGroupBuy.ErrGroupClosed),
+		errors.Is(err, pkgGroupBuy.ErrGroupFull),
+		errors.Is(err, pkgGroupBuy.ErrAlreadyJoined):
+		return consts.StatusOK, &base.BaseResponse{Code: base.Code_INVALID_PARAM, Msg: err.Error()}
+	case errors.Is(err, pkgGroupBuy.ErrCampaignNotFound),
+		errors.Is(err, pkgGroupBuy.ErrGroupNotFound):
+		return consts.StatusOK, &base.BaseResponse{Code: base.Code_NOT_FOUND, Msg: err.Error()}
+	case errors.As(err, &orderErr):
+		// 已补偿，返回触发补偿的原因（如库存不足）
+		return consts.StatusOK, &base.BaseResponse{Code: base.Code_SERVICE_ERR, Msg: orderErr.Reason}
+	}
+	log.Printf("%s failed: %v", method, err)
+	return consts.StatusInternalServerError, &base.BaseResponse{Code: base.Code_SERVICE_ERR, Msg: "Internal Error"}
+}
+
+func toCampaign(c *pkgGroupBuy.Campaign) *groupbuy.GroupCampaign {
+	return &groupbuy.GroupCampaign{
+		ID:         c.ID,
+		MerchantID: c.MerchantID,
+		ProductID:  c.ProductID,
+		SkuID:      c.SkuID,
+		GroupSize:  c.GroupSize,
+		GroupTTL:   c.GroupTTL,
+		StartAt:    c.StartAt,
+		EndAt:      c.EndAt,
+		CreatedAt:  c.CreatedAt,
+	}
+}
+
+func toGroup(g *pkgGroupBuy.Group) *groupbuy.Group {
+	res := &groupbuy.Group{
+		ID:           g.ID,
+		CampaignID:   g.CampaignID,
+		LeaderUserID: g.LeaderUserID,
+		Status:       g.Status,
+		GroupSize:    g.GroupSize,
+		Joined:       g.Joined,
+		Paid:         g.Paid,
+		Deadline:     g.Deadline,
+		CreatedAt:    g.CreatedAt,
+	}
+	for _, m := range g.Members {
+		res.Members = append(res.Members, &groupbuy.GroupMember{
+			UserID:   m.UserID,
+			OrderID:  m.OrderID,
+			Paid:     m.Paid,
+			JoinedAt: m.CreatedAt,
+		})
+	}
+	return res
+}
+
+// toOrderResp 下单未结束时 res 不为空，带上 saga_id 便于排查
+func toOrderResp(method string, res *pkgGroupBuy.JoinResult, err error) (int, *groupbuy.GroupOrderResponse) {
+	if err != nil {
+		status, baseResp := errResp(method, err)
+		resp := &groupbuy.GroupOrderResponse{BaseResp: baseResp}
+		var orderErr *pkgGroupBuy.OrderFailedError
+		switch {
+		case errors.As(err, &orderErr):
+			resp.GroupID, resp.SagaID = orderErr.GroupID, orderErr.SagaID
+		case res != nil:
+			resp.GroupID, resp.SagaID = res.GroupID, res.SagaID
+		}
+		return status, resp
+	}
+	return consts.StatusOK, &groupbuy.GroupOrderResponse{
+		BaseResp: middleware.SuccessResp(),
+		GroupID:  res.GroupID,
+		OrderID:  res.OrderID,
+		SagaID:   res.SagaID,
+	}
+}
+
+// CreateGroupCampaign .
+// @router /group_campaign_create [POST]
+func CreateGroupCampaign(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req groupbuy.CreateGroupCampaignRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &groupbuy.CreateGroupCampaignResponse{BaseResp: middleware.UserIDErrResp(err)})
+		return
+	}
+
+	// 绑定时不会填充 IDL 默认值，GroupTTL 为 0 时由 pkg 层使用默认值
+	campaign, err := groupBuyService.CreateCampaign(ctx, userID, &pkgGroupBuy.Campaign{
+		SkuID:     req.SkuID,
+		GroupSize: req.GroupSize,
+		GroupTTL:  req.GroupTTL,
+		StartAt:   req.StartAt,
+		EndAt:     req.EndAt,
+	})
+	if err != nil {
+		status, baseResp := errResp("CreateGroupCampaign", err)
+		c.JSON(status, &groupbuy.CreateGroupCampaignResponse{BaseResp: baseResp})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &groupbuy.CreateGroupCampaignResponse{
+		BaseResp: middleware.SuccessResp(),
+		Campaign: toCampaign(campaign),
+	})
+}
+
+// ListGroupCampaign .
+// @router /group_campaign_list [POST]
+func ListGroupCampaign(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req groupbuy.ListGroupCampaignRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	campaigns, err := groupBuyService.ListCampaigns(ctx, req.GetMerchantID())
+	if err != nil {
+		status, baseResp := errResp("ListGroupCampaign", err)
+		c.JSON(status, &groupbuy.ListGroupCampaignResponse{BaseResp: baseResp})
+		return
+	}
+
+	resp := &groupbuy.ListGroupCampaignResponse{
+		BaseResp:  middleware.SuccessResp(),
+		Campaigns: make([]*groupbuy.GroupCampaign, 0, len(campaigns)),
+	}
+	for _, campaign := range campaigns {
+		resp.Campaigns = append(resp.Campaigns, toCampaign(campaign))
+	}
+	c.JSON(consts.StatusOK, resp)
+}
+
+// OpenGroup .
+// @router /group_open [POST]
+func OpenGroup(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req groupbuy.OpenGroupRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &groupbuy.GroupOrderResponse{BaseResp: middleware.UserIDErrResp(err)})
+		return
+	}
+
+	// 绑定时不会填充 IDL 默认值
+	count := req.Count
+	if count == 0 {
+		count = 1
+	}
+	res, err := groupBuyService.Open(ctx, userID, req.CampaignID, count, req.Ext)
+	c.JSON(toOrderResp("OpenGroup", res, err))
+}
+
+// JoinGroup .
+// @router /group_join [POST]
+func JoinGroup(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req groupbuy.JoinGroupRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &groupbuy.GroupOrderResponse{BaseResp: middleware.UserIDErrResp(err)})
+		return
+	}
+
+	// 绑定时不会填充 IDL 默认值
+	count := req.Count
+	if count == 0 {
+		count = 1
+	}
+	res, err := groupBuyService.Join(ctx, userID, req.GroupID, count, req.Ext)
+	c.JSON(toOrderResp("JoinGroup", res, err))
+}
+
+// ListGroup .
+// @router /group_list [POST]
+func ListGroup(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req groupbuy.ListGroupRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	groups, err := groupBuyService.ListGroups(ctx, req.CampaignID)
+	if err != nil {
+		status, baseResp := errResp("ListGroup", err)
+		c.JSON(status, &groupbuy.ListGroupResponse{BaseResp: baseResp})
+		return
+	}
+
+	resp := &groupbuy.ListGroupResponse{
+		BaseResp: middleware.SuccessResp(),
+		Groups:   make([]*groupbuy.Group, 0, len(groups)),
+	}
+	for _, g := range groups {
+		resp.Groups = append(resp.Groups, toGroup(g))
+	}
+	c.JSON(consts.StatusOK, resp)
+}
+
+// QueryGroup .
+// @router /group_query [POST]
+func QueryGroup(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req groupbuy.QueryGroupRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	g, err := groupBuyService.Query(ctx, req.GroupID)
+	if err != nil {
+		status, baseResp := errResp("QueryGroup", err)
+		c.JSON(status, &groupbuy.QueryGroupResponse{BaseResp: baseResp})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &groupbuy.QueryGroupResponse{
+		BaseResp: middleware.SuccessResp(),
+		Group:    toGroup(g),
+	})
+}
@@ -0,0 +1,179 @@
+package order
+
+import (
+	"github.com/youperceive/cloudwego_instance/api/biz/model/base"
+	order "github.com/youperceive/cloudwego_instance/api/biz/model/order"
+
+	base_k "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/base"
+	order_k "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order"
+)
+
+// toBaseResp 透传订单服务的业务错误码，让前端能区分参数错误、状态流转被拒等情况
+func toBaseResp(b *base_k.BaseResponse) *base.BaseResponse {
+	if b == nil {
+		return &base.BaseResponse{
+			Code: base.Code_SERVICE_ERR,
+			Msg:  "Internal Error",
+		}
+	}
+	return &base.BaseResponse{
+		Code: base.Code(b.Code),
+		Msg:  b.Msg,
+	}
+}
+
+func toOrder(o *order_k.Order) *order.Order {
+	if o == nil {
+		return nil
+	}
+
+	var items []*order.OrderItem
+	for _, it := range o.Items {
+		items = append(items, &order.OrderItem{
+			ID:        it.Id,
+			OrderID:   it.OrderId,
+			ProductID: it.ProductId,
+			SkuID:     it.SkuId,
+			Count:     it.Count,
+			Price:     it.Price,
+			Ext:       it.Ext,
+			Subtotal:  it.Subtotal,
+		})
+	}
+
+	var history []*order.StatusRecord
+	for _, r := range o.StatusHistory {
+		history = append(history, &order.StatusRecord{
+			From:      r.From,
+			To:        r.To,
+			ChangedAt: r.ChangedAt,
+		})
+	}
+
+	var refunds []*order.Refund
+	for _, r := range o.Refunds {
+		refunds = append(refunds, toRefund(r))
+	}
+
+	var shipments []*order.Shipment
+	for _, sh := range o.Shipments {
+		shipments = append(shipments, toShipment(sh))
+	}
+
+	return &order.Order{
+		ID:              o.Id,
+		Type:            o.Type,
+		Status:          o.Status,
+		ReqUserID:       o.ReqUserId,
+		RespUserID:      o.RespUserId,
+		Items:           items,
+		CreatedAt:       o.CreatedAt,
+		UpdatedAt:       o.UpdatedAt,
+		Ext:             o.Ext,
+		StatusHistory:   history,
+		Version:         o.Version,
+		TotalAmount:     o.TotalAmount,
+		PayDeadline:     o.PayDeadline,
+		Refunds:         refunds,
+		RefundedAmount:  o.RefundedAmount,
+		IdempotencyKey:  o.IdempotencyKey,
+		ParentID:        o.ParentId,
+		Shipments:       shipments,
+		AutoConfirmAt:   o.AutoConfirmAt,
+		ShippingAddress: toShippingAddress(o.ShippingAddress),
+	}
+}
+
+func toParentOrder(p *order_k.ParentOrder) *order.ParentOrder {
+	if p == nil {
+		return nil
+	}
+	return &order.ParentOrder{
+		ID:          p.Id,
+		ReqUserID:   p.ReqUserId,
+		Status:      p.Status,
+		TotalAmount: p.TotalAmount,
+		OrderIds:    p.OrderIds,
+		CreatedAt:   p.CreatedAt,
+	}
+}
+
+func toRefund(r *order_k.Refund) *order.Refund {
+	if r == nil {
+		return nil
+	}
+
+	var items []*order.RefundItem
+	for _, it := range r.Items {
+		items = append(items, &order.RefundItem{
+			ItemID:    it.ItemId,
+			ProductID: it.ProductId,
+			SkuID:     it.SkuId,
+			Count:     it.Count,
+		})
+	}
+
+	return &order.Refund{
+		ID:           r.Id,
+		Items:        items,
+		Amount:       r.Amount,
+		Status:       order.RefundStatus(r.Status),
+		Reason:       r.Reason,
+		RejectReason: r.RejectReason,
+		CreatedAt:    r.CreatedAt,
+		ReviewedAt:   r.ReviewedAt,
+		CompletedAt:  r.CompletedAt,
+	}
+}
+
+func toShipment(sh *order_k.Shipment) *order.Shipment {
+	if sh == nil {
+		return nil
+	}
+
+	var items []*order.ShipmentItem
+	for _, it := range sh.Items {
+		items = append(items, &order.ShipmentItem{
+			ItemID:    it.ItemId,
+			ProductID: it.ProductId,
+			SkuID:     it.SkuId,
+			Count:     it.Count,
+		})
+	}
+
+	var events []*order.TrackingEvent
+	for _, e := range sh.Events {
+		events = append(events, &order.TrackingEvent{
+			OccurredAt:  e.OccurredAt,
+			Description: e.Description,
+			Location:    e.Location,
+			CreatedAt:   e.CreatedAt,
+		})
+	}
+
+	return &order.Shipment{
+		ID:          sh.Id,
+		Carrier:     sh.Carrier,
+		TrackingNo:  sh.TrackingNo,
+		Items:       items,
+		Status:      order.ShipmentStatus(sh.Status),
+		Events:      events,
+		ShippedAt:   sh.ShippedAt,
+		DeliveredAt: sh.DeliveredAt,
+	}
+}
+
+func toShippingAddress(a *order_k.ShippingAddress) *order.ShippingAddress {
+	if a == nil {
+		return nil
+	}
+	return &order.ShippingAddress{
+		AddressID:    a.AddressId,
+		ReceiverName: a.ReceiverName,
+		Phone:        a.Phone,
+		Province:     a.Province,
+		City:         a.City,
+		District:     a.District,
+		Detail:       a.Detail,
+	}
+}
@@ -4,31 +4,32 @@ package order
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
-	"os"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
-	"github.com/cloudwego/kitex/client"
+	"github.com/youperceive/cloudwego_instance/api/biz/handler/shared"
 	"github.com/youperceive/cloudwego_instance/api/biz/middleware"
 	"github.com/youperceive/cloudwego_instance/api/biz/model/base"
 	order "github.com/youperceive/cloudwego_instance/api/biz/model/order"
+	pkgCheckout "github.com/youperceive/cloudwego_instance/api/pkg/checkout"
+	pkgGroupBuy "github.com/youperceive/cloudwego_instance/api/pkg/groupbuy"
+	pkgProduct "github.com/youperceive/cloudwego_instance/api/pkg/product"
+	pkgSeckill "github.com/youperceive/cloudwego_instance/api/pkg/seckill"
 
+	base_k "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/base"
 	order_k "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order"
 	order_service_k "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order/orderservice"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/status"
 )
 
 var orderServiceClient order_service_k.Client
 
-func init() {
-	cli, err := order_service_k.NewClient(
-		"order_service",
-		client.WithHostPorts(os.Getenv("order_service_addr")),
-	)
-	if err != nil {
-		log.Fatal(err)
-	}
-	orderServiceClient = cli
+// Init 使用共用的订单服务客户端，main 在 shared.Init 之后调用
+func Init() {
+	orderServiceClient = shared.OrderClient
 }
 
 // Create .
@@ -42,23 +43,10 @@ func Create(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	jwtUserID, exist := c.Get(middleware.UserIDKey)
-	if !exist {
-		c.JSON(consts.StatusInternalServerError, &order.CreateResponse{
-			BaseResp: &base.BaseResponse{
-				Code: base.Code_SERVICE_ERR,
-				Msg:  "token.userId 不存在. Internal Error",
-			},
-		})
-		return
-	}
-	userID, ok := jwtUserID.(int64)
-	if !ok {
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
 		c.JSON(consts.StatusInternalServerError, &order.CreateResponse{
-			BaseResp: &base.BaseResponse{
-				Code: base.Code_SERVICE_ERR,
-				Msg:  "token.userId 解析失败. Internal Error",
-			},
+			BaseResp: middleware.UserIDErrResp(err),
 		})
 		return
 	}
@@ -75,12 +63,14 @@ func Create(ctx context.Context, c *app.RequestContext) {
 	}
 
 	reqK := &order_k.CreateRequest{
-		Type:       req.Type,
-		Status:     req.Status,
-		ReqUserId:  userID,
-		RespUserId: req.RespUserID,
-		Items:      items,
-		Ext:        req.Ext,
+		Type:           req.Type,
+		Status:         req.Status,
+		ReqUserId:      userID,
+		RespUserId:     req.RespUserID,
+		Items:          items,
+		Ext:            req.Ext,
+		IdempotencyKey: req.IdempotencyKey,
+		AddressId:      req.AddressID,
 	}
 	respK, err := orderServiceClient.Create(ctx, reqK)
 	if err != nil {
@@ -95,11 +85,8 @@ func Create(ctx context.Context, c *app.RequestContext) {
 	}
 
 	c.JSON(consts.StatusOK, &order.CreateResponse{
-		BaseResp: &base.BaseResponse{
-			Code: base.Code_SUCCESS,
-			Msg:  "Success",
-		},
-		OrderID: respK.OrderId,
+		BaseResp: toBaseResp(respK.BaseResp),
+		OrderID:  respK.OrderId,
 	})
 }
 
@@ -114,10 +101,98 @@ func Update(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	// 订单只能由支付回调推进为已支付（见 pkg/payment），对外接口不接受
+	if req.Status != nil && *req.Status == status.Paid {
+		c.JSON(consts.StatusOK, &order.UpdateResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  "订单需通过 /create_payment 支付，不能直接修改为已支付",
+			},
+		})
+		return
+	}
+	// 团购订单由团购模块在成团后推进（见 pkg/groupbuy）
+	if req.Status != nil && *req.Status == status.Confirmed {
+		c.JSON(consts.StatusOK, &order.UpdateResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  "团购订单成团后自动变为已成团，不能直接修改",
+			},
+		})
+		return
+	}
+	// 订单由买家确认收货（或超时自动确认）后完成
+	if req.Status != nil && *req.Status == status.Completed {
+		c.JSON(consts.StatusOK, &order.UpdateResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  "订单需通过 /confirm_delivery 确认收货后完成，不能直接修改为已完成",
+			},
+		})
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &order.UpdateResponse{
+			BaseResp: middleware.UserIDErrResp(err),
+		})
+		return
+	}
+	infoK, err := orderServiceClient.QueryOrderInfo(ctx, &order_k.QueryOrderInfoRequest{Id: req.ID})
+	if err != nil {
+		log.Println(err.Error())
+		c.JSON(consts.StatusInternalServerError, &order.UpdateResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  "Internal Error",
+			},
+		})
+		return
+	}
+	// 只有买家能修改自己的订单，他人的订单按不存在处理
+	o := infoK.Order
+	if infoK.BaseResp == nil || infoK.BaseResp.Code != base_k.Code_SUCCESS || o == nil || o.ReqUserId != userID {
+		c.JSON(consts.StatusOK, &order.UpdateResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_NOT_FOUND,
+				Msg:  "订单不存在",
+			},
+		})
+		return
+	}
+	// 买家只能取消待支付的订单，支付、完成等状态由对应的业务流程推进
+	if req.Status != nil && (*req.Status != status.Cancelled || o.Status != status.PendingPayment) {
+		c.JSON(consts.StatusOK, &order.UpdateResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_STATUS_TRANSITION,
+				Msg:  "只能取消待支付的订单",
+			},
+		})
+		return
+	}
+	if key := reservedExtWrite(&req, o.Ext); key != "" {
+		c.JSON(consts.StatusOK, &order.UpdateResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  fmt.Sprintf("扩展字段 %s 由系统维护，不能修改", key),
+			},
+		})
+		return
+	}
+	// 未指定版本号时按刚读到的版本写入，避免订单在校验之后被支付
+	expectedVersion := req.ExpectedVersion
+	if expectedVersion == nil {
+		expectedVersion = &o.Version
+	}
+
 	reqK := &order_k.UpdateRequest{
-		Id:     req.ID,
-		Status: req.Status,
-		Ext:    req.Ext,
+		Id:              req.ID,
+		Status:          req.Status,
+		Ext:             req.Ext,
+		ExpectedVersion: expectedVersion,
+		ExtMode:         order_k.ExtUpdateMode(req.ExtMode),
+		ExtDeleteKeys:   req.ExtDeleteKeys,
 	}
 	respK, err := orderServiceClient.Update(ctx, reqK)
 	if err != nil {
@@ -131,14 +206,51 @@ func Update(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	if req.Status != nil && respK.BaseResp != nil && respK.BaseResp.Code == base_k.Code_SUCCESS {
+		settleReservation(ctx, req.ID, *req.Status)
+	}
+
 	c.JSON(consts.StatusOK, &order.UpdateResponse{
-		BaseResp: &base.BaseResponse{
-			Code: base.Code_SUCCESS,
-			Msg:  "Success",
-		},
+		BaseResp: toBaseResp(respK.BaseResp),
 	})
 }
 
+// reservedExtKeys 结算、秒杀、团购流程写入订单 ext 的字段，流程据此找回订单、统计名额，买家不能修改
+var reservedExtKeys = []string{
+	pkgCheckout.ExtSagaKey,
+	pkgSeckill.ExtCampaignKey,
+	pkgGroupBuy.ExtGroupKey,
+	pkgGroupBuy.ExtCampaignKey,
+}
+
+// reservedExtWrite 返回请求会改写（设置、删除或整体替换掉）的第一个保留字段，没有时返回空串
+func reservedExtWrite(req *order.UpdateRequest, current map[string]string) string {
+	deleted := make(map[string]bool, len(req.ExtDeleteKeys))
+	for _, k := range req.ExtDeleteKeys {
+		deleted[k] = true
+	}
+	for _, k := range reservedExtKeys {
+		_, set := req.Ext[k]
+		_, has := current[k]
+		if set || deleted[k] || (req.ExtMode == order.ExtUpdateMode_REPLACE && has) {
+			return k
+		}
+	}
+	return ""
+}
+
+// settleReservation 订单取消后释放库存预占（支付后的确认由 pkg/payment 完成）。
+// 不是通过结算流程创建的订单没有预占记录；失败时只记录日志，未释放的预占过期后会被释放。
+func settleReservation(ctx context.Context, orderID string, to int32) {
+	if to != status.Cancelled {
+		return
+	}
+	err := pkgProduct.ReleaseReservation(ctx, orderID)
+	if err != nil && !errors.Is(err, pkgProduct.ErrReservationNotFound) {
+		log.Printf("order %s: release stock reservation failed: %v", orderID, err)
+	}
+}
+
 // QueryOrderInfo .
 // @router /query_order_info [POST]
 func QueryOrderInfo(ctx context.Context, c *app.RequestContext) {
@@ -165,35 +277,9 @@ func QueryOrderInfo(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	var items []*order.OrderItem
-	for _, it := range respK.Order.Items {
-		items = append(items, &order.OrderItem{
-			ProductID: it.ProductId,
-			SkuID:     it.SkuId,
-			Count:     it.Count,
-			Price:     it.Price,
-			Ext:       it.Ext,
-		})
-	}
-
-	respOrder := order.Order{
-		ID:         respK.Order.Id,
-		Type:       respK.Order.Type,
-		Status:     respK.Order.Status,
-		ReqUserID:  respK.Order.ReqUserId,
-		RespUserID: respK.Order.RespUserId,
-		Items:      items,
-		CreatedAt:  respK.Order.CreatedAt,
-		UpdatedAt:  respK.Order.UpdatedAt,
-		Ext:        respK.Order.Ext,
-	}
-
 	c.JSON(consts.StatusOK, &order.QueryOrderInfoResponse{
-		BaseResp: &base.BaseResponse{
-			Code: base.Code_SUCCESS,
-			Msg:  "Success",
-		},
-		Order: &respOrder,
+		BaseResp: toBaseResp(respK.BaseResp),
+		Order:    toOrder(respK.Order),
 	})
 }
 
@@ -239,3 +325,713 @@ func QueryOrderId(ctx context.Context, c *app.RequestContext) {
 		PageSize: respK.PageSize,
 	})
 }
+
+// SearchOrders .
+// @router /search_orders [POST]
+func SearchOrders(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.SearchOrdersRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &order.SearchOrdersResponse{
+			BaseResp: middleware.UserIDErrResp(err),
+		})
+		return
+	}
+	// 只能查询自己作为买家或商户的订单，都不指定时查询自己买到的订单
+	switch {
+	case req.ReqUserID == nil && req.RespUserID == nil:
+		req.ReqUserID = &userID
+	case req.ReqUserID != nil && *req.ReqUserID == userID,
+		req.RespUserID != nil && *req.RespUserID == userID:
+	default:
+		c.JSON(consts.StatusOK, &order.SearchOrdersResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  "只能查询自己作为买家或商户的订单",
+			},
+		})
+		return
+	}
+
+	// 绑定时不会填充 IDL 默认值，未传时在这里补上
+	reqK := order_k.NewSearchOrdersRequest()
+	reqK.Statuses = req.Statuses
+	reqK.Type = req.Type
+	reqK.ReqUserId = req.ReqUserID
+	reqK.RespUserId = req.RespUserID
+	reqK.CreatedFrom = req.CreatedFrom
+	reqK.CreatedTo = req.CreatedTo
+	reqK.UpdatedFrom = req.UpdatedFrom
+	reqK.UpdatedTo = req.UpdatedTo
+	reqK.ProductId = req.ProductID
+	reqK.SkuId = req.SkuID
+	reqK.Ext = req.Ext
+	if req.SortBy != 0 {
+		reqK.SortBy = order_k.SearchSortField(req.SortBy)
+	}
+	reqK.Ascending = req.Ascending
+	reqK.Cursor = req.Cursor
+	if req.Limit != 0 {
+		reqK.Limit = req.Limit
+	}
+	reqK.IncludeOrders = req.IncludeOrders
+	reqK.Fields = req.Fields
+	reqK.ParentId = req.ParentID
+
+	respK, err := orderServiceClient.SearchOrders(ctx, reqK)
+	if err != nil {
+		log.Println(err.Error())
+		c.JSON(consts.StatusInternalServerError, &order.SearchOrdersResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  "Internal Error",
+			},
+		})
+		return
+	}
+
+	resp := &order.SearchOrdersResponse{
+		BaseResp:   toBaseResp(respK.BaseResp),
+		OrderIds:   respK.OrderIds,
+		NextCursor: respK.NextCursor,
+	}
+	for _, o := range respK.Orders {
+		resp.Orders = append(resp.Orders, toOrder(o))
+	}
+	c.JSON(consts.StatusOK, resp)
+}
+
+// BatchQueryOrderInfo .
+// @router /batch_query_order_info [POST]
+func BatchQueryOrderInfo(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.BatchQueryOrderInfoRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &order.BatchQueryOrderInfoResponse{
+			BaseResp: middleware.UserIDErrResp(err),
+		})
+		return
+	}
+
+	respK, err := orderServiceClient.BatchQueryOrderInfo(ctx, &order_k.BatchQueryOrderInfoRequest{
+		Ids:    req.Ids,
+		Fields: withOwnerFields(req.Fields),
+	})
+	if err != nil {
+		log.Println(err.Error())
+		c.JSON(consts.StatusInternalServerError, &order.BatchQueryOrderInfoResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  "Internal Error",
+			},
+		})
+		return
+	}
+
+	resp := &order.BatchQueryOrderInfoResponse{
+		BaseResp:    toBaseResp(respK.BaseResp),
+		NotFoundIds: respK.NotFoundIds,
+	}
+	// 不属于当前用户的订单按未找到处理，不暴露其是否存在
+	for _, o := range respK.Orders {
+		if !ownsOrder(o, userID) {
+			resp.NotFoundIds = append(resp.NotFoundIds, o.Id)
+			continue
+		}
+		resp.Orders = append(resp.Orders, toOrder(o))
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ownsOrder 用户是订单的买家或商户
+func ownsOrder(o *order_k.Order, userID int64) bool {
+	return o.ReqUserId == userID || o.RespUserId == userID
+}
+
+// withOwnerFields 指定了返回字段时补上买家和商户，用于校验订单归属
+func withOwnerFields(fields []string) []string {
+	if len(fields) == 0 {
+		return nil
+	}
+	return append(fields[:len(fields):len(fields)], "req_user_id", "resp_user_id")
+}
+
+// RequestRefund .
+// @router /request_refund [POST]
+func RequestRefund(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.RequestRefundRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &order.RequestRefundResponse{
+			BaseResp: middleware.UserIDErrResp(err),
+		})
+		return
+	}
+
+	var items []*order_k.RefundItemForRequest
+	for _, it := range req.Items {
+		items = append(items, &order_k.RefundItemForRequest{
+			ItemId: it.ItemID,
+			Count:  it.Count,
+		})
+	}
+
+	// 申请人以 token 中的用户为准
+	respK, err := orderServiceClient.RequestRefund(ctx, &order_k.RequestRefundRequest{
+		OrderId:   req.OrderID,
+		ReqUserId: userID,
+		Items:     items,
+		Amount:    req.Amount,
+		Reason:    req.Reason,
+	})
+	if err != nil {
+		log.Println(err.Error())
+		c.JSON(consts.StatusInternalServerError, &order.RequestRefundResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  "Internal Error",
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &order.RequestRefundResponse{
+		BaseResp: toBaseResp(respK.BaseResp),
+		RefundID: respK.RefundId,
+	})
+}
+
+// ReviewRefund .
+// @router /review_refund [POST]
+func ReviewRefund(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.ReviewRefundRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &order.ReviewRefundResponse{
+			BaseResp: middleware.UserIDErrResp(err),
+		})
+		return
+	}
+
+	// 审核人以 token 中的用户为准
+	respK, err := orderServiceClient.ReviewRefund(ctx, &order_k.ReviewRefundRequest{
+		OrderId:      req.OrderID,
+		RefundId:     req.RefundID,
+		RespUserId:   userID,
+		Approve:      req.Approve,
+		RejectReason: req.RejectReason,
+	})
+	if err != nil {
+		log.Println(err.Error())
+		c.JSON(consts.StatusInternalServerError, &order.ReviewRefundResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  "Internal Error",
+			},
+		})
+		return
+	}
+
+	resp := &order.ReviewRefundResponse{
+		BaseResp: toBaseResp(respK.BaseResp),
+		Refund:   toRefund(respK.Refund),
+	}
+	// 审核通过后退回库存；失败时提示重试，重复审核通过是幂等的，库存也只会退回一次
+	if respK.BaseResp != nil && respK.BaseResp.Code == base_k.Code_SUCCESS && req.Approve {
+		if err = returnRefundStock(ctx, respK.Refund); err != nil {
+			log.Printf("order %s: return stock for refund %d failed: %v", req.OrderID, req.RefundID, err)
+			resp.BaseResp = &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  "退款已同意，但退回库存失败，请重试",
+			}
+		}
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// returnRefundStock 按退款单退回库存，以退款单 id 作为凭证保证只退回一次
+func returnRefundStock(ctx context.Context, refund *order_k.Refund) error {
+	if refund == nil {
+		return errors.New("退款单为空")
+	}
+	var items []pkgProduct.ReserveItem
+	for _, it := range refund.Items {
+		items = append(items, pkgProduct.ReserveItem{SkuID: it.SkuId, Count: int32(it.Count)})
+	}
+	return pkgProduct.ReturnStock(ctx, fmt.Sprintf("refund:%d", refund.Id), items)
+}
+
+// CompleteRefund .
+// @router /complete_refund [POST]
+func CompleteRefund(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.CompleteRefundRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &order.CompleteRefundResponse{
+			BaseResp: middleware.UserIDErrResp(err),
+		})
+		return
+	}
+
+	respK, err := orderServiceClient.CompleteRefund(ctx, &order_k.CompleteRefundRequest{
+		OrderId:    req.OrderID,
+		RefundId:   req.RefundID,
+		RespUserId: userID,
+	})
+	if err != nil {
+		log.Println(err.Error())
+		c.JSON(consts.StatusInternalServerError, &order.CompleteRefundResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  "Internal Error",
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &order.CompleteRefundResponse{
+		BaseResp: toBaseResp(respK.BaseResp),
+	})
+}
+
+// QueryRefunds .
+// @router /query_refunds [POST]
+func QueryRefunds(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.QueryRefundsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &order.QueryRefundsResponse{
+			BaseResp: middleware.UserIDErrResp(err),
+		})
+		return
+	}
+	infoK, err := orderServiceClient.QueryOrderInfo(ctx, &order_k.QueryOrderInfoRequest{Id: req.OrderID})
+	if err != nil {
+		log.Println(err.Error())
+		c.JSON(consts.StatusInternalServerError, &order.QueryRefundsResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  "Internal Error",
+			},
+		})
+		return
+	}
+	// 只有订单的买家和商户能查看退款单，他人的订单按不存在处理
+	if infoK.BaseResp == nil || infoK.BaseResp.Code != base_k.Code_SUCCESS || infoK.Order == nil || !ownsOrder(infoK.Order, userID) {
+		c.JSON(consts.StatusOK, &order.QueryRefundsResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_NOT_FOUND,
+				Msg:  "订单不存在",
+			},
+		})
+		return
+	}
+
+	var statuses []order_k.RefundStatus
+	for _, st := range req.Statuses {
+		statuses = append(statuses, order_k.RefundStatus(st))
+	}
+	respK, err := orderServiceClient.QueryRefunds(ctx, &order_k.QueryRefundsRequest{
+		OrderId:  req.OrderID,
+		Statuses: statuses,
+	})
+	if err != nil {
+		log.Println(err.Error())
+		c.JSON(consts.StatusInternalServerError, &order.QueryRefundsResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  "Internal Error",
+			},
+		})
+		return
+	}
+
+	resp := &order.QueryRefundsResponse{
+		BaseResp:         toBaseResp(respK.BaseResp),
+		RefundedAmount:   respK.RefundedAmount,
+		RefundableAmount: respK.RefundableAmount,
+	}
+	for _, r := range respK.Refunds {
+		resp.Refunds = append(resp.Refunds, toRefund(r))
+	}
+	c.JSON(consts.StatusOK, resp)
+}
+
+// DecodeId .
+// @router /decode_id [POST]
+func DecodeId(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.DecodeIdRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	respK, err := orderServiceClient.DecodeId(ctx, &order_k.DecodeIdRequest{Id: req.ID})
+	if err != nil {
+		log.Println(err.Error())
+		c.JSON(consts.StatusInternalServerError, &order.DecodeIdResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  "Internal Error",
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &order.DecodeIdResponse{
+		BaseResp:    toBaseResp(respK.BaseResp),
+		TimestampMs: respK.TimestampMs,
+		Node:        respK.Node,
+		Sequence:    respK.Sequence,
+	})
+}
+
+// Report .
+// @router /report [POST]
+func Report(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.ReportRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	respK, err := queryReport(ctx, c, &req)
+	if err != nil {
+		log.Println(err.Error())
+		c.JSON(consts.StatusInternalServerError, &order.ReportResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  "Internal Error",
+			},
+		})
+		return
+	}
+
+	resp := &order.ReportResponse{
+		BaseResp:            toBaseResp(respK.BaseResp),
+		TopSkusByQuantity:   toSkuSales(respK.TopSkusByQuantity),
+		TopSkusByRevenue:    toSkuSales(respK.TopSkusByRevenue),
+		TotalOrderCount:     respK.TotalOrderCount,
+		TotalRevenue:        respK.TotalRevenue,
+		TotalRefundedAmount: respK.TotalRefundedAmount,
+	}
+	for _, b := range respK.Buckets {
+		resp.Buckets = append(resp.Buckets, &order.ReportBucket{
+			Period:         b.Period,
+			PeriodStart:    b.PeriodStart,
+			OrderCount:     b.OrderCount,
+			Revenue:        b.Revenue,
+			RefundedAmount: b.RefundedAmount,
+		})
+	}
+	c.JSON(consts.StatusOK, resp)
+}
+
+// CreateFromCart .
+// @router /create_from_cart [POST]
+func CreateFromCart(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.CreateFromCartRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &order.CreateFromCartResponse{
+			BaseResp: middleware.UserIDErrResp(err),
+		})
+		return
+	}
+
+	var items []*order_k.OrderItemForCreate
+	for _, it := range req.Items {
+		items = append(items, &order_k.OrderItemForCreate{
+			ProductId: it.ProductID,
+			SkuId:     it.SkuID,
+			Count:     it.Count,
+			Price:     it.Price,
+			Ext:       it.Ext,
+		})
+	}
+
+	respK, err := orderServiceClient.CreateFromCart(ctx, &order_k.CreateFromCartRequest{
+		Type:           req.Type,
+		Status:         req.Status,
+		ReqUserId:      userID,
+		Items:          items,
+		Ext:            req.Ext,
+		IdempotencyKey: req.IdempotencyKey,
+	})
+	if err != nil {
+		log.Println(err.Error())
+		c.JSON(consts.StatusInternalServerError, &order.CreateFromCartResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  "Internal Error",
+			},
+		})
+		return
+	}
+
+	resp := &order.CreateFromCartResponse{
+		BaseResp:    toBaseResp(respK.BaseResp),
+		ParentOrder: toParentOrder(respK.ParentOrder),
+	}
+	for _, o := range respK.Orders {
+		resp.Orders = append(resp.Orders, toOrder(o))
+	}
+	c.JSON(consts.StatusOK, resp)
+}
+
+// QueryParentOrder .
+// @router /query_parent_order [POST]
+func QueryParentOrder(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.QueryParentOrderRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &order.QueryParentOrderResponse{
+			BaseResp: middleware.UserIDErrResp(err),
+		})
+		return
+	}
+
+	respK, err := orderServiceClient.QueryParentOrder(ctx, &order_k.QueryParentOrderRequest{
+		ParentId: req.ParentID,
+	})
+	if err != nil {
+		log.Println(err.Error())
+		c.JSON(consts.StatusInternalServerError, &order.QueryParentOrderResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  "Internal Error",
+			},
+		})
+		return
+	}
+
+	if respK.BaseResp == nil || respK.BaseResp.Code != base_k.Code_SUCCESS {
+		c.JSON(consts.StatusOK, &order.QueryParentOrderResponse{
+			BaseResp: toBaseResp(respK.BaseResp),
+		})
+		return
+	}
+
+	// 买家看到父订单和全部子订单；商户只看到自己的子订单，父订单汇总了其他商户的订单，不返回
+	resp := &order.QueryParentOrderResponse{
+		BaseResp: toBaseResp(respK.BaseResp),
+	}
+	isBuyer := respK.ParentOrder != nil && respK.ParentOrder.ReqUserId == userID
+	if isBuyer {
+		resp.ParentOrder = toParentOrder(respK.ParentOrder)
+	}
+	for _, o := range respK.Orders {
+		if isBuyer || o.RespUserId == userID {
+			resp.Orders = append(resp.Orders, toOrder(o))
+		}
+	}
+	if !isBuyer && len(resp.Orders) == 0 {
+		c.JSON(consts.StatusOK, &order.QueryParentOrderResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_NOT_FOUND,
+				Msg:  "父订单不存在",
+			},
+		})
+		return
+	}
+	c.JSON(consts.StatusOK, resp)
+}
+
+// CreateShipment .
+// @router /create_shipment [POST]
+func CreateShipment(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.CreateShipmentRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &order.CreateShipmentResponse{
+			BaseResp: middleware.UserIDErrResp(err),
+		})
+		return
+	}
+
+	var items []*order_k.ShipmentItemForRequest
+	for _, it := range req.Items {
+		items = append(items, &order_k.ShipmentItemForRequest{
+			ItemId: it.ItemID,
+			Count:  it.Count,
+		})
+	}
+
+	// 发货人以 token 中的用户为准
+	respK, err := orderServiceClient.CreateShipment(ctx, &order_k.CreateShipmentRequest{
+		OrderId:    req.OrderID,
+		RespUserId: userID,
+		Carrier:    req.Carrier,
+		TrackingNo: req.TrackingNo,
+		Items:      items,
+	})
+	if err != nil {
+		log.Println(err.Error())
+		c.JSON(consts.StatusInternalServerError, &order.CreateShipmentResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  "Internal Error",
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &order.CreateShipmentResponse{
+		BaseResp: toBaseResp(respK.BaseResp),
+		Shipment: toShipment(respK.Shipment),
+	})
+}
+
+// AddTrackingEvent .
+// @router /add_tracking_event [POST]
+func AddTrackingEvent(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.AddTrackingEventRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &order.AddTrackingEventResponse{
+			BaseResp: middleware.UserIDErrResp(err),
+		})
+		return
+	}
+
+	respK, err := orderServiceClient.AddTrackingEvent(ctx, &order_k.AddTrackingEventRequest{
+		OrderId:     req.OrderID,
+		ShipmentId:  req.ShipmentID,
+		RespUserId:  userID,
+		Description: req.Description,
+		Location:    req.Location,
+		OccurredAt:  req.OccurredAt,
+		Delivered:   req.Delivered,
+	})
+	if err != nil {
+		log.Println(err.Error())
+		c.JSON(consts.StatusInternalServerError, &order.AddTrackingEventResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  "Internal Error",
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &order.AddTrackingEventResponse{
+		BaseResp: toBaseResp(respK.BaseResp),
+		Shipment: toShipment(respK.Shipment),
+	})
+}
+
+// ConfirmDelivery .
+// @router /confirm_delivery [POST]
+func ConfirmDelivery(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.ConfirmDeliveryRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &order.ConfirmDeliveryResponse{
+			BaseResp: middleware.UserIDErrResp(err),
+		})
+		return
+	}
+
+	// 确认人以 token 中的用户为准
+	respK, err := orderServiceClient.ConfirmDelivery(ctx, &order_k.ConfirmDeliveryRequest{
+		OrderId:   req.OrderID,
+		ReqUserId: userID,
+	})
+	if err != nil {
+		log.Println(err.Error())
+		c.JSON(consts.StatusInternalServerError, &order.ConfirmDeliveryResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  "Internal Error",
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &order.ConfirmDeliveryResponse{
+		BaseResp: toBaseResp(respK.BaseResp),
+	})
+}
@@ -0,0 +1,130 @@
+package order
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/youperceive/cloudwego_instance/api/biz/middleware"
+	order "github.com/youperceive/cloudwego_instance/api/biz/model/order"
+
+	base_k "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/base"
+	order_k "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order"
+)
+
+// CSV 报表的内容，通过 ?section= 选择
+const (
+	reportSectionBuckets     = "buckets"
+	reportSectionTopQuantity = "top_quantity"
+	reportSectionTopRevenue  = "top_revenue"
+)
+
+// queryReport 以当前登录的商户身份查询报表，绑定时不会填充 IDL 默认值，未传时在这里补上
+func queryReport(ctx context.Context, c *app.RequestContext, req *order.ReportRequest) (*order_k.ReportResponse, error) {
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		return nil, err
+	}
+	reqK := order_k.NewReportRequest()
+	reqK.RespUserId = userID
+	reqK.From = req.From
+	reqK.To = req.To
+	reqK.Statuses = req.Statuses
+	if req.Granularity != 0 {
+		reqK.Granularity = order_k.ReportGranularity(req.Granularity)
+	}
+	if req.Timezone != "" {
+		reqK.Timezone = req.Timezone
+	}
+	if req.TopN != 0 {
+		reqK.TopN = req.TopN
+	}
+	return orderServiceClient.Report(ctx, reqK)
+}
+
+func toSkuSales(skus []*order_k.SkuSales) []*order.SkuSales {
+	sales := make([]*order.SkuSales, 0, len(skus))
+	for _, s := range skus {
+		sales = append(sales, &order.SkuSales{
+			SkuID:      s.SkuId,
+			ProductID:  s.ProductId,
+			Quantity:   s.Quantity,
+			Revenue:    s.Revenue,
+			OrderCount: s.OrderCount,
+		})
+	}
+	return sales
+}
+
+// ReportCSV 与 /report 参数相同，以 CSV 返回其中一部分：
+// section=buckets（默认，按周期统计）、top_quantity（销量排行）、top_revenue（销售额排行）
+func ReportCSV(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.ReportRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	section := c.DefaultQuery("section", reportSectionBuckets)
+	switch section {
+	case reportSectionBuckets, reportSectionTopQuantity, reportSectionTopRevenue:
+	default:
+		c.String(consts.StatusBadRequest, fmt.Sprintf("unknown section %q", section))
+		return
+	}
+
+	respK, err := queryReport(ctx, c, &req)
+	if err != nil {
+		log.Println(err.Error())
+		c.String(consts.StatusInternalServerError, "Internal Error")
+		return
+	}
+	if respK.BaseResp == nil || respK.BaseResp.Code != base_k.Code_SUCCESS {
+		c.JSON(consts.StatusOK, &order.ReportResponse{BaseResp: toBaseResp(respK.BaseResp)})
+		return
+	}
+
+	var rows [][]string
+	switch section {
+	case reportSectionBuckets:
+		rows = append(rows, []string{"period", "period_start", "order_count", "revenue", "refunded_amount"})
+		for _, b := range respK.Buckets {
+			rows = append(rows, []string{
+				b.Period,
+				strconv.FormatInt(b.PeriodStart, 10),
+				strconv.FormatInt(b.OrderCount, 10),
+				strconv.FormatInt(b.Revenue, 10),
+				strconv.FormatInt(b.RefundedAmount, 10),
+			})
+		}
+	default:
+		skus := respK.TopSkusByQuantity
+		if section == reportSectionTopRevenue {
+			skus = respK.TopSkusByRevenue
+		}
+		rows = append(rows, []string{"sku_id", "product_id", "quantity", "revenue", "order_count"})
+		for _, s := range skus {
+			rows = append(rows, []string{
+				strconv.FormatInt(s.SkuId, 10),
+				strconv.FormatInt(s.ProductId, 10),
+				strconv.FormatInt(s.Quantity, 10),
+				strconv.FormatInt(s.Revenue, 10),
+				strconv.FormatInt(s.OrderCount, 10),
+			})
+		}
+	}
+
+	c.Response.Header.Set("Content-Disposition", fmt.Sprintf(`attachment; filename="report_%s.csv"`, section))
+	c.SetContentType("text/csv; charset=utf-8")
+	c.SetStatusCode(consts.StatusOK)
+	w := csv.NewWriter(c)
+	if err := w.WriteAll(rows); err != nil {
+		log.Printf("ReportCSV: write csv failed: %v", err)
+	}
+}
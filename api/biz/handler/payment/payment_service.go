@@ -0,0 +1,205 @@
+// Code generated by hertz generator.
+
+package payment
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/youperceive/cloudwego_instance/api/biz/handler/shared"
+	"github.com/youperceive/cloudwego_instance/api/biz/middleware"
+	"github.com/youperceive/cloudwego_instance/api/biz/model/base"
+	payment "github.com/youperceive/cloudwego_instance/api/biz/model/payment"
+	pkgPayment "github.com/youperceive/cloudwego_instance/api/pkg/payment"
+	pkgProduct "github.com/youperceive/cloudwego_instance/api/pkg/product"
+)
+
+var paymentService *pkgPayment.Service
+
+// Init 创建支付服务，main 在 shared.Init 之后调用。模拟渠道只在 $PAYMENT_MOCK_ENABLED 开启时注册
+func Init() {
+	var providers []pkgPayment.Provider
+	if pkgPayment.MockEnabledFromEnv() {
+		mock, err := pkgPayment.NewMockProviderFromEnv()
+		if err != nil {
+			log.Fatal(err)
+		}
+		providers = append(providers, mock)
+	}
+	paymentService = pkgPayment.NewService(pkgProduct.DB, shared.OrderClient, providers...)
+}
+
+func toIntent(it *pkgPayment.Intent) *payment.PaymentIntent {
+	return &payment.PaymentIntent{
+		ID:            it.ID,
+		OrderID:       it.OrderID,
+		Amount:        it.Amount,
+		Provider:      it.Provider,
+		Status:        it.Status,
+		ProviderTxnID: it.ProviderTxnID,
+		PayURL:        it.PayURL,
+		CreatedAt:     it.CreatedAt,
+		UpdatedAt:     it.UpdatedAt,
+	}
+}
+
+// CreatePayment .
+// @router /create_payment [POST]
+func CreatePayment(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req payment.CreatePaymentRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &payment.CreatePaymentResponse{
+			BaseResp: middleware.UserIDErrResp(err),
+		})
+		return
+	}
+
+	// 绑定时不会填充 IDL 默认值
+	provider := pkgPayment.MockProviderName
+	if req.Provider != "" {
+		provider = req.Provider
+	}
+	intent, err := paymentService.CreateIntent(ctx, userID, req.OrderID, provider)
+	if err != nil {
+		if errors.Is(err, pkgPayment.ErrInvalidRequest) {
+			c.JSON(consts.StatusOK, &payment.CreatePaymentResponse{
+				BaseResp: &base.BaseResponse{
+					Code: base.Code_INVALID_PARAM,
+					Msg:  err.Error(),
+				},
+			})
+			return
+		}
+		log.Printf("CreatePayment failed: %v", err)
+		c.JSON(consts.StatusInternalServerError, &payment.CreatePaymentResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  "Internal Error",
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &payment.CreatePaymentResponse{
+		BaseResp: &base.BaseResponse{
+			Code: base.Code_SUCCESS,
+			Msg:  "success",
+		},
+		Intent: toIntent(intent),
+	})
+}
+
+// QueryPayment .
+// @router /query_payment [POST]
+func QueryPayment(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req payment.QueryPaymentRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &payment.QueryPaymentResponse{
+			BaseResp: middleware.UserIDErrResp(err),
+		})
+		return
+	}
+
+	intent, err := paymentService.GetIntent(ctx, userID, req.IntentID)
+	if err != nil {
+		if errors.Is(err, pkgPayment.ErrIntentNotFound) {
+			c.JSON(consts.StatusOK, &payment.QueryPaymentResponse{
+				BaseResp: &base.BaseResponse{
+					Code: base.Code_NOT_FOUND,
+					Msg:  err.Error(),
+				},
+			})
+			return
+		}
+		log.Printf("QueryPayment failed: %v", err)
+		c.JSON(consts.StatusInternalServerError, &payment.QueryPaymentResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  "Internal Error",
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &payment.QueryPaymentResponse{
+		BaseResp: &base.BaseResponse{
+			Code: base.Code_SUCCESS,
+			Msg:  "success",
+		},
+		Intent: toIntent(intent),
+	})
+}
+
+// PaymentCallback .
+// @router /payment_callback/:provider [POST]
+func PaymentCallback(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req payment.PaymentCallbackRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// 签名针对原始请求体计算，不能使用绑定后的结构重新序列化
+	header := func(key string) string {
+		return string(c.GetHeader(key))
+	}
+	err = paymentService.HandleCallback(ctx, req.Provider, header, c.Request.Body())
+	switch {
+	case err == nil:
+		c.JSON(consts.StatusOK, &payment.PaymentCallbackResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SUCCESS,
+				Msg:  "success",
+			},
+		})
+	case errors.Is(err, pkgPayment.ErrInvalidSignature):
+		log.Printf("PaymentCallback: provider %s: %v", req.Provider, err)
+		c.JSON(consts.StatusUnauthorized, &payment.PaymentCallbackResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  err.Error(),
+			},
+		})
+	case errors.Is(err, pkgPayment.ErrUnknownProvider),
+		errors.Is(err, pkgPayment.ErrInvalidRequest),
+		errors.Is(err, pkgPayment.ErrIntentNotFound),
+		errors.Is(err, pkgPayment.ErrAmountMismatch):
+		log.Printf("PaymentCallback: provider %s: %v", req.Provider, err)
+		c.JSON(consts.StatusBadRequest, &payment.PaymentCallbackResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  err.Error(),
+			},
+		})
+	default:
+		// 返回 5xx，由渠道稍后重试
+		log.Printf("PaymentCallback: provider %s: %v", req.Provider, err)
+		c.JSON(consts.StatusInternalServerError, &payment.PaymentCallbackResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  "Internal Error",
+			},
+		})
+	}
+}
@@ -4,6 +4,7 @@ package product
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 
@@ -309,15 +310,21 @@ func GetSku(ctx context.Context, c *app.RequestContext) {
 	if err != nil {
 		log.Printf("GetSku failed: %v", err)
 		resp.BaseResp.Code = codeFailed
+		if errors.Is(err, pkgProduct.ErrSkuNotFound) {
+			resp.BaseResp.Code = base.Code_NOT_FOUND
+		}
 		resp.BaseResp.Msg = err.Error()
 	} else {
 		// 转换 pkg 结构体为 model 结构体
 		resp.Sku = &productModel.Sku{
-			ID:        skuInfo.ID,
-			ProductID: skuInfo.ProductID,
-			SkuCode:   skuInfo.SkuCode,
-			Price:     skuInfo.Price,
-			Stock:     skuInfo.Stock,
+			ID:             skuInfo.ID,
+			ProductID:      skuInfo.ProductID,
+			SkuCode:        skuInfo.SkuCode,
+			Price:          skuInfo.Price,
+			Stock:          skuInfo.Stock,
+			MerchantID:     skuInfo.MerchantID,
+			ReservedStock:  skuInfo.ReservedStock,
+			AvailableStock: skuInfo.Stock - skuInfo.ReservedStock,
 		}
 	}
 
@@ -460,11 +467,14 @@ func ListSku(ctx context.Context, c *app.RequestContext) {
 	resp.Skus = make([]*productModel.Sku, 0, len(skus))
 	for _, s := range skus {
 		resp.Skus = append(resp.Skus, &productModel.Sku{
-			ID:        s.ID,
-			ProductID: s.ProductID,
-			SkuCode:   s.SkuCode,
-			Price:     s.Price,
-			Stock:     s.Stock,
+			ID:             s.ID,
+			ProductID:      s.ProductID,
+			SkuCode:        s.SkuCode,
+			Price:          s.Price,
+			Stock:          s.Stock,
+			MerchantID:     s.MerchantID,
+			ReservedStock:  s.ReservedStock,
+			AvailableStock: s.Stock - s.ReservedStock,
 		})
 	}
 
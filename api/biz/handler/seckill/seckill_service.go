@@ -0,0 +1,224 @@
+// Code generated by hertz generator.
+
+package seckill
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/youperceive/cloudwego_instance/api/biz/handler/shared"
+	"github.com/youperceive/cloudwego_instance/api/biz/middleware"
+	"github.com/youperceive/cloudwego_instance/api/biz/model/base"
+	seckill "github.com/youperceive/cloudwego_instance/api/biz/model/seckill"
+	pkgProduct "github.com/youperceive/cloudwego_instance/api/pkg/product"
+	pkgSeckill "github.com/youperceive/cloudwego_instance/api/pkg/seckill"
+)
+
+var seckillService *pkgSeckill.Service
+
+// Init 创建秒杀服务并启动预热和下单队列，main 在 shared.Init 之后调用
+func Init() {
+	seckillService = pkgSeckill.NewServiceFromEnv(pkgProduct.DB, shared.Orchestrator)
+
+	go func() {
+		if err := seckillService.WarmUp(context.Background()); err != nil {
+			log.Printf("seckill: 预热活动库存失败: %v", err)
+		}
+		seckillService.Run(context.Background())
+	}()
+}
+
+// errResp 把秒杀的错误转换为 HTTP 状态码和 BaseResponse，未知错误记录日志后返回 Internal Error
+func errResp(method string, err error) (int, *base.BaseResponse) {
+	switch {
+	case errors.Is(err, pkgSeckill.ErrInvalidRequest),
+		errors.Is(err, pkgSeckill.ErrNotStarted),
+		errors.Is(err, pkgSeckill.ErrEnded),
+		errors.Is(err, pkgSeckill.ErrSoldOut),
+		errors.Is(err, pkgSeckill.ErrLimitExceeded):
+		return consts.StatusOK, &base.BaseResponse{Code: base.Code_INVALID_PARAM, Msg: err.Error()}
+	case errors.Is(err, pkgSeckill.ErrCampaignNotFound),
+		errors.Is(err, pkgSeckill.ErrRequestNotFound):
+		return consts.StatusOK, &base.BaseResponse{Code: base.Code_NOT_FOUND, Msg: err.Error()}
+	}
+	log.Printf("%s failed: %v", method, err)
+	return consts.StatusInternalServerError, &base.BaseResponse{Code: base.Code_SERVICE_ERR, Msg: "Internal Error"}
+}
+
+func toCampaign(c *pkgSeckill.Campaign) *seckill.Campaign {
+	return &seckill.Campaign{
+		ID:           c.ID,
+		MerchantID:   c.MerchantID,
+		ProductID:    c.ProductID,
+		SkuID:        c.SkuID,
+		Quota:        c.Quota,
+		Remaining:    c.Remaining,
+		PerUserLimit: c.PerUserLimit,
+		StartAt:      c.StartAt,
+		EndAt:        c.EndAt,
+		CreatedAt:    c.CreatedAt,
+	}
+}
+
+// CreateCampaign .
+// @router /seckill_create [POST]
+func CreateCampaign(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req seckill.CreateCampaignRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &seckill.CreateCampaignResponse{BaseResp: middleware.UserIDErrResp(err)})
+		return
+	}
+
+	// 绑定时不会填充 IDL 默认值
+	limit := req.PerUserLimit
+	if limit == 0 {
+		limit = 1
+	}
+	campaign, err := seckillService.CreateCampaign(ctx, userID, &pkgSeckill.Campaign{
+		SkuID:        req.SkuID,
+		Quota:        req.Quota,
+		PerUserLimit: limit,
+		StartAt:      req.StartAt,
+		EndAt:        req.EndAt,
+	})
+	if err != nil {
+		status, baseResp := errResp("CreateCampaign", err)
+		c.JSON(status, &seckill.CreateCampaignResponse{BaseResp: baseResp})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &seckill.CreateCampaignResponse{
+		BaseResp: middleware.SuccessResp(),
+		Campaign: toCampaign(campaign),
+	})
+}
+
+// GetCampaign .
+// @router /seckill_get [POST]
+func GetCampaign(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req seckill.GetCampaignRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	campaign, err := seckillService.GetCampaign(ctx, req.CampaignID)
+	if err != nil {
+		status, baseResp := errResp("GetCampaign", err)
+		c.JSON(status, &seckill.GetCampaignResponse{BaseResp: baseResp})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &seckill.GetCampaignResponse{
+		BaseResp: middleware.SuccessResp(),
+		Campaign: toCampaign(campaign),
+	})
+}
+
+// ListCampaign .
+// @router /seckill_list [POST]
+func ListCampaign(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req seckill.ListCampaignRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	campaigns, err := seckillService.ListCampaigns(ctx, req.GetMerchantID())
+	if err != nil {
+		status, baseResp := errResp("ListCampaign", err)
+		c.JSON(status, &seckill.ListCampaignResponse{BaseResp: baseResp})
+		return
+	}
+
+	resp := &seckill.ListCampaignResponse{
+		BaseResp:  middleware.SuccessResp(),
+		Campaigns: make([]*seckill.Campaign, 0, len(campaigns)),
+	}
+	for _, campaign := range campaigns {
+		resp.Campaigns = append(resp.Campaigns, toCampaign(campaign))
+	}
+	c.JSON(consts.StatusOK, resp)
+}
+
+// Buy .
+// @router /seckill_buy [POST]
+func Buy(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req seckill.BuyRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &seckill.BuyResponse{BaseResp: middleware.UserIDErrResp(err)})
+		return
+	}
+
+	// 绑定时不会填充 IDL 默认值
+	count := req.Count
+	if count == 0 {
+		count = 1
+	}
+	requestID, err := seckillService.Buy(ctx, userID, req.CampaignID, count)
+	if err != nil {
+		status, baseResp := errResp("Buy", err)
+		c.JSON(status, &seckill.BuyResponse{BaseResp: baseResp})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &seckill.BuyResponse{
+		BaseResp:  middleware.SuccessResp(),
+		RequestID: requestID,
+	})
+}
+
+// QueryResult .
+// @router /seckill_result [POST]
+func QueryResult(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req seckill.QueryResultRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &seckill.QueryResultResponse{BaseResp: middleware.UserIDErrResp(err)})
+		return
+	}
+
+	res, err := seckillService.Result(ctx, userID, req.RequestID)
+	if err != nil {
+		status, baseResp := errResp("QueryResult", err)
+		c.JSON(status, &seckill.QueryResultResponse{BaseResp: baseResp})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &seckill.QueryResultResponse{
+		BaseResp: middleware.SuccessResp(),
+		Status:   res.Status,
+		OrderID:  res.OrderID,
+		Error:    res.Error,
+	})
+}
@@ -0,0 +1,49 @@
+// Package shared 保存各 handler 包共用的下游依赖：商品库连接、订单服务客户端和结算编排器
+package shared
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/cloudwego/kitex/client"
+	pkgCheckout "github.com/youperceive/cloudwego_instance/api/pkg/checkout"
+	pkgProduct "github.com/youperceive/cloudwego_instance/api/pkg/product"
+	order_service_k "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order/orderservice"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/paytimeout"
+)
+
+var (
+	// OrderClient 订单服务客户端，所有 handler 包共用一个
+	OrderClient order_service_k.Client
+	// Orchestrator 结算编排器，结算、购物车、秒杀、团购共用一个
+	Orchestrator *pkgCheckout.Orchestrator
+)
+
+// Init 连接商品库并创建共用依赖，main 在各 handler 包的 Init 之前调用一次
+func Init() {
+	pkgProduct.InitDB()
+
+	cli, err := order_service_k.NewClient(
+		"order_service",
+		client.WithHostPorts(os.Getenv("order_service_addr")),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+	OrderClient = cli
+
+	// 与订单服务读取同一个 $ORDER_PAY_TIMEOUT，预占有效期按订单类型不短于支付期限
+	payTimeout, err := paytimeout.ConfigFromEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	Orchestrator = pkgCheckout.NewOrchestrator(pkgProduct.DB, cli, pkgProduct.ReservationTTLFromEnv(), payTimeout)
+
+	// 恢复上次进程退出时未完成的结算流程
+	go func() {
+		if err := Orchestrator.Resume(context.Background()); err != nil {
+			log.Printf("checkout: 恢复结算流程失败: %v", err)
+		}
+	}()
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
 	"github.com/cloudwego/kitex/client"
+	"github.com/youperceive/cloudwego_instance/api/biz/middleware"
 	"github.com/youperceive/cloudwego_instance/api/biz/model/base"
 	user_account "github.com/youperceive/cloudwego_instance/api/biz/model/user_account"
 
@@ -146,3 +147,255 @@ func Update(ctx context.Context, c *app.RequestContext) {
 		},
 	})
 }
+
+// CreateAddress .
+// @router user/address_create [POST]
+func CreateAddress(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user_account.CreateAddressRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &user_account.CreateAddressResponse{
+			BaseResp: middleware.UserIDErrResp(err),
+		})
+		return
+	}
+
+	// 地址归属以 token 中的用户为准
+	respK, err := userAccountClient.CreateAddress(ctx, &user_account_k.CreateAddressRequest{
+		UserId:       userID,
+		ReceiverName: req.ReceiverName,
+		Phone:        req.Phone,
+		Province:     req.Province,
+		City:         req.City,
+		District:     req.District,
+		Detail:       req.Detail,
+		IsDefault:    req.IsDefault,
+	})
+	if err != nil {
+		log.Println(err.Error())
+		c.JSON(consts.StatusInternalServerError, &user_account.CreateAddressResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  "Internal Error",
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &user_account.CreateAddressResponse{
+		BaseResp:  toBaseResp(respK.BaseResp),
+		AddressID: respK.AddressId,
+	})
+}
+
+// UpdateAddress .
+// @router user/address_update [POST]
+func UpdateAddress(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user_account.UpdateAddressRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &user_account.UpdateAddressResponse{
+			BaseResp: middleware.UserIDErrResp(err),
+		})
+		return
+	}
+
+	// 地址归属以 token 中的用户为准
+	respK, err := userAccountClient.UpdateAddress(ctx, &user_account_k.UpdateAddressRequest{
+		Id:           req.ID,
+		UserId:       userID,
+		ReceiverName: req.ReceiverName,
+		Phone:        req.Phone,
+		Province:     req.Province,
+		City:         req.City,
+		District:     req.District,
+		Detail:       req.Detail,
+		IsDefault:    req.IsDefault,
+	})
+	if err != nil {
+		log.Println(err.Error())
+		c.JSON(consts.StatusInternalServerError, &user_account.UpdateAddressResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  "Internal Error",
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &user_account.UpdateAddressResponse{
+		BaseResp: toBaseResp(respK.BaseResp),
+	})
+}
+
+// DeleteAddress .
+// @router user/address_delete [POST]
+func DeleteAddress(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user_account.DeleteAddressRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &user_account.DeleteAddressResponse{
+			BaseResp: middleware.UserIDErrResp(err),
+		})
+		return
+	}
+
+	// 地址归属以 token 中的用户为准
+	respK, err := userAccountClient.DeleteAddress(ctx, &user_account_k.DeleteAddressRequest{
+		Id:     req.ID,
+		UserId: userID,
+	})
+	if err != nil {
+		log.Println(err.Error())
+		c.JSON(consts.StatusInternalServerError, &user_account.DeleteAddressResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  "Internal Error",
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &user_account.DeleteAddressResponse{
+		BaseResp: toBaseResp(respK.BaseResp),
+	})
+}
+
+// GetAddress .
+// @router user/address_get [POST]
+func GetAddress(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user_account.GetAddressRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &user_account.GetAddressResponse{
+			BaseResp: middleware.UserIDErrResp(err),
+		})
+		return
+	}
+
+	// 地址归属以 token 中的用户为准
+	respK, err := userAccountClient.GetAddress(ctx, &user_account_k.GetAddressRequest{
+		Id:     req.ID,
+		UserId: userID,
+	})
+	if err != nil {
+		log.Println(err.Error())
+		c.JSON(consts.StatusInternalServerError, &user_account.GetAddressResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  "Internal Error",
+			},
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &user_account.GetAddressResponse{
+		BaseResp: toBaseResp(respK.BaseResp),
+		Address:  toAddress(respK.Address),
+	})
+}
+
+// ListAddresses .
+// @router user/address_list [POST]
+func ListAddresses(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user_account.ListAddressesRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, err := middleware.CurrentUserID(c)
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, &user_account.ListAddressesResponse{
+			BaseResp: middleware.UserIDErrResp(err),
+		})
+		return
+	}
+
+	// 地址归属以 token 中的用户为准
+	respK, err := userAccountClient.ListAddresses(ctx, &user_account_k.ListAddressesRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		log.Println(err.Error())
+		c.JSON(consts.StatusInternalServerError, &user_account.ListAddressesResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  "Internal Error",
+			},
+		})
+		return
+	}
+
+	addresses := make([]*user_account.Address, 0, len(respK.Addresses))
+	for _, a := range respK.Addresses {
+		addresses = append(addresses, toAddress(a))
+	}
+	c.JSON(consts.StatusOK, &user_account.ListAddressesResponse{
+		BaseResp:  toBaseResp(respK.BaseResp),
+		Addresses: addresses,
+	})
+}
+
+// toBaseResp 透传用户服务的业务错误码
+func toBaseResp(b *base_k.BaseResponse) *base.BaseResponse {
+	if b == nil {
+		return &base.BaseResponse{
+			Code: base.Code_SERVICE_ERR,
+			Msg:  "Internal Error",
+		}
+	}
+	return &base.BaseResponse{
+		Code: base.Code(b.Code),
+		Msg:  b.Msg,
+	}
+}
+
+func toAddress(a *user_account_k.Address) *user_account.Address {
+	if a == nil {
+		return nil
+	}
+	return &user_account.Address{
+		ID:           a.Id,
+		UserID:       a.UserId,
+		ReceiverName: a.ReceiverName,
+		Phone:        a.Phone,
+		Province:     a.Province,
+		City:         a.City,
+		District:     a.District,
+		Detail:       a.Detail,
+		IsDefault:    a.IsDefault,
+		CreatedAt:    a.CreatedAt,
+		UpdatedAt:    a.UpdatedAt,
+	}
+}
@@ -2,20 +2,95 @@ package middleware
 
 import (
 	"context"
+	"errors"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/youperceive/cloudwego_instance/api/biz/model/base"
 	"github.com/youperceive/cloudwego_instance/rpc/user_account/pkg/token"
 )
 
 const UserIDKey = "user_id"
 
+// CurrentUserID 读取 JWTMiddleware 写入的用户 id，路由不在 jwtWhitelist 中时返回错误
+func CurrentUserID(c *app.RequestContext) (int64, error) {
+	v, exist := c.Get(UserIDKey)
+	if !exist {
+		return 0, errors.New("token.userId 不存在")
+	}
+	userID, ok := v.(int64)
+	if !ok {
+		return 0, errors.New("token.userId 解析失败")
+	}
+	return userID, nil
+}
+
+// UserIDErrResp CurrentUserID 失败时返回给调用方的 BaseResponse
+func UserIDErrResp(err error) *base.BaseResponse {
+	return &base.BaseResponse{
+		Code: base.Code_SERVICE_ERR,
+		Msg:  err.Error() + ". Internal Error",
+	}
+}
+
+// SuccessResp 不需要透传下游结果的接口成功时返回的 BaseResponse
+func SuccessResp() *base.BaseResponse {
+	return &base.BaseResponse{
+		Code: base.Code_SUCCESS,
+		Msg:  "success",
+	}
+}
+
 var jwtWhitelist = map[string]bool{
-	"/create": true,
+	"/user/address_create": true,
+	"/user/address_update": true,
+	"/user/address_delete": true,
+	"/user/address_get":    true,
+	"/user/address_list":   true,
+
+	"/create":           true,
+	"/update":           true,
+	"/create_from_cart": true,
+	"/checkout":         true,
+
+	"/search_orders":          true,
+	"/batch_query_order_info": true,
+	"/query_parent_order":     true,
+
+	"/request_refund":  true,
+	"/review_refund":   true,
+	"/complete_refund": true,
+	"/query_refunds":   true,
+
+	"/create_shipment":    true,
+	"/add_tracking_event": true,
+	"/confirm_delivery":   true,
+
+	"/create_payment": true,
+	"/query_payment":  true,
+
+	"/report":     true,
+	"/report_csv": true,
+
+	"/cart_add":      true,
+	"/cart_update":   true,
+	"/cart_remove":   true,
+	"/cart_list":     true,
+	"/cart_clear":    true,
+	"/cart_checkout": true,
+
+	"/seckill_create": true,
+	"/seckill_buy":    true,
+	"/seckill_result": true,
+
+	"/group_campaign_create": true,
+	"/group_open":            true,
+	"/group_join":            true,
 }
 
 func JWTMiddleware() app.HandlerFunc {
+	issuer := token.NewIssuer(token.ConfigFromEnv())
 	return func(c context.Context, ctx *app.RequestContext) {
 		hlog.Info(ctx.FullPath())
 
@@ -38,7 +113,7 @@ func JWTMiddleware() app.HandlerFunc {
 		}
 		hlog.Info(string(tokenStr))
 
-		claims, err := token.VerifyToken(string(tokenStr))
+		claims, err := issuer.VerifyToken(string(tokenStr))
 		if err != nil {
 			hlog.Error("Token解析失败:", err)
 			ctx.JSON(consts.StatusUnauthorized, map[string]interface{}{
@@ -17,6 +17,12 @@ const (
 	Code_DB_ERR        Code = 2
 	Code_SERVICE_ERR   Code = 3
 	Code_NOT_FOUND     Code = 4
+	// 订单状态机不允许的状态流转
+	Code_INVALID_STATUS_TRANSITION Code = 5
+	// 乐观锁冲突：数据已被其他请求修改
+	Code_VERSION_CONFLICT Code = 6
+	// 幂等键已被另一个内容不同的请求使用
+	Code_IDEMPOTENCY_CONFLICT Code = 7
 )
 
 func (p Code) String() string {
@@ -31,6 +37,12 @@ func (p Code) String() string {
 		return "SERVICE_ERR"
 	case Code_NOT_FOUND:
 		return "NOT_FOUND"
+	case Code_INVALID_STATUS_TRANSITION:
+		return "INVALID_STATUS_TRANSITION"
+	case Code_VERSION_CONFLICT:
+		return "VERSION_CONFLICT"
+	case Code_IDEMPOTENCY_CONFLICT:
+		return "IDEMPOTENCY_CONFLICT"
 	}
 	return "<UNSET>"
 }
@@ -47,6 +59,12 @@ func CodeFromString(s string) (Code, error) {
 		return Code_SERVICE_ERR, nil
 	case "NOT_FOUND":
 		return Code_NOT_FOUND, nil
+	case "INVALID_STATUS_TRANSITION":
+		return Code_INVALID_STATUS_TRANSITION, nil
+	case "VERSION_CONFLICT":
+		return Code_VERSION_CONFLICT, nil
+	case "IDEMPOTENCY_CONFLICT":
+		return Code_IDEMPOTENCY_CONFLICT, nil
 	}
 	return Code(0), fmt.Errorf("not a valid Code string")
 }
@@ -332,8 +332,14 @@ type Sku struct {
 	SkuCode string `thrift:"sku_code,3" form:"sku_code" json:"sku_code" query:"sku_code"`
 	// 单价（分）
 	Price int64 `thrift:"price,4" form:"price" json:"price" query:"price"`
-	// 库存
+	// 库存（含已预占部分）
 	Stock int32 `thrift:"stock,5" form:"stock" json:"stock" query:"stock"`
+	// 商户ID（订单服务据此校验 SKU 归属）
+	MerchantID int64 `thrift:"merchant_id,6" form:"merchant_id" json:"merchant_id" query:"merchant_id"`
+	// 已预占、尚未确认的库存
+	ReservedStock int32 `thrift:"reserved_stock,7" form:"reserved_stock" json:"reserved_stock" query:"reserved_stock"`
+	// 可售库存 = stock - reserved_stock
+	AvailableStock int32 `thrift:"available_stock,8" form:"available_stock" json:"available_stock" query:"available_stock"`
 }
 
 func NewSku() *Sku {
@@ -363,12 +369,27 @@ func (p *Sku) GetStock() (v int32) {
 	return p.Stock
 }
 
+func (p *Sku) GetMerchantID() (v int64) {
+	return p.MerchantID
+}
+
+func (p *Sku) GetReservedStock() (v int32) {
+	return p.ReservedStock
+}
+
+func (p *Sku) GetAvailableStock() (v int32) {
+	return p.AvailableStock
+}
+
 var fieldIDToName_Sku = map[int16]string{
 	1: "id",
 	2: "product_id",
 	3: "sku_code",
 	4: "price",
 	5: "stock",
+	6: "merchant_id",
+	7: "reserved_stock",
+	8: "available_stock",
 }
 
 func (p *Sku) Read(iprot thrift.TProtocol) (err error) {
@@ -430,6 +451,30 @@ func (p *Sku) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -514,6 +559,39 @@ func (p *Sku) ReadField5(iprot thrift.TProtocol) error {
 	p.Stock = _field
 	return nil
 }
+func (p *Sku) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.MerchantID = _field
+	return nil
+}
+func (p *Sku) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ReservedStock = _field
+	return nil
+}
+func (p *Sku) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AvailableStock = _field
+	return nil
+}
 
 func (p *Sku) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -541,6 +619,18 @@ func (p *Sku) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 5
 			goto WriteFieldError
 		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -644,6 +734,57 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
+func (p *Sku) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("merchant_id", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.MerchantID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *Sku) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reserved_stock", thrift.I32, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ReservedStock); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *Sku) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("available_stock", thrift.I32, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.AvailableStock); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
 func (p *Sku) String() string {
 	if p == nil {
 		return "<nil>"
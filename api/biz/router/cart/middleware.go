@@ -0,0 +1,42 @@
+// Code generated by hertz generator.
+
+package cart
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func rootMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _addcartitemMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _cartcheckoutMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _clearcartMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listcartMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _removecartitemMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatecartitemMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
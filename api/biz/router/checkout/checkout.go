@@ -0,0 +1,21 @@
+// Code generated by hertz generator. DO NOT EDIT.
+
+package checkout
+
+import (
+	"github.com/cloudwego/hertz/pkg/app/server"
+	checkout "github.com/youperceive/cloudwego_instance/api/biz/handler/checkout"
+)
+
+/*
+ This file will register all the routes of the services in the master idl.
+ And it will update automatically when you use the "update" command for the idl.
+ So don't modify the contents of the file, or your code will be deleted when it is updated.
+*/
+
+// Register register routes based on the IDL 'api.${HTTP Method}' annotation.
+func Register(r *server.Hertz) {
+
+	root := r.Group("/", rootMw()...)
+	root.POST("/checkout", append(_checkoutMw(), checkout.Checkout)...)
+}
@@ -0,0 +1,17 @@
+// Code generated by hertz generator.
+
+package checkout
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func rootMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _checkoutMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -0,0 +1,42 @@
+// Code generated by hertz generator.
+
+package groupbuy
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func rootMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _creategroupcampaignMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listgroupcampaignMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _joingroupMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listgroupMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _opengroupMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _querygroupMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
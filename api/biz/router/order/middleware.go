@@ -30,3 +30,68 @@ func _updateMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _searchordersMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _batchqueryorderinfoMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _completerefundMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _queryrefundsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _requestrefundMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _reviewrefundMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _decodeidMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _reportMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createfromcartMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _queryparentorderMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _addtrackingeventMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _confirmdeliveryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createshipmentMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
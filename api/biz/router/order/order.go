@@ -17,8 +17,21 @@ import (
 func Register(r *server.Hertz) {
 
 	root := r.Group("/", rootMw()...)
+	root.POST("/add_tracking_event", append(_addtrackingeventMw(), order.AddTrackingEvent)...)
+	root.POST("/batch_query_order_info", append(_batchqueryorderinfoMw(), order.BatchQueryOrderInfo)...)
+	root.POST("/complete_refund", append(_completerefundMw(), order.CompleteRefund)...)
+	root.POST("/confirm_delivery", append(_confirmdeliveryMw(), order.ConfirmDelivery)...)
 	root.POST("/create", append(_createMw(), order.Create)...)
+	root.POST("/create_from_cart", append(_createfromcartMw(), order.CreateFromCart)...)
+	root.POST("/create_shipment", append(_createshipmentMw(), order.CreateShipment)...)
+	root.POST("/decode_id", append(_decodeidMw(), order.DecodeId)...)
 	root.POST("/query_order_id", append(_queryorderidMw(), order.QueryOrderId)...)
 	root.POST("/query_order_info", append(_queryorderinfoMw(), order.QueryOrderInfo)...)
+	root.POST("/query_parent_order", append(_queryparentorderMw(), order.QueryParentOrder)...)
+	root.POST("/query_refunds", append(_queryrefundsMw(), order.QueryRefunds)...)
+	root.POST("/report", append(_reportMw(), order.Report)...)
+	root.POST("/request_refund", append(_requestrefundMw(), order.RequestRefund)...)
+	root.POST("/review_refund", append(_reviewrefundMw(), order.ReviewRefund)...)
+	root.POST("/search_orders", append(_searchordersMw(), order.SearchOrders)...)
 	root.POST("/update", append(_updateMw(), order.Update)...)
 }
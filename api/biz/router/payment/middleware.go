@@ -0,0 +1,32 @@
+// Code generated by hertz generator.
+
+package payment
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func rootMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createpaymentMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _querypaymentMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _payment_callbackMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _paymentcallbackMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -4,8 +4,13 @@ package router
 
 import (
 	"github.com/cloudwego/hertz/pkg/app/server"
+	cart "github.com/youperceive/cloudwego_instance/api/biz/router/cart"
+	checkout "github.com/youperceive/cloudwego_instance/api/biz/router/checkout"
+	groupbuy "github.com/youperceive/cloudwego_instance/api/biz/router/groupbuy"
 	order "github.com/youperceive/cloudwego_instance/api/biz/router/order"
+	payment "github.com/youperceive/cloudwego_instance/api/biz/router/payment"
 	product "github.com/youperceive/cloudwego_instance/api/biz/router/product"
+	seckill "github.com/youperceive/cloudwego_instance/api/biz/router/seckill"
 	user_account "github.com/youperceive/cloudwego_instance/api/biz/router/user_account"
 	verify_code "github.com/youperceive/cloudwego_instance/api/biz/router/verify_code"
 )
@@ -13,6 +18,16 @@ import (
 // GeneratedRegister registers routers generated by IDL.
 func GeneratedRegister(r *server.Hertz) {
 	//INSERT_POINT: DO NOT DELETE THIS LINE!
+	groupbuy.Register(r)
+
+	seckill.Register(r)
+
+	cart.Register(r)
+
+	payment.Register(r)
+
+	checkout.Register(r)
+
 	product.Register(r)
 
 	order.Register(r)
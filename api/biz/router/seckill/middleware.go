@@ -0,0 +1,37 @@
+// Code generated by hertz generator.
+
+package seckill
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func rootMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _buyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createcampaignMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getcampaignMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listcampaignMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _queryresultMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -0,0 +1,25 @@
+// Code generated by hertz generator. DO NOT EDIT.
+
+package seckill
+
+import (
+	"github.com/cloudwego/hertz/pkg/app/server"
+	seckill "github.com/youperceive/cloudwego_instance/api/biz/handler/seckill"
+)
+
+/*
+ This file will register all the routes of the services in the master idl.
+ And it will update automatically when you use the "update" command for the idl.
+ So don't modify the contents of the file, or your code will be deleted when it is updated.
+*/
+
+// Register register routes based on the IDL 'api.${HTTP Method}' annotation.
+func Register(r *server.Hertz) {
+
+	root := r.Group("/", rootMw()...)
+	root.POST("/seckill_buy", append(_buyMw(), seckill.Buy)...)
+	root.POST("/seckill_create", append(_createcampaignMw(), seckill.CreateCampaign)...)
+	root.POST("/seckill_get", append(_getcampaignMw(), seckill.GetCampaign)...)
+	root.POST("/seckill_list", append(_listcampaignMw(), seckill.ListCampaign)...)
+	root.POST("/seckill_result", append(_queryresultMw(), seckill.QueryResult)...)
+}
@@ -28,3 +28,28 @@ func _updateMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _createaddressMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deleteaddressMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getaddressMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listaddressesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updateaddressMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
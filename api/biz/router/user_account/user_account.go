@@ -19,6 +19,11 @@ func Register(r *server.Hertz) {
 	root := r.Group("/", rootMw()...)
 	{
 		_user := root.Group("/user", _userMw()...)
+		_user.POST("/address_create", append(_createaddressMw(), user_account.CreateAddress)...)
+		_user.POST("/address_delete", append(_deleteaddressMw(), user_account.DeleteAddress)...)
+		_user.POST("/address_get", append(_getaddressMw(), user_account.GetAddress)...)
+		_user.POST("/address_list", append(_listaddressesMw(), user_account.ListAddresses)...)
+		_user.POST("/address_update", append(_updateaddressMw(), user_account.UpdateAddress)...)
 		_user.POST("/login", append(_loginMw(), user_account.Login)...)
 		_user.POST("/register", append(_registerMw(), user_account.Register)...)
 		_user.POST("/update", append(_updateMw(), user_account.Update)...)
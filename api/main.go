@@ -4,13 +4,31 @@ package main
 
 import (
 	"context"
+	"time"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/app/server"
+	"github.com/youperceive/cloudwego_instance/api/biz/handler/cart"
+	"github.com/youperceive/cloudwego_instance/api/biz/handler/checkout"
+	"github.com/youperceive/cloudwego_instance/api/biz/handler/groupbuy"
+	"github.com/youperceive/cloudwego_instance/api/biz/handler/order"
+	"github.com/youperceive/cloudwego_instance/api/biz/handler/payment"
+	"github.com/youperceive/cloudwego_instance/api/biz/handler/seckill"
+	"github.com/youperceive/cloudwego_instance/api/biz/handler/shared"
 	"github.com/youperceive/cloudwego_instance/api/biz/middleware"
+	pkgProduct "github.com/youperceive/cloudwego_instance/api/pkg/product"
 )
 
 func main() {
+	// 共用依赖先于各 handler 包初始化
+	shared.Init()
+	order.Init()
+	checkout.Init()
+	cart.Init()
+	seckill.Init()
+	groupbuy.Init()
+	payment.Init()
+
 	h := server.Default()
 
 	h.Use(func(c context.Context, ctx *app.RequestContext) {
@@ -29,6 +47,9 @@ func main() {
 	})
 	h.Use(middleware.JWTMiddleware())
 
+	// 定期释放过期的库存预占
+	go pkgProduct.RunReservationSweeper(context.Background(), time.Minute)
+
 	register(h)
 	h.Spin()
 }
@@ -0,0 +1,27 @@
+package cart
+
+import "errors"
+
+const (
+	// MaxItems 购物车最多保存的 SKU 种数
+	MaxItems = 100
+	// MaxCount 单个 SKU 的最大加购数量
+	MaxCount = 999
+)
+
+var (
+	ErrInvalidCount  = errors.New("数量必须在 1 到 999 之间")
+	ErrCartFull      = errors.New("购物车已满，请先删除部分商品")
+	ErrCountExceeded = errors.New("该商品加购数量超过上限")
+	ErrItemNotFound  = errors.New("购物车中没有该商品")
+	ErrEmptyCart     = errors.New("没有可结算的商品")
+	// ErrCheckoutBusy 同一用户的购物车正在结算，避免重复提交生成重复订单
+	ErrCheckoutBusy = errors.New("购物车正在结算，请稍后再试")
+)
+
+// Item 购物车中的一项，以 JSON 保存在用户购物车 hash 中，field 为 sku_id
+type Item struct {
+	SkuID   int64 `json:"-"`
+	Count   int64 `json:"count"`
+	AddedAt int64 `json:"added_at"`
+}
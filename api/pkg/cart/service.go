@@ -0,0 +1,149 @@
+package cart
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	pkgCheckout "github.com/youperceive/cloudwego_instance/api/pkg/checkout"
+	pkgProduct "github.com/youperceive/cloudwego_instance/api/pkg/product"
+)
+
+// checkoutLockTTL 结算锁的有效期，应长于一次结算的耗时
+const checkoutLockTTL = time.Minute
+
+// Line 购物车项加上 SKU 的实时信息，Sku 为 nil 表示 SKU 已删除
+type Line struct {
+	*Item
+	Sku *pkgProduct.Sku
+}
+
+// Available SKU 存在且可售库存不少于加购数量
+func (l *Line) Available() bool {
+	return l.Sku != nil && int64(l.Sku.Stock-l.Sku.ReservedStock) >= l.Count
+}
+
+// Service 购物车业务：读取时补充 SKU 实时价格和库存，结算时整车走一次结算流程，由订单服务按商户拆分为父订单和子订单
+type Service struct {
+	store        *Store
+	orchestrator *pkgCheckout.Orchestrator
+}
+
+func NewService(store *Store, orchestrator *pkgCheckout.Orchestrator) *Service {
+	return &Service{store: store, orchestrator: orchestrator}
+}
+
+// Add 加购前校验 SKU 存在
+func (s *Service) Add(ctx context.Context, userID, skuID, count int64) (int64, error) {
+	if _, err := pkgProduct.GetSku(ctx, skuID); err != nil {
+		return 0, err
+	}
+	return s.store.Add(ctx, userID, skuID, count)
+}
+
+// List 返回购物车中的所有项及其 SKU 的实时信息
+func (s *Service) List(ctx context.Context, userID int64) ([]*Line, error) {
+	items, err := s.store.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.withSkus(ctx, items)
+}
+
+func (s *Service) withSkus(ctx context.Context, items []*Item) ([]*Line, error) {
+	ids := make([]int64, 0, len(items))
+	for _, it := range items {
+		ids = append(ids, it.SkuID)
+	}
+	skus, err := pkgProduct.ListSkuByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	lines := make([]*Line, 0, len(items))
+	for _, it := range items {
+		lines = append(lines, &Line{Item: it, Sku: skus[it.SkuID]})
+	}
+	return lines, nil
+}
+
+// Checkout 结算购物车中的 skuIDs（为空时结算全部），订单服务按商户拆分为一个父订单和各商户的子订单，全部成功或全部失败。
+// 选中的 SKU 不在购物车中或已删除时返回 ErrInvalidRequest 包装的错误，不创建任何订单；
+// 流程结束时 error 为 nil，由 Saga.Status 区分结果，成功时结算的商品从购物车中扣除。addressID 为 0 表示不指定收货地址。
+func (s *Service) Checkout(ctx context.Context, userID int64, orderType int32, skuIDs []int64, ext map[string]string, addressID int64) (*pkgCheckout.Saga, error) {
+	unlock, err := s.store.lock(ctx, userID, checkoutLockTTL)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	items, err := s.store.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	items, err = selectItems(items, skuIDs)
+	if err != nil {
+		return nil, err
+	}
+	lines, err := s.withSkus(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+
+	checkoutItems := make([]*pkgCheckout.Item, 0, len(lines))
+	for _, l := range lines {
+		if l.Sku == nil {
+			return nil, fmt.Errorf("%w: SKU %d 已下架，请从购物车中删除", pkgCheckout.ErrInvalidRequest, l.SkuID)
+		}
+		checkoutItems = append(checkoutItems, &pkgCheckout.Item{
+			ProductID: l.Sku.ProductID,
+			SkuID:     l.SkuID,
+			Count:     l.Count,
+		})
+	}
+
+	saga, err := s.orchestrator.Checkout(ctx, &pkgCheckout.Request{
+		Type:      orderType,
+		ReqUserID: userID,
+		Items:     checkoutItems,
+		Ext:       ext,
+		AddressID: addressID,
+		FromCart:  true,
+	})
+	if err != nil || saga.Status != pkgCheckout.StatusCompleted {
+		return saga, err
+	}
+	// 订单已创建，扣除失败只会让商品留在购物车中，不影响结算结果
+	if err := s.store.consume(ctx, userID, items); err != nil {
+		log.Printf("cart: 用户 %d 结算成功（父订单 %s），但从购物车扣除失败: %v", userID, saga.OrderID, err)
+	}
+	return saga, nil
+}
+
+// selectItems 从购物车中挑出 skuIDs 对应的项，skuIDs 为空时返回全部
+func selectItems(items []*Item, skuIDs []int64) ([]*Item, error) {
+	if len(skuIDs) == 0 {
+		if len(items) == 0 {
+			return nil, ErrEmptyCart
+		}
+		return items, nil
+	}
+	bySku := make(map[int64]*Item, len(items))
+	for _, it := range items {
+		bySku[it.SkuID] = it
+	}
+	selected := make([]*Item, 0, len(skuIDs))
+	seen := make(map[int64]bool, len(skuIDs))
+	for _, id := range skuIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		it, ok := bySku[id]
+		if !ok {
+			return nil, fmt.Errorf("%w: 购物车中没有 SKU %d", pkgCheckout.ErrInvalidRequest, id)
+		}
+		selected = append(selected, it)
+	}
+	return selected, nil
+}
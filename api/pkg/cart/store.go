@@ -0,0 +1,237 @@
+package cart
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const defaultTTL = 30 * 24 * time.Hour
+
+// addScript 累加数量；新 SKU 检查种数上限，累加后检查数量上限。
+// 返回累加后的数量，-1 表示购物车已满，-2 表示超过数量上限
+var addScript = redis.NewScript(`
+local v = redis.call('HGET', KEYS[1], ARGV[1])
+local item
+if v then
+	item = cjson.decode(v)
+else
+	if redis.call('HLEN', KEYS[1]) >= tonumber(ARGV[4]) then
+		return -1
+	end
+	item = {count = 0, added_at = tonumber(ARGV[3])}
+end
+item.count = item.count + tonumber(ARGV[2])
+if item.count > tonumber(ARGV[5]) then
+	return -2
+end
+redis.call('HSET', KEYS[1], ARGV[1], cjson.encode(item))
+redis.call('PEXPIRE', KEYS[1], ARGV[6])
+return item.count
+`)
+
+// updateScript 修改已有 SKU 的数量，返回 0 表示购物车中没有该 SKU
+var updateScript = redis.NewScript(`
+local v = redis.call('HGET', KEYS[1], ARGV[1])
+if not v then
+	return 0
+end
+local item = cjson.decode(v)
+item.count = tonumber(ARGV[2])
+redis.call('HSET', KEYS[1], ARGV[1], cjson.encode(item))
+redis.call('PEXPIRE', KEYS[1], ARGV[3])
+return 1
+`)
+
+// consumeScript 结算成功后扣减数量，扣完的 SKU 删除；结算期间又加购的数量会保留
+var consumeScript = redis.NewScript(`
+for i = 1, #ARGV, 2 do
+	local v = redis.call('HGET', KEYS[1], ARGV[i])
+	if v then
+		local item = cjson.decode(v)
+		item.count = item.count - tonumber(ARGV[i + 1])
+		if item.count > 0 then
+			redis.call('HSET', KEYS[1], ARGV[i], cjson.encode(item))
+		else
+			redis.call('HDEL', KEYS[1], ARGV[i])
+		end
+	end
+end
+return 0
+`)
+
+// unlockScript 只释放自己持有的锁，锁过期后被他人获取时不误删
+var unlockScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// Store 按用户保存购物车：cart:<user_id> 为 hash，field 为 sku_id，
+// 每次写入都刷新整个购物车的过期时间
+type Store struct {
+	rdb *redis.Client
+	ttl time.Duration
+}
+
+func NewStore(rdb *redis.Client, ttl time.Duration) *Store {
+	return &Store{rdb: rdb, ttl: ttl}
+}
+
+// NewStoreFromEnv 连接 $CART_REDIS_ADDR（未配置时使用 $REDIS_ADDR），过期时间读取 $CART_TTL（如 720h），默认 30 天
+func NewStoreFromEnv() *Store {
+	addr := os.Getenv("CART_REDIS_ADDR")
+	if addr == "" {
+		addr = os.Getenv("REDIS_ADDR")
+	}
+	rdb := redis.NewClient(&redis.Options{Addr: addr})
+	if err := rdb.Ping(context.Background()).Err(); err != nil {
+		log.Printf("cart: 连接 redis 失败: %v", err)
+	}
+
+	ttl := defaultTTL
+	if v := os.Getenv("CART_TTL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			log.Printf("cart: CART_TTL=%q 格式错误，使用默认值 %s", v, defaultTTL)
+		} else {
+			ttl = d
+		}
+	}
+	return NewStore(rdb, ttl)
+}
+
+func cartKey(userID int64) string {
+	return "cart:" + strconv.FormatInt(userID, 10)
+}
+
+func lockKey(userID int64) string {
+	return "cart_checkout_lock:" + strconv.FormatInt(userID, 10)
+}
+
+func validCount(count int64) bool {
+	return count >= 1 && count <= MaxCount
+}
+
+// Add 加购，已有该 SKU 时累加，返回累加后的数量
+func (s *Store) Add(ctx context.Context, userID, skuID, count int64) (int64, error) {
+	if !validCount(count) {
+		return 0, ErrInvalidCount
+	}
+	n, err := addScript.Run(ctx, s.rdb, []string{cartKey(userID)},
+		skuID, count, time.Now().Unix(), MaxItems, MaxCount, s.ttl.Milliseconds()).Int64()
+	if err != nil {
+		return 0, fmt.Errorf("cart: 加购失败: %w", err)
+	}
+	switch n {
+	case -1:
+		return 0, ErrCartFull
+	case -2:
+		return 0, ErrCountExceeded
+	}
+	return n, nil
+}
+
+// Update 修改已有 SKU 的数量
+func (s *Store) Update(ctx context.Context, userID, skuID, count int64) error {
+	if !validCount(count) {
+		return ErrInvalidCount
+	}
+	n, err := updateScript.Run(ctx, s.rdb, []string{cartKey(userID)},
+		skuID, count, s.ttl.Milliseconds()).Int64()
+	if err != nil {
+		return fmt.Errorf("cart: 修改数量失败: %w", err)
+	}
+	if n == 0 {
+		return ErrItemNotFound
+	}
+	return nil
+}
+
+// Remove 删除若干 SKU，不在购物车中的忽略
+func (s *Store) Remove(ctx context.Context, userID int64, skuIDs []int64) error {
+	if len(skuIDs) == 0 {
+		return nil
+	}
+	fields := make([]string, 0, len(skuIDs))
+	for _, id := range skuIDs {
+		fields = append(fields, strconv.FormatInt(id, 10))
+	}
+	if err := s.rdb.HDel(ctx, cartKey(userID), fields...).Err(); err != nil {
+		return fmt.Errorf("cart: 删除商品失败: %w", err)
+	}
+	return nil
+}
+
+// List 返回购物车中的所有项，按加购时间倒序
+func (s *Store) List(ctx context.Context, userID int64) ([]*Item, error) {
+	m, err := s.rdb.HGetAll(ctx, cartKey(userID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("cart: 查询购物车失败: %w", err)
+	}
+	items := make([]*Item, 0, len(m))
+	for field, v := range m {
+		skuID, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			continue
+		}
+		item := &Item{SkuID: skuID}
+		if err := json.Unmarshal([]byte(v), item); err != nil {
+			log.Printf("cart: 用户 %d 的购物车项 %s 解析失败: %v", userID, field, err)
+			continue
+		}
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].AddedAt != items[j].AddedAt {
+			return items[i].AddedAt > items[j].AddedAt
+		}
+		return items[i].SkuID > items[j].SkuID
+	})
+	return items, nil
+}
+
+// Clear 清空购物车
+func (s *Store) Clear(ctx context.Context, userID int64) error {
+	if err := s.rdb.Del(ctx, cartKey(userID)).Err(); err != nil {
+		return fmt.Errorf("cart: 清空购物车失败: %w", err)
+	}
+	return nil
+}
+
+// consume 从购物车中扣减已结算的数量
+func (s *Store) consume(ctx context.Context, userID int64, items []*Item) error {
+	args := make([]any, 0, 2*len(items))
+	for _, it := range items {
+		args = append(args, it.SkuID, it.Count)
+	}
+	if err := consumeScript.Run(ctx, s.rdb, []string{cartKey(userID)}, args...).Err(); err != nil && err != redis.Nil {
+		return fmt.Errorf("cart: 扣减已结算商品失败: %w", err)
+	}
+	return nil
+}
+
+// lock 结算期间持有的用户级锁，返回的函数用于释放
+func (s *Store) lock(ctx context.Context, userID int64, ttl time.Duration) (func(), error) {
+	token := strconv.FormatInt(time.Now().UnixNano(), 36)
+	ok, err := s.rdb.SetNX(ctx, lockKey(userID), token, ttl).Result()
+	if err != nil {
+		return nil, fmt.Errorf("cart: 获取结算锁失败: %w", err)
+	}
+	if !ok {
+		return nil, ErrCheckoutBusy
+	}
+	return func() {
+		if err := unlockScript.Run(context.Background(), s.rdb, []string{lockKey(userID)}, token).Err(); err != nil {
+			log.Printf("cart: 释放用户 %d 的结算锁失败: %v", userID, err)
+		}
+	}, nil
+}
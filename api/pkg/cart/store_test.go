@@ -0,0 +1,145 @@
+package cart
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestStore(t *testing.T) (*Store, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	return NewStore(rdb, time.Hour), mr
+}
+
+func counts(t *testing.T, s *Store, userID int64) map[int64]int64 {
+	t.Helper()
+	items, err := s.List(context.Background(), userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := make(map[int64]int64, len(items))
+	for _, it := range items {
+		m[it.SkuID] = it.Count
+	}
+	return m
+}
+
+func TestStoreAdd(t *testing.T) {
+	ctx := context.Background()
+	s, mr := newTestStore(t)
+
+	if n, err := s.Add(ctx, 1, 100, 2); err != nil || n != 2 {
+		t.Fatalf("Add = %d, %v", n, err)
+	}
+	if n, err := s.Add(ctx, 1, 100, 3); err != nil || n != 5 {
+		t.Fatalf("Add = %d, %v, want accumulated 5", n, err)
+	}
+	if ttl := mr.TTL(cartKey(1)); ttl != time.Hour {
+		t.Fatalf("ttl = %s, want 1h", ttl)
+	}
+	if _, err := s.Add(ctx, 1, 100, MaxCount); !errors.Is(err, ErrCountExceeded) {
+		t.Fatalf("err = %v, want ErrCountExceeded", err)
+	}
+	if got := counts(t, s, 1)[100]; got != 5 {
+		t.Fatalf("count after rejected add = %d, want 5", got)
+	}
+	if _, err := s.Add(ctx, 1, 100, 0); !errors.Is(err, ErrInvalidCount) {
+		t.Fatalf("err = %v, want ErrInvalidCount", err)
+	}
+}
+
+func TestStoreAddCartFull(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestStore(t)
+
+	for sku := int64(1); sku <= MaxItems; sku++ {
+		if _, err := s.Add(ctx, 1, sku, 1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := s.Add(ctx, 1, MaxItems+1, 1); !errors.Is(err, ErrCartFull) {
+		t.Fatalf("err = %v, want ErrCartFull", err)
+	}
+	// 已有的 SKU 不受种数上限影响
+	if _, err := s.Add(ctx, 1, 1, 1); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStoreUpdate(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestStore(t)
+
+	if err := s.Update(ctx, 1, 100, 3); !errors.Is(err, ErrItemNotFound) {
+		t.Fatalf("err = %v, want ErrItemNotFound", err)
+	}
+	if _, err := s.Add(ctx, 1, 100, 2); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Update(ctx, 1, 100, 7); err != nil {
+		t.Fatal(err)
+	}
+	if got := counts(t, s, 1)[100]; got != 7 {
+		t.Fatalf("count = %d, want 7", got)
+	}
+}
+
+func TestStoreConsume(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestStore(t)
+
+	for sku, n := range map[int64]int64{100: 2, 200: 5} {
+		if _, err := s.Add(ctx, 1, sku, n); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// 结算 100 全部、200 中的 3 件，不在购物车中的 300 忽略
+	err := s.consume(ctx, 1, []*Item{{SkuID: 100, Count: 2}, {SkuID: 200, Count: 3}, {SkuID: 300, Count: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := counts(t, s, 1)
+	if len(got) != 1 || got[200] != 2 {
+		t.Fatalf("cart after consume = %v, want map[200:2]", got)
+	}
+}
+
+func TestStoreLock(t *testing.T) {
+	ctx := context.Background()
+	s, mr := newTestStore(t)
+
+	unlock, err := s.lock(ctx, 1, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.lock(ctx, 1, time.Minute); !errors.Is(err, ErrCheckoutBusy) {
+		t.Fatalf("err = %v, want ErrCheckoutBusy", err)
+	}
+
+	// 锁过期后被他人获取，原持有者释放时不能误删
+	mr.FastForward(time.Minute)
+	if err := mr.Set(lockKey(1), "other"); err != nil {
+		t.Fatal(err)
+	}
+	unlock()
+	if v, _ := mr.Get(lockKey(1)); v != "other" {
+		t.Fatalf("lock = %q, want other holder kept", v)
+	}
+
+	mr.Del(lockKey(1))
+	unlock2, err := s.lock(ctx, 1, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unlock2()
+	if mr.Exists(lockKey(1)) {
+		t.Fatal("lock should be released")
+	}
+}
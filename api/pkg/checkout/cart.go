@@ -0,0 +1,95 @@
+package checkout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	pkgProduct "github.com/youperceive/cloudwego_instance/api/pkg/product"
+	"github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/base"
+	order_k "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/status"
+)
+
+// maxCartOrders 一次购物车结算最多拆出的子订单数，与订单服务一次最多下单的订单项数一致
+const maxCartOrders = 100
+
+// createCartOrders 通过订单服务的 CreateFromCart 在一个事务中创建父订单和各商户的子订单，
+// 再把以流程 id 预占的库存按 SKU 换绑到对应的子订单
+func (o *Orchestrator) createCartOrders(ctx context.Context, s *Saga, resumed bool) error {
+	if resumed {
+		// 中断前可能已经创建成功，先按 ext 找回
+		children, err := o.findCartOrders(ctx, s.ID)
+		if err != nil {
+			return err
+		}
+		if len(children) == 0 {
+			return &stepError{msg: "结算流程中断，订单未创建"}
+		}
+		return bindCartOrders(ctx, s, children)
+	}
+
+	req := s.Request
+	createReq := &order_k.CreateFromCartRequest{
+		Type:      req.Type,
+		Status:    status.PendingPayment,
+		ReqUserId: req.ReqUserID,
+		Items:     orderItems(req.Items),
+		Ext:       sagaExt(req.Ext, s.ID),
+		// 以流程 id 作为幂等键，超时重试不会重复创建父订单
+		IdempotencyKey: &s.ID,
+	}
+	if req.AddressID > 0 {
+		createReq.AddressId = &req.AddressID
+	}
+	resp, err := o.orders.CreateFromCart(ctx, createReq)
+	if err != nil {
+		// 结果未知，进入补偿：补偿时会按 ext 找到可能已创建的子订单并取消
+		log.Printf("checkout: 结算流程 %s 创建订单失败: %v", s.ID, err)
+		return &stepError{msg: "创建订单失败"}
+	}
+	if resp.BaseResp == nil || resp.BaseResp.Code != base.Code_SUCCESS {
+		msg := "创建订单失败"
+		if resp.BaseResp != nil {
+			msg = resp.BaseResp.Msg
+		}
+		return &stepError{msg: msg}
+	}
+	return bindCartOrders(ctx, s, resp.Orders)
+}
+
+// bindCartOrders 记录父订单 id，并把预占按子订单的 SKU 换绑到子订单，支付和取消时按子订单 id 结算。
+// 同一 SKU 只属于一个商户，换绑可以安全重试
+func bindCartOrders(ctx context.Context, s *Saga, children []*order_k.Order) error {
+	for _, c := range children {
+		skuIDs := make([]int64, 0, len(c.Items))
+		for _, it := range c.Items {
+			skuIDs = append(skuIDs, it.SkuId)
+		}
+		if err := pkgProduct.RebindReservation(ctx, s.ID, c.Id, skuIDs...); err != nil {
+			return err
+		}
+	}
+	if len(children) > 0 {
+		s.OrderID = children[0].ParentId
+	}
+	s.Orders = children
+	return nil
+}
+
+// findCartOrders 按 ext 中的结算流程 id 查找子订单，没有时返回空
+func (o *Orchestrator) findCartOrders(ctx context.Context, sagaID string) ([]*order_k.Order, error) {
+	ids, err := o.findOrders(ctx, sagaID, maxCartOrders)
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+	resp, err := o.orders.BatchQueryOrderInfo(ctx, &order_k.BatchQueryOrderInfoRequest{Ids: ids})
+	if err != nil {
+		return nil, fmt.Errorf("findCartOrders: %w", err)
+	}
+	if resp.BaseResp == nil || resp.BaseResp.Code != base.Code_SUCCESS {
+		return nil, errors.New("findCartOrders: 查询子订单失败")
+	}
+	return resp.Orders, nil
+}
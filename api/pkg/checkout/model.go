@@ -0,0 +1,77 @@
+package checkout
+
+import (
+	"errors"
+	"time"
+
+	order_k "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order"
+)
+
+// 结算流程状态
+const (
+	StatusReserving     = "reserving"      // 正在扣减库存
+	StatusCreatingOrder = "creating_order" // 库存已扣减，正在创建订单
+	StatusCompensating  = "compensating"   // 正在补偿：取消订单、回补库存
+	StatusCompleted     = "completed"
+	StatusFailed        = "failed" // 补偿完成
+)
+
+// ExtSagaKey 写入订单 ext，流程中断后据此找回已创建的订单
+const ExtSagaKey = "checkout_saga_id"
+
+var (
+	ErrInvalidRequest = errors.New("invalid checkout request")
+	ErrSagaNotFound   = errors.New("结算流程不存在")
+	// ErrSagaRunning 同一 id 的流程正在执行
+	ErrSagaRunning = errors.New("结算流程正在执行")
+)
+
+type Item struct {
+	ProductID int64             `json:"product_id"`
+	SkuID     int64             `json:"sku_id"`
+	Count     int64             `json:"count"`
+	Ext       map[string]string `json:"ext,omitempty"`
+}
+
+// Request 结算请求，整体以 JSON 保存在 checkout_saga.payload
+type Request struct {
+	Type       int32             `json:"type"`
+	ReqUserID  int64             `json:"req_user_id"`
+	RespUserID int64             `json:"resp_user_id"`
+	Items      []*Item           `json:"items"`
+	Ext        map[string]string `json:"ext,omitempty"`
+	// AddressID 收货地址 id，0 表示不指定，订单服务校验其属于 ReqUserID
+	AddressID int64 `json:"address_id,omitempty"`
+	// FromCart 按购物车结算：订单服务按 SKU 所属商户在一个事务中创建父订单和各商户的子订单，RespUserID 不填
+	FromCart bool `json:"from_cart,omitempty"`
+	// HoldTTL 库存预占的最短有效期，0 表示按订单类型取（见 Orchestrator.HoldTTL）
+	HoldTTL time.Duration `json:"hold_ttl,omitempty"`
+}
+
+// Saga 对应 checkout_saga 表的一行
+type Saga struct {
+	ID      string
+	Request Request
+	Status  string
+	// OrderID 创建成功的订单 id，按购物车结算时为父订单 id
+	OrderID string
+	// Orders 按购物车结算时的子订单，只在创建（或恢复时找回）订单的那次执行中填充，不落库
+	Orders    []*order_k.Order
+	Error     string
+	CreatedAt int64
+	UpdatedAt int64
+}
+
+// Finished 流程已结束（成功或已补偿）
+func (s *Saga) Finished() bool {
+	return s.Status == StatusCompleted || s.Status == StatusFailed
+}
+
+// stepError 表示业务上的失败（库存不足、订单服务拒绝等），需要补偿，Error() 可以返回给调用方
+type stepError struct {
+	msg string
+}
+
+func (e *stepError) Error() string {
+	return e.msg
+}
@@ -0,0 +1,369 @@
+package checkout
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	pkgProduct "github.com/youperceive/cloudwego_instance/api/pkg/product"
+	"github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/base"
+	order_k "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order"
+	order_service_k "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order/orderservice"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/paytimeout"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/status"
+)
+
+// resumeStaleAfter 超过该时间没有推进的流程才会被恢复，避免抢走其他实例正在执行的流程
+const resumeStaleAfter = time.Minute
+
+// Orchestrator 编排结算流程：以流程 id 预占库存 -> 创建订单 -> 把预占凭证换成订单 id；
+// 任一步业务失败时进入补偿：取消可能已创建的订单，再释放预占的库存。
+// 按购物车结算时一次创建父订单和各商户的子订单，预占按 SKU 换绑到各子订单。
+// 订单支付后由订单接口确认预占，未支付的预占过期后由 sweeper 释放。
+// 每一步推进都先写入 checkout_saga，进程崩溃后由 Resume 接着执行。
+type Orchestrator struct {
+	db     *sql.DB
+	orders order_service_k.Client
+	// reservationTTL 预占的默认有效期，用于不自动取消的订单类型
+	reservationTTL time.Duration
+	// payTimeout 各类型订单的支付期限，与订单服务的 $ORDER_PAY_TIMEOUT 一致
+	payTimeout paytimeout.Config
+}
+
+func NewOrchestrator(db *sql.DB, orders order_service_k.Client, reservationTTL time.Duration, payTimeout paytimeout.Config) *Orchestrator {
+	return &Orchestrator{
+		db:             db,
+		orders:         orders,
+		reservationTTL: reservationTTL,
+		payTimeout:     payTimeout,
+	}
+}
+
+// HoldTTL 返回该类型订单的预占有效期：不短于订单的支付期限，否则订单未超时预占就已释放，支付时库存可能已被他人占用
+func (o *Orchestrator) HoldTTL(orderType int32) time.Duration {
+	return max(o.reservationTTL, o.payTimeout.For(orderType))
+}
+
+func validateRequest(req *Request) error {
+	switch {
+	case req.ReqUserID <= 0:
+		return errors.New("买家ID必须大于 0")
+	case req.FromCart && req.RespUserID != 0:
+		return errors.New("按购物车结算时商户由 SKU 决定，不能指定商户ID")
+	case !req.FromCart && req.RespUserID <= 0:
+		return errors.New("商户ID必须大于 0")
+	}
+	if len(req.Items) == 0 {
+		return errors.New("结算项不能为空")
+	}
+	if req.AddressID < 0 {
+		return errors.New("收货地址ID不合法")
+	}
+	for i, item := range req.Items {
+		if item == nil || item.ProductID <= 0 || item.SkuID <= 0 {
+			return fmt.Errorf("第 %d 个结算项的商品ID和SKU ID必须大于 0", i+1)
+		}
+		if item.Count <= 0 || item.Count > math.MaxInt32 {
+			return fmt.Errorf("第 %d 个结算项的数量不合法", i+1)
+		}
+	}
+	return nil
+}
+
+// Checkout 创建并执行一个结算流程。
+// 参数不合法时返回 ErrInvalidRequest 包装的错误；流程已结束（completed/failed）时 error 为 nil，
+// 由 Saga.Status 区分结果；其他 error 表示流程暂时无法推进，等待 Resume 恢复。
+func (o *Orchestrator) Checkout(ctx context.Context, req *Request) (*Saga, error) {
+	id, err := newSagaID()
+	if err != nil {
+		return nil, err
+	}
+	return o.CheckoutWithID(ctx, id, req)
+}
+
+// CheckoutWithID 与 Checkout 相同，但流程 id 由调用方指定（32 位十六进制），供会重试的调用方（如秒杀队列）使用：
+// 同一 id 的流程已存在时不重复创建，已结束的直接返回；未结束且超过 resumeStaleAfter 没有推进的接着执行，
+// 否则返回 ErrSagaRunning，表示流程正由其他请求或实例执行。
+func (o *Orchestrator) CheckoutWithID(ctx context.Context, id string, req *Request) (*Saga, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRequest, err.Error())
+	}
+	now := time.Now().Unix()
+	s := &Saga{
+		ID:        id,
+		Request:   *req,
+		Status:    StatusReserving,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	inserted, err := insertSaga(ctx, o.db, s)
+	if err != nil {
+		return nil, err
+	}
+	if inserted {
+		return s, o.run(ctx, s, false)
+	}
+
+	s, err = getSaga(ctx, o.db, id)
+	if err != nil {
+		return nil, err
+	}
+	if s.Finished() {
+		return s, nil
+	}
+	if s.UpdatedAt >= time.Now().Add(-resumeStaleAfter).Unix() {
+		return s, ErrSagaRunning
+	}
+	claimed, err := claimSaga(ctx, o.db, s)
+	if err != nil {
+		return s, err
+	}
+	if !claimed {
+		return s, ErrSagaRunning
+	}
+	return s, o.run(ctx, s, true)
+}
+
+// Get 查询结算流程，不存在时返回 ErrSagaNotFound
+func (o *Orchestrator) Get(ctx context.Context, id string) (*Saga, error) {
+	return getSaga(ctx, o.db, id)
+}
+
+// Resume 恢复中断的流程，网关启动时调用
+func (o *Orchestrator) Resume(ctx context.Context) error {
+	sagas, err := listUnfinishedSagas(ctx, o.db, time.Now().Add(-resumeStaleAfter).Unix())
+	if err != nil {
+		return err
+	}
+	for _, s := range sagas {
+		claimed, err := claimSaga(ctx, o.db, s)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			continue
+		}
+		if err := o.run(ctx, s, true); err != nil {
+			log.Printf("checkout: 恢复结算流程 %s 失败: %v", s.ID, err)
+			continue
+		}
+		log.Printf("checkout: 结算流程 %s 已恢复，当前状态 %s", s.ID, s.Status)
+	}
+	return nil
+}
+
+// run 从当前状态推进到 completed 或 failed。resumed 为 true 表示这是中断后的恢复。
+func (o *Orchestrator) run(ctx context.Context, s *Saga, resumed bool) error {
+	for {
+		var err error
+		switch s.Status {
+		case StatusReserving:
+			err = o.reserve(ctx, s)
+			if err == nil {
+				s.Status = StatusCreatingOrder
+			}
+		case StatusCreatingOrder:
+			err = o.createOrder(ctx, s, resumed)
+			if err == nil {
+				s.Status = StatusCompleted
+			}
+		case StatusCompensating:
+			if err := o.compensate(ctx, s); err != nil {
+				return err
+			}
+			s.Status = StatusFailed
+		default:
+			return nil
+		}
+
+		var se *stepError
+		if errors.As(err, &se) {
+			s.Error = se.msg
+			s.Status = StatusCompensating
+		} else if err != nil {
+			return err
+		}
+		if err := saveSaga(ctx, o.db, s); err != nil {
+			return err
+		}
+	}
+}
+
+func (o *Orchestrator) reserve(ctx context.Context, s *Saga) error {
+	items := make([]pkgProduct.ReserveItem, 0, len(s.Request.Items))
+	for _, item := range s.Request.Items {
+		items = append(items, pkgProduct.ReserveItem{SkuID: item.SkuID, Count: int32(item.Count)})
+	}
+	// 同一凭证预占中的 SKU 会被跳过，恢复流程时可以安全重试
+	ttl := max(o.HoldTTL(s.Request.Type), s.Request.HoldTTL)
+	_, err := pkgProduct.ReserveStock(ctx, s.ID, items, ttl)
+	if errors.Is(err, pkgProduct.ErrInsufficientStock) || errors.Is(err, pkgProduct.ErrSkuNotFound) {
+		return &stepError{msg: err.Error()}
+	}
+	return err
+}
+
+func (o *Orchestrator) createOrder(ctx context.Context, s *Saga, resumed bool) error {
+	if s.Request.FromCart {
+		return o.createCartOrders(ctx, s, resumed)
+	}
+	if resumed {
+		// 中断前可能已经创建成功，先按 ext 找回
+		orderID, err := o.findOrder(ctx, s.ID)
+		if err != nil {
+			return err
+		}
+		if orderID != "" {
+			s.OrderID = orderID
+			return pkgProduct.RebindReservation(ctx, s.ID, orderID)
+		}
+		return &stepError{msg: "结算流程中断，订单未创建"}
+	}
+
+	req := s.Request
+	createReq := &order_k.CreateRequest{
+		Type:       req.Type,
+		Status:     status.PendingPayment,
+		ReqUserId:  req.ReqUserID,
+		RespUserId: req.RespUserID,
+		Items:      orderItems(req.Items),
+		Ext:        sagaExt(req.Ext, s.ID),
+		// 以流程 id 作为幂等键，超时重试不会重复创建订单
+		IdempotencyKey: &s.ID,
+	}
+	if req.AddressID > 0 {
+		createReq.AddressId = &req.AddressID
+	}
+	resp, err := o.orders.Create(ctx, createReq)
+	if err != nil {
+		// 结果未知，进入补偿：补偿时会按 ext 找到可能已创建的订单并取消
+		log.Printf("checkout: 结算流程 %s 创建订单失败: %v", s.ID, err)
+		return &stepError{msg: "创建订单失败"}
+	}
+	if resp.BaseResp == nil || resp.BaseResp.Code != base.Code_SUCCESS {
+		msg := "创建订单失败"
+		if resp.BaseResp != nil {
+			msg = resp.BaseResp.Msg
+		}
+		return &stepError{msg: msg}
+	}
+	s.OrderID = resp.OrderId
+	return pkgProduct.RebindReservation(ctx, s.ID, s.OrderID)
+}
+
+// orderItems 把结算项转换为订单服务的订单项
+func orderItems(items []*Item) []*order_k.OrderItemForCreate {
+	result := make([]*order_k.OrderItemForCreate, 0, len(items))
+	for _, it := range items {
+		result = append(result, &order_k.OrderItemForCreate{
+			ProductId: it.ProductID,
+			SkuId:     it.SkuID,
+			Count:     it.Count,
+			Ext:       it.Ext,
+		})
+	}
+	return result
+}
+
+// sagaExt 在请求的 ext 之外记录流程 id
+func sagaExt(reqExt map[string]string, sagaID string) map[string]string {
+	ext := make(map[string]string, len(reqExt)+1)
+	for k, v := range reqExt {
+		ext[k] = v
+	}
+	ext[ExtSagaKey] = sagaID
+	return ext
+}
+
+func (o *Orchestrator) compensate(ctx context.Context, s *Saga) error {
+	var orderIDs []string
+	switch {
+	case s.Request.FromCart:
+		// 子订单在同一事务中创建，要么全部存在要么都不存在
+		ids, err := o.findOrders(ctx, s.ID, maxCartOrders)
+		if err != nil {
+			return err
+		}
+		orderIDs = ids
+	case s.OrderID != "":
+		orderIDs = []string{s.OrderID}
+	default:
+		orderID, err := o.findOrder(ctx, s.ID)
+		if err != nil {
+			return err
+		}
+		if orderID != "" {
+			s.OrderID = orderID
+			orderIDs = []string{orderID}
+		}
+	}
+	for _, orderID := range orderIDs {
+		if err := o.cancelOrder(ctx, orderID); err != nil {
+			return err
+		}
+		if err := pkgProduct.ReleaseReservation(ctx, orderID); err != nil {
+			return err
+		}
+	}
+	return pkgProduct.ReleaseReservation(ctx, s.ID)
+}
+
+func (o *Orchestrator) cancelOrder(ctx context.Context, orderID string) error {
+	cancelled := status.Cancelled
+	resp, err := o.orders.Update(ctx, &order_k.UpdateRequest{
+		Id:     orderID,
+		Status: &cancelled,
+	})
+	if err != nil {
+		return fmt.Errorf("cancelOrder: %w", err)
+	}
+	if resp.BaseResp == nil {
+		return errors.New("cancelOrder: 订单服务返回为空")
+	}
+	switch resp.BaseResp.Code {
+	case base.Code_SUCCESS, base.Code_NOT_FOUND:
+		return nil
+	case base.Code_INVALID_STATUS_TRANSITION:
+		// 已经是取消状态时视为补偿完成，其他状态（如已支付）需要人工介入
+		info, err := o.orders.QueryOrderInfo(ctx, &order_k.QueryOrderInfoRequest{Id: orderID})
+		if err != nil {
+			return fmt.Errorf("cancelOrder: %w", err)
+		}
+		if info.Order != nil && info.Order.Status == status.Cancelled {
+			return nil
+		}
+	}
+	return fmt.Errorf("cancelOrder: 取消订单 %s 失败: %s", orderID, resp.BaseResp.Msg)
+}
+
+// findOrder 按 ext 中的结算流程 id 查找订单，不存在时返回空字符串
+func (o *Orchestrator) findOrder(ctx context.Context, sagaID string) (string, error) {
+	ids, err := o.findOrders(ctx, sagaID, 1)
+	if err != nil || len(ids) == 0 {
+		return "", err
+	}
+	return ids[0], nil
+}
+
+// findOrders 按 ext 中的结算流程 id 查找最多 limit 个订单，按购物车结算时为全部子订单
+func (o *Orchestrator) findOrders(ctx context.Context, sagaID string, limit int32) ([]string, error) {
+	extKey, extVal := ExtSagaKey, sagaID
+	resp, err := o.orders.QueryOrderId(ctx, &order_k.QueryOrderIdRequest{
+		Type:     order_k.QueryOrderIdType_EXT_KEY,
+		ExtKey:   &extKey,
+		ExtVal:   &extVal,
+		Page:     1,
+		PageSize: limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("findOrders: %w", err)
+	}
+	if resp.BaseResp == nil || resp.BaseResp.Code != base.Code_SUCCESS {
+		return nil, errors.New("findOrders: 查询订单失败")
+	}
+	return resp.OrderId, nil
+}
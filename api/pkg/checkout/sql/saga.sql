@@ -0,0 +1,14 @@
+-- 结算流程（saga）状态表，网关重启时据此恢复未完成的流程
+CREATE TABLE `checkout_saga` (
+  `id` char(32) NOT NULL COMMENT '结算流程ID',
+  `req_user_id` bigint NOT NULL COMMENT '买家ID',
+  `payload` json NOT NULL COMMENT '结算请求（订单类型、商户、结算项）',
+  `status` varchar(16) NOT NULL COMMENT 'reserving/creating_order/compensating/completed/failed',
+  `order_id` varchar(24) NOT NULL DEFAULT '' COMMENT '创建成功的订单ID',
+  `error` varchar(255) NOT NULL DEFAULT '' COMMENT '触发补偿的原因',
+  `created_at` bigint NOT NULL,
+  `updated_at` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_status_updated_at` (`status`, `updated_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
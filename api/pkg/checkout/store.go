@@ -0,0 +1,117 @@
+package checkout
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+func newSagaID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// insertSaga 同一 id 的流程已存在时不插入，返回 false
+func insertSaga(ctx context.Context, db *sql.DB, s *Saga) (bool, error) {
+	payload, err := json.Marshal(s.Request)
+	if err != nil {
+		return false, err
+	}
+	result, err := db.ExecContext(ctx, `
+		INSERT IGNORE INTO checkout_saga (id, req_user_id, payload, status, order_id, error, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`, s.ID, s.Request.ReqUserID, payload, s.Status, s.OrderID, s.Error, s.CreatedAt, s.UpdatedAt)
+	if err != nil {
+		return false, fmt.Errorf("insertSaga: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("insertSaga: %w", err)
+	}
+	return n == 1, nil
+}
+
+func getSaga(ctx context.Context, db *sql.DB, id string) (*Saga, error) {
+	var s Saga
+	var payload []byte
+	err := db.QueryRowContext(ctx, `
+		SELECT id, payload, status, order_id, error, created_at, updated_at FROM checkout_saga WHERE id=?
+	`, id).Scan(&s.ID, &payload, &s.Status, &s.OrderID, &s.Error, &s.CreatedAt, &s.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSagaNotFound
+		}
+		return nil, fmt.Errorf("getSaga: %w", err)
+	}
+	if err := json.Unmarshal(payload, &s.Request); err != nil {
+		return nil, fmt.Errorf("getSaga: 解析 payload 失败: %w", err)
+	}
+	return &s, nil
+}
+
+// saveSaga 持久化状态变更，每一步完成后调用，崩溃后从最后保存的状态继续
+func saveSaga(ctx context.Context, db *sql.DB, s *Saga) error {
+	s.UpdatedAt = time.Now().Unix()
+	_, err := db.ExecContext(ctx, `
+		UPDATE checkout_saga SET status=?, order_id=?, error=?, updated_at=? WHERE id=?
+	`, s.Status, s.OrderID, s.Error, s.UpdatedAt, s.ID)
+	if err != nil {
+		return fmt.Errorf("saveSaga: %w", err)
+	}
+	return nil
+}
+
+// claimSaga 以 updated_at 做 compare-and-set，多个网关实例同时恢复时只有一个能拿到
+func claimSaga(ctx context.Context, db *sql.DB, s *Saga) (bool, error) {
+	now := time.Now().Unix()
+	result, err := db.ExecContext(ctx, `
+		UPDATE checkout_saga SET updated_at=? WHERE id=? AND updated_at=?
+	`, now, s.ID, s.UpdatedAt)
+	if err != nil {
+		return false, fmt.Errorf("claimSaga: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("claimSaga: %w", err)
+	}
+	s.UpdatedAt = now
+	return n == 1, nil
+}
+
+// listUnfinishedSagas 查询超过 staleBefore 仍未结束的流程
+func listUnfinishedSagas(ctx context.Context, db *sql.DB, staleBefore int64) ([]*Saga, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT id, payload, status, order_id, error, created_at, updated_at FROM checkout_saga
+		WHERE status IN (?, ?, ?) AND updated_at < ?
+		ORDER BY created_at ASC
+	`, StatusReserving, StatusCreatingOrder, StatusCompensating, staleBefore)
+	if err != nil {
+		return nil, fmt.Errorf("listUnfinishedSagas: %w", err)
+	}
+	defer rows.Close()
+
+	sagas := make([]*Saga, 0)
+	for rows.Next() {
+		var s Saga
+		var payload []byte
+		if err := rows.Scan(&s.ID, &payload, &s.Status, &s.OrderID, &s.Error, &s.CreatedAt, &s.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("listUnfinishedSagas: 解析失败: %w", err)
+		}
+		if err := json.Unmarshal(payload, &s.Request); err != nil {
+			return nil, fmt.Errorf("listUnfinishedSagas: 解析 payload 失败: %w", err)
+		}
+		sagas = append(sagas, &s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listUnfinishedSagas: %w", err)
+	}
+	return sagas, nil
+}
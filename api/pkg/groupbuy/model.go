@@ -0,0 +1,117 @@
+package groupbuy
+
+import (
+	"errors"
+	"time"
+)
+
+// OrderType 团购订单的类型，订单 ext 中记录团 id 和活动 id
+const (
+	OrderType      = 3
+	ExtGroupKey    = "group_buy_id"
+	ExtCampaignKey = "group_campaign_id"
+)
+
+const (
+	// MaxGroupSize 成团人数上限，结算时一次批量查询全部成员订单
+	MaxGroupSize     = 100
+	defaultGroupTTL  = 24 * 60 * 60
+	autoRefundReason = "团购未成团，自动退款"
+)
+
+// orderHoldTTL 团购订单的库存预占至少保留 24 小时（团购订单默认的支付期限），
+// 即使 $ORDER_PAY_TIMEOUT 缩短或关闭了团购订单的超时取消，参团后一天内支付也不会因预占过期而失败
+const orderHoldTTL = 24 * time.Hour
+
+// 团状态
+const (
+	GroupForming   = "forming"
+	GroupSucceeded = "succeeded"
+	GroupFailed    = "failed"
+)
+
+// 成员状态：joining、joined 占用名额；left 为下单失败或未支付订单被取消，释放名额；
+// confirmed、cancelled 为团结束后成员订单的最终处理结果
+const (
+	MemberJoining   = "joining"
+	MemberJoined    = "joined"
+	MemberLeft      = "left"
+	MemberConfirmed = "confirmed"
+	MemberCancelled = "cancelled"
+)
+
+var (
+	ErrInvalidRequest    = errors.New("invalid group-buy request")
+	ErrCampaignNotFound  = errors.New("团购活动不存在")
+	ErrCampaignNotActive = errors.New("团购活动未开始或已结束")
+	ErrGroupNotFound     = errors.New("团不存在")
+	ErrGroupClosed       = errors.New("该团已结束或已过成团期限")
+	ErrGroupFull         = errors.New("该团人数已满")
+	ErrAlreadyJoined     = errors.New("你已经在这个团中")
+)
+
+// Campaign 对应 group_campaign 表的一行
+type Campaign struct {
+	ID         int64
+	MerchantID int64
+	ProductID  int64
+	SkuID      int64
+	GroupSize  int64
+	GroupTTL   int64
+	StartAt    int64
+	EndAt      int64
+	CreatedAt  int64
+}
+
+// Group 对应 group_buy 表的一行，Joined 为占用名额的成员数
+type Group struct {
+	ID           int64
+	CampaignID   int64
+	LeaderUserID int64
+	Status       string
+	GroupSize    int64
+	Joined       int64
+	Paid         int64
+	Deadline     int64
+	Settled      bool
+	CreatedAt    int64
+	UpdatedAt    int64
+	Members      []*Member
+}
+
+// Member 对应 group_member 表的一行，Paid 为查询时从订单服务读取的支付状态
+type Member struct {
+	ID        int64
+	GroupID   int64
+	UserID    int64
+	Count     int64
+	SagaID    string
+	OrderID   string
+	Status    string
+	Paid      bool
+	CreatedAt int64
+	UpdatedAt int64
+}
+
+// holdsSlot 成员是否占用名额
+func (m *Member) holdsSlot() bool {
+	return m.Status == MemberJoining || m.Status == MemberJoined || m.Status == MemberConfirmed
+}
+
+// JoinResult 开团、参团的结果
+type JoinResult struct {
+	GroupID int64
+	SagaID  string
+	OrderID string
+}
+
+// OrderFailedError 参团订单创建失败且已补偿，Error() 可以返回给调用方
+type OrderFailedError struct {
+	GroupID int64
+	SagaID  string
+	Reason  string
+}
+
+func (e *OrderFailedError) Error() string {
+	return e.Reason
+}
@@ -0,0 +1,338 @@
+package groupbuy
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"time"
+
+	pkgCheckout "github.com/youperceive/cloudwego_instance/api/pkg/checkout"
+	pkgProduct "github.com/youperceive/cloudwego_instance/api/pkg/product"
+	"github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/base"
+	order_k "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order"
+	order_service_k "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order/orderservice"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/status"
+)
+
+const maxGroupTTL = 30 * 24 * 60 * 60
+
+// Service 团购：开团、参团各自走一次结算流程生成团购订单；成团与否由 RunWatcher 在后台判定，
+// 成团后把成员订单推进为已成团，未成团时取消订单，已支付的自动退款并退回库存
+type Service struct {
+	db           *sql.DB
+	orders       order_service_k.Client
+	orchestrator *pkgCheckout.Orchestrator
+}
+
+func NewService(db *sql.DB, orders order_service_k.Client, orchestrator *pkgCheckout.Orchestrator) *Service {
+	return &Service{
+		db:           db,
+		orders:       orders,
+		orchestrator: orchestrator,
+	}
+}
+
+func invalid(msg string) error {
+	return fmt.Errorf("%w: %s", ErrInvalidRequest, msg)
+}
+
+func newSagaID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// CreateCampaign 商户为自己的 SKU 创建团购活动，GroupTTL 为 0 时使用默认的 24 小时
+func (s *Service) CreateCampaign(ctx context.Context, merchantID int64, c *Campaign) (*Campaign, error) {
+	now := time.Now().Unix()
+	ttl := c.GroupTTL
+	if ttl == 0 {
+		ttl = defaultGroupTTL
+	}
+	switch {
+	case c.GroupSize < 2 || c.GroupSize > MaxGroupSize:
+		return nil, invalid(fmt.Sprintf("成团人数必须在 2 到 %d 之间", MaxGroupSize))
+	case ttl <= 0 || ttl > maxGroupTTL:
+		return nil, invalid("成团期限必须在 1 秒到 30 天之间")
+	case c.EndAt <= c.StartAt || c.EndAt <= now:
+		return nil, invalid("结束时间必须晚于开始时间和当前时间")
+	}
+
+	sku, err := pkgProduct.GetSku(ctx, c.SkuID)
+	if err != nil {
+		if errors.Is(err, pkgProduct.ErrSkuNotFound) {
+			return nil, invalid(err.Error())
+		}
+		return nil, err
+	}
+	if sku.MerchantID != merchantID {
+		return nil, invalid("只能为自己的 SKU 创建团购活动")
+	}
+
+	campaign := &Campaign{
+		MerchantID: merchantID,
+		ProductID:  sku.ProductID,
+		SkuID:      sku.ID,
+		GroupSize:  c.GroupSize,
+		GroupTTL:   ttl,
+		StartAt:    c.StartAt,
+		EndAt:      c.EndAt,
+		CreatedAt:  now,
+	}
+	if err := insertCampaign(ctx, s.db, campaign); err != nil {
+		return nil, err
+	}
+	return campaign, nil
+}
+
+// ListCampaigns 查询未结束的活动，merchantID 为 0 时查询所有商户
+func (s *Service) ListCampaigns(ctx context.Context, merchantID int64) ([]*Campaign, error) {
+	return listCampaigns(ctx, s.db, merchantID, time.Now().Unix())
+}
+
+func validCount(count int64) error {
+	if count <= 0 || count > math.MaxInt32 {
+		return invalid("购买数量不合法")
+	}
+	return nil
+}
+
+// Open 开团：创建团并以开团人为第一个成员下单
+func (s *Service) Open(ctx context.Context, userID, campaignID, count int64, ext map[string]string) (*JoinResult, error) {
+	if err := validCount(count); err != nil {
+		return nil, err
+	}
+	c, err := getCampaign(ctx, s.db, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().Unix()
+	if now < c.StartAt || now >= c.EndAt {
+		return nil, ErrCampaignNotActive
+	}
+	sagaID, err := newSagaID()
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Member{UserID: userID, Count: count, SagaID: sagaID, Status: MemberJoining, CreatedAt: now, UpdatedAt: now}
+	err = s.inTx(ctx, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, `
+			INSERT INTO group_buy (campaign_id, leader_user_id, status, group_size, deadline, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+		`, c.ID, userID, GroupForming, c.GroupSize, now+c.GroupTTL, now, now)
+		if err != nil {
+			return fmt.Errorf("Open: 创建团失败: %w", err)
+		}
+		if m.GroupID, err = result.LastInsertId(); err != nil {
+			return fmt.Errorf("Open: %w", err)
+		}
+		return insertMember(ctx, tx, m)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.placeOrder(ctx, c, m, ext, true)
+}
+
+// Join 参团：锁住团行后校验状态、期限和剩余名额，占用名额后下单
+func (s *Service) Join(ctx context.Context, userID, groupID, count int64, ext map[string]string) (*JoinResult, error) {
+	if err := validCount(count); err != nil {
+		return nil, err
+	}
+	sagaID, err := newSagaID()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Unix()
+	m := &Member{GroupID: groupID, UserID: userID, Count: count, SagaID: sagaID, Status: MemberJoining, CreatedAt: now, UpdatedAt: now}
+	var campaignID int64
+	err = s.inTx(ctx, func(tx *sql.Tx) error {
+		g, err := getGroup(ctx, tx, groupID, true)
+		if err != nil {
+			return err
+		}
+		if g.Status != GroupForming || g.Deadline <= now {
+			return ErrGroupClosed
+		}
+		members, err := listMembers(ctx, tx, groupID)
+		if err != nil {
+			return err
+		}
+		var slots int64
+		for _, other := range members {
+			if !other.holdsSlot() {
+				continue
+			}
+			if other.UserID == userID {
+				return ErrAlreadyJoined
+			}
+			slots++
+		}
+		if slots >= g.GroupSize {
+			return ErrGroupFull
+		}
+		campaignID = g.CampaignID
+		return insertMember(ctx, tx, m)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := getCampaign(ctx, s.db, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	return s.placeOrder(ctx, c, m, ext, false)
+}
+
+// placeOrder 以成员的 saga_id 走结算流程下单。流程未结束时返回 error，成员保持 joining，由 RunWatcher 跟进
+func (s *Service) placeOrder(ctx context.Context, c *Campaign, m *Member, ext map[string]string, leader bool) (*JoinResult, error) {
+	orderExt := make(map[string]string, len(ext)+2)
+	for k, v := range ext {
+		orderExt[k] = v
+	}
+	orderExt[ExtGroupKey] = strconv.FormatInt(m.GroupID, 10)
+	orderExt[ExtCampaignKey] = strconv.FormatInt(c.ID, 10)
+
+	res := &JoinResult{GroupID: m.GroupID, SagaID: m.SagaID}
+	saga, err := s.orchestrator.CheckoutWithID(ctx, m.SagaID, &pkgCheckout.Request{
+		Type:       OrderType,
+		ReqUserID:  m.UserID,
+		RespUserID: c.MerchantID,
+		Items: []*pkgCheckout.Item{{
+			ProductID: c.ProductID,
+			SkuID:     c.SkuID,
+			Count:     m.Count,
+		}},
+		Ext:     orderExt,
+		HoldTTL: orderHoldTTL,
+	})
+	switch {
+	case errors.Is(err, pkgCheckout.ErrInvalidRequest):
+		s.leave(ctx, m, leader)
+		return nil, err
+	case err != nil:
+		return res, err
+	case saga.Status != pkgCheckout.StatusCompleted:
+		s.leave(ctx, m, leader)
+		return nil, &OrderFailedError{GroupID: m.GroupID, SagaID: m.SagaID, Reason: saga.Error}
+	}
+
+	res.OrderID = saga.OrderID
+	if _, err := updateMember(ctx, s.db, m, MemberJoining, MemberJoined, saga.OrderID, time.Now().Unix()); err != nil {
+		// 订单已创建，后台会按结算流程的结果补上
+		log.Printf("groupbuy: 团 %d 成员 %d 记录订单 %s 失败: %v", m.GroupID, m.ID, saga.OrderID, err)
+	}
+	return res, nil
+}
+
+// leave 下单失败，成员退出并释放名额；开团人下单失败且团里没有其他成员时直接结束该团
+func (s *Service) leave(ctx context.Context, m *Member, leader bool) {
+	now := time.Now().Unix()
+	if _, err := updateMember(ctx, s.db, m, MemberJoining, MemberLeft, "", now); err != nil {
+		log.Printf("groupbuy: 团 %d 成员 %d 退出失败: %v", m.GroupID, m.ID, err)
+		return
+	}
+	if !leader {
+		return
+	}
+	_, err := s.db.ExecContext(ctx, `
+		UPDATE group_buy SET status=?, settled=1, updated_at=?
+		WHERE id=? AND status=? AND NOT EXISTS (
+			SELECT 1 FROM group_member WHERE group_id=? AND status IN (?, ?)
+		)
+	`, GroupFailed, now, m.GroupID, GroupForming, m.GroupID, MemberJoining, MemberJoined)
+	if err != nil {
+		log.Printf("groupbuy: 结束团 %d 失败: %v", m.GroupID, err)
+	}
+}
+
+// ListGroups 查询活动下拼团中的团
+func (s *Service) ListGroups(ctx context.Context, campaignID int64) ([]*Group, error) {
+	return listFormingGroups(ctx, s.db, campaignID, time.Now().Unix())
+}
+
+// Query 查询团的进度，已支付人数从订单服务实时读取；已退出的成员不返回
+func (s *Service) Query(ctx context.Context, groupID int64) (*Group, error) {
+	g, err := getGroup(ctx, s.db, groupID, false)
+	if err != nil {
+		return nil, err
+	}
+	members, err := listMembers(ctx, s.db, groupID)
+	if err != nil {
+		return nil, err
+	}
+	orders, err := s.loadOrders(ctx, members)
+	if err != nil {
+		return nil, err
+	}
+
+	g.Joined, g.Paid = 0, 0
+	for _, m := range members {
+		if m.Status == MemberLeft {
+			continue
+		}
+		if o := orders[m.OrderID]; o != nil {
+			m.Paid = o.Status == status.Paid || o.Status == status.Confirmed || o.Status == status.Completed
+		}
+		if m.holdsSlot() {
+			g.Joined++
+			if m.Paid {
+				g.Paid++
+			}
+		}
+		g.Members = append(g.Members, m)
+	}
+	return g, nil
+}
+
+// loadOrders 批量查询成员的订单，返回订单 id 到订单的映射
+func (s *Service) loadOrders(ctx context.Context, members []*Member) (map[string]*order_k.Order, error) {
+	ids := make([]string, 0, len(members))
+	for _, m := range members {
+		if m.OrderID != "" && m.Status != MemberLeft {
+			ids = append(ids, m.OrderID)
+		}
+	}
+	orders := make(map[string]*order_k.Order, len(ids))
+	if len(ids) == 0 {
+		return orders, nil
+	}
+	resp, err := s.orders.BatchQueryOrderInfo(ctx, &order_k.BatchQueryOrderInfoRequest{Ids: ids})
+	if err != nil {
+		return nil, fmt.Errorf("groupbuy: 查询成员订单失败: %w", err)
+	}
+	if resp.BaseResp == nil || resp.BaseResp.Code != base.Code_SUCCESS {
+		return nil, fmt.Errorf("groupbuy: 查询成员订单失败: %v", resp.BaseResp)
+	}
+	for _, o := range resp.Orders {
+		orders[o.Id] = o
+	}
+	return orders, nil
+}
+
+func (s *Service) inTx(ctx context.Context, fn func(tx *sql.Tx) error) (err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("groupbuy: 开启事务失败: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	if err = fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
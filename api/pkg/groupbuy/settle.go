@@ -0,0 +1,330 @@
+package groupbuy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	pkgCheckout "github.com/youperceive/cloudwego_instance/api/pkg/checkout"
+	pkgProduct "github.com/youperceive/cloudwego_instance/api/pkg/product"
+	"github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/base"
+	order_k "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/status"
+)
+
+const (
+	// settleLease 处理一个团时持有的租约，期间其他网关实例不会处理该团
+	settleLease = time.Minute
+	settleBatch = 100
+	// orphanAfter 成员下单超过该时间仍查不到结算流程（进程在创建流程前退出），视为下单失败
+	orphanAfter = 5 * time.Minute
+)
+
+// RunWatcher 每隔 interval 处理一批未结算的团，直到 ctx 结束
+func (s *Service) RunWatcher(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		ids, err := s.claimGroups(ctx)
+		if err != nil {
+			log.Printf("groupbuy: 领取待结算的团失败: %v", err)
+			continue
+		}
+		for _, id := range ids {
+			if err := s.settle(ctx, id); err != nil {
+				log.Printf("groupbuy: 结算团 %d 失败: %v", id, err)
+			}
+		}
+	}
+}
+
+// claimGroups 以 locked_until 做 compare-and-set 领取未结算的团
+func (s *Service) claimGroups(ctx context.Context) ([]int64, error) {
+	now := time.Now().Unix()
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, locked_until FROM group_buy WHERE settled=0 AND locked_until < ? ORDER BY id ASC LIMIT ?
+	`, now, settleBatch)
+	if err != nil {
+		return nil, fmt.Errorf("claimGroups: %w", err)
+	}
+	type candidate struct{ id, lockedUntil int64 }
+	var candidates []candidate
+	for rows.Next() {
+		var c candidate
+		if err := rows.Scan(&c.id, &c.lockedUntil); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("claimGroups: 解析失败: %w", err)
+		}
+		candidates = append(candidates, c)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("claimGroups: %w", err)
+	}
+
+	ids := make([]int64, 0, len(candidates))
+	until := time.Now().Add(settleLease).Unix()
+	for _, c := range candidates {
+		result, err := s.db.ExecContext(ctx, `
+			UPDATE group_buy SET locked_until=? WHERE id=? AND locked_until=?
+		`, until, c.id, c.lockedUntil)
+		if err != nil {
+			return ids, fmt.Errorf("claimGroups: %w", err)
+		}
+		if n, _ := result.RowsAffected(); n == 1 {
+			ids = append(ids, c.id)
+		}
+	}
+	return ids, nil
+}
+
+// settle 推进一个团：同步下单中的成员，拼团中的团判定成团或过期，
+// 已结束的团逐个处理成员订单，全部处理完后标记 settled
+func (s *Service) settle(ctx context.Context, groupID int64) error {
+	g, err := getGroup(ctx, s.db, groupID, false)
+	if err != nil {
+		return err
+	}
+	members, err := listMembers(ctx, s.db, groupID)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+
+	for _, m := range members {
+		if m.Status == MemberJoining {
+			if err := s.syncJoining(ctx, m, now); err != nil {
+				return err
+			}
+		}
+	}
+	orders, err := s.loadOrders(ctx, members)
+	if err != nil {
+		return err
+	}
+
+	if g.Status == GroupForming {
+		var paid int64
+		for _, m := range members {
+			o := orders[m.OrderID]
+			if m.Status != MemberJoined || o == nil {
+				continue
+			}
+			switch o.Status {
+			case status.Cancelled:
+				// 未支付超时被取消，释放名额
+				if _, err := updateMember(ctx, s.db, m, MemberJoined, MemberLeft, m.OrderID, now.Unix()); err != nil {
+					return err
+				}
+			case status.Paid, status.Confirmed:
+				paid++
+			}
+		}
+
+		next := ""
+		switch {
+		case paid >= g.GroupSize:
+			next = GroupSucceeded
+		case g.Deadline <= now.Unix():
+			next = GroupFailed
+		}
+		result, err := s.db.ExecContext(ctx, `
+			UPDATE group_buy SET paid=?, status=IF(?='', status, ?), updated_at=? WHERE id=? AND status=?
+		`, paid, next, next, now.Unix(), g.ID, GroupForming)
+		if err != nil {
+			return fmt.Errorf("settle: 更新团状态失败: %w", err)
+		}
+		if n, _ := result.RowsAffected(); n != 1 || next == "" {
+			return nil
+		}
+		g.Status = next
+		log.Printf("groupbuy: 团 %d %s，已支付 %d/%d", g.ID, next, paid, g.GroupSize)
+	}
+
+	unsettled := false
+	for _, m := range members {
+		switch m.Status {
+		case MemberJoining:
+			unsettled = true
+			continue
+		case MemberJoined:
+		default:
+			continue
+		}
+		o := orders[m.OrderID]
+		if o == nil {
+			return fmt.Errorf("settle: 成员 %d 的订单 %s 不存在", m.ID, m.OrderID)
+		}
+		if g.Status == GroupSucceeded {
+			err = s.confirmMember(ctx, m, o)
+		} else {
+			err = s.cancelMember(ctx, m, o)
+		}
+		if err != nil {
+			return fmt.Errorf("settle: 处理成员 %d 的订单 %s 失败: %w", m.ID, m.OrderID, err)
+		}
+	}
+	if unsettled {
+		return nil
+	}
+	_, err = s.db.ExecContext(ctx, `UPDATE group_buy SET settled=1, updated_at=? WHERE id=?`, now.Unix(), g.ID)
+	if err != nil {
+		return fmt.Errorf("settle: %w", err)
+	}
+	return nil
+}
+
+// syncJoining 按结算流程的结果更新下单中的成员
+func (s *Service) syncJoining(ctx context.Context, m *Member, now time.Time) error {
+	saga, err := s.orchestrator.Get(ctx, m.SagaID)
+	switch {
+	case errors.Is(err, pkgCheckout.ErrSagaNotFound):
+		if time.Unix(m.CreatedAt, 0).Add(orphanAfter).After(now) {
+			return nil
+		}
+		_, err = updateMember(ctx, s.db, m, MemberJoining, MemberLeft, "", now.Unix())
+		return err
+	case err != nil:
+		return err
+	case saga.Status == pkgCheckout.StatusCompleted:
+		_, err = updateMember(ctx, s.db, m, MemberJoining, MemberJoined, saga.OrderID, now.Unix())
+		return err
+	case saga.Status == pkgCheckout.StatusFailed:
+		_, err = updateMember(ctx, s.db, m, MemberJoining, MemberLeft, "", now.Unix())
+		return err
+	}
+	return nil
+}
+
+// confirmMember 成团：已支付的订单推进为已成团；已被取消的订单（支付超时）释放名额
+func (s *Service) confirmMember(ctx context.Context, m *Member, o *order_k.Order) error {
+	next := MemberConfirmed
+	switch o.Status {
+	case status.Paid:
+		if err := s.transit(ctx, o.Id, status.Confirmed); err != nil {
+			return err
+		}
+	case status.Confirmed, status.Completed:
+	case status.Cancelled:
+		next = MemberLeft
+	default:
+		// 成团时名额都已支付，不应出现待支付的成员订单，按未成团处理
+		return s.cancelMember(ctx, m, o)
+	}
+	_, err := updateMember(ctx, s.db, m, MemberJoined, next, m.OrderID, time.Now().Unix())
+	return err
+}
+
+// cancelMember 未成团：取消订单，已支付的先全额退款并退回库存，未支付的释放库存预占
+func (s *Service) cancelMember(ctx context.Context, m *Member, o *order_k.Order) error {
+	switch o.Status {
+	case status.PendingPayment:
+		if err := s.transit(ctx, o.Id, status.Cancelled); err != nil {
+			return err
+		}
+		if err := pkgProduct.ReleaseReservation(ctx, o.Id); err != nil {
+			return err
+		}
+	case status.Paid, status.Confirmed:
+		if err := s.autoRefund(ctx, o); err != nil {
+			return err
+		}
+		if err := s.transit(ctx, o.Id, status.Cancelled); err != nil {
+			return err
+		}
+	}
+	_, err := updateMember(ctx, s.db, m, MemberJoined, MemberCancelled, m.OrderID, time.Now().Unix())
+	return err
+}
+
+// autoRefund 为订单中尚未退款的部分申请退款并以商户身份同意，然后退回库存。
+// 已有的自动退款单会被复用，重复调用只退一次
+func (s *Service) autoRefund(ctx context.Context, o *order_k.Order) error {
+	var refund *order_k.Refund
+	refunded := make(map[int64]int64)
+	for _, r := range o.Refunds {
+		if r.Status == order_k.RefundStatus_REJECTED {
+			continue
+		}
+		if r.Reason == autoRefundReason {
+			refund = r
+		}
+		for _, it := range r.Items {
+			refunded[it.ItemId] += it.Count
+		}
+	}
+
+	if refund == nil {
+		var items []*order_k.RefundItemForRequest
+		for _, it := range o.Items {
+			if left := it.Count - refunded[it.Id]; left > 0 {
+				items = append(items, &order_k.RefundItemForRequest{ItemId: it.Id, Count: left})
+			}
+		}
+		if len(items) == 0 {
+			return nil
+		}
+		resp, err := s.orders.RequestRefund(ctx, &order_k.RequestRefundRequest{
+			OrderId:   o.Id,
+			ReqUserId: o.ReqUserId,
+			Items:     items,
+			Reason:    autoRefundReason,
+		})
+		if err != nil {
+			return fmt.Errorf("autoRefund: %w", err)
+		}
+		if resp.BaseResp == nil || resp.BaseResp.Code != base.Code_SUCCESS {
+			return fmt.Errorf("autoRefund: 申请退款失败: %v", resp.BaseResp)
+		}
+		refund = &order_k.Refund{Id: resp.RefundId, Status: order_k.RefundStatus_REQUESTED}
+	}
+
+	if refund.Status == order_k.RefundStatus_REQUESTED {
+		resp, err := s.orders.ReviewRefund(ctx, &order_k.ReviewRefundRequest{
+			OrderId:    o.Id,
+			RefundId:   refund.Id,
+			RespUserId: o.RespUserId,
+			Approve:    true,
+		})
+		if err != nil {
+			return fmt.Errorf("autoRefund: %w", err)
+		}
+		if resp.BaseResp == nil || resp.BaseResp.Code != base.Code_SUCCESS {
+			return fmt.Errorf("autoRefund: 同意退款失败: %v", resp.BaseResp)
+		}
+		refund = resp.Refund
+	}
+
+	// 与 /review_refund 使用同一凭证，库存只会退回一次
+	items := make([]pkgProduct.ReserveItem, 0, len(refund.Items))
+	for _, it := range refund.Items {
+		items = append(items, pkgProduct.ReserveItem{SkuID: it.SkuId, Count: int32(it.Count)})
+	}
+	return pkgProduct.ReturnStock(ctx, fmt.Sprintf("refund:%d", refund.Id), items)
+}
+
+// transit 推进订单状态，订单已处于目标状态时视为成功
+func (s *Service) transit(ctx context.Context, orderID string, to int32) error {
+	resp, err := s.orders.Update(ctx, &order_k.UpdateRequest{Id: orderID, Status: &to})
+	if err != nil {
+		return fmt.Errorf("transit: %w", err)
+	}
+	if resp.BaseResp == nil {
+		return errors.New("transit: 订单服务返回为空")
+	}
+	if resp.BaseResp.Code == base.Code_SUCCESS {
+		return nil
+	}
+	info, err := s.orders.QueryOrderInfo(ctx, &order_k.QueryOrderInfoRequest{Id: orderID})
+	if err == nil && info.Order != nil && info.Order.Status == to {
+		return nil
+	}
+	return fmt.Errorf("transit: 订单 %s 变更为 %d 失败: %s", orderID, to, resp.BaseResp.Msg)
+}
@@ -0,0 +1,49 @@
+-- 团购活动
+CREATE TABLE `group_campaign` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `merchant_id` bigint NOT NULL COMMENT '商户ID',
+  `product_id` bigint NOT NULL,
+  `sku_id` bigint NOT NULL,
+  `group_size` int NOT NULL COMMENT '成团人数',
+  `group_ttl` bigint NOT NULL COMMENT '成团期限（秒），从开团时算起',
+  `start_at` bigint NOT NULL COMMENT '开始时间（unix 秒）',
+  `end_at` bigint NOT NULL COMMENT '结束时间（unix 秒），之后不能再开团',
+  `created_at` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_end_at` (`end_at`),
+  KEY `idx_merchant_end_at` (`merchant_id`, `end_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+-- 团：参团时锁住该行，保证参团人数不超过成团人数
+CREATE TABLE `group_buy` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `campaign_id` bigint NOT NULL,
+  `leader_user_id` bigint NOT NULL COMMENT '开团人',
+  `status` varchar(16) NOT NULL COMMENT 'forming/succeeded/failed',
+  `group_size` int NOT NULL COMMENT '成团人数，冗余自活动',
+  `paid` int NOT NULL DEFAULT '0' COMMENT '已支付人数，由后台结算时统计',
+  `deadline` bigint NOT NULL COMMENT '成团期限（unix 秒）',
+  `settled` tinyint NOT NULL DEFAULT '0' COMMENT '1=成员订单已全部处理完毕',
+  `locked_until` bigint NOT NULL DEFAULT '0' COMMENT '后台结算的租约，避免多个网关实例同时处理',
+  `created_at` bigint NOT NULL,
+  `updated_at` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_campaign_status_deadline` (`campaign_id`, `status`, `deadline`),
+  KEY `idx_settled_locked_until` (`settled`, `locked_until`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+-- 团成员：每个成员对应一个结算流程（saga_id）和一个团购订单
+CREATE TABLE `group_member` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `group_id` bigint NOT NULL,
+  `user_id` bigint NOT NULL,
+  `count` int NOT NULL COMMENT '购买数量',
+  `saga_id` char(32) NOT NULL COMMENT '结算流程ID，参团时生成',
+  `order_id` varchar(24) NOT NULL DEFAULT '' COMMENT '订单创建成功后写入',
+  `status` varchar(16) NOT NULL COMMENT 'joining/joined/left/confirmed/cancelled',
+  `created_at` bigint NOT NULL,
+  `updated_at` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_saga_id` (`saga_id`),
+  KEY `idx_group_user` (`group_id`, `user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
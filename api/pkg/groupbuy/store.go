@@ -0,0 +1,194 @@
+package groupbuy
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+type scanner interface{ Scan(...any) error }
+
+const campaignColumns = "id, merchant_id, product_id, sku_id, group_size, group_ttl, start_at, end_at, created_at"
+
+func scanCampaign(row scanner) (*Campaign, error) {
+	var c Campaign
+	err := row.Scan(&c.ID, &c.MerchantID, &c.ProductID, &c.SkuID, &c.GroupSize, &c.GroupTTL, &c.StartAt, &c.EndAt, &c.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func insertCampaign(ctx context.Context, db *sql.DB, c *Campaign) error {
+	result, err := db.ExecContext(ctx, `
+		INSERT INTO group_campaign (merchant_id, product_id, sku_id, group_size, group_ttl, start_at, end_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`, c.MerchantID, c.ProductID, c.SkuID, c.GroupSize, c.GroupTTL, c.StartAt, c.EndAt, c.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("insertCampaign: %w", err)
+	}
+	c.ID, err = result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("insertCampaign: %w", err)
+	}
+	return nil
+}
+
+func getCampaign(ctx context.Context, db *sql.DB, id int64) (*Campaign, error) {
+	c, err := scanCampaign(db.QueryRowContext(ctx,
+		`SELECT `+campaignColumns+` FROM group_campaign WHERE id=?`, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrCampaignNotFound
+		}
+		return nil, fmt.Errorf("getCampaign: %w", err)
+	}
+	return c, nil
+}
+
+// listCampaigns 查询 endAfter 之后结束的活动，merchantID 为 0 时不按商户过滤
+func listCampaigns(ctx context.Context, db *sql.DB, merchantID, endAfter int64) ([]*Campaign, error) {
+	query := `SELECT ` + campaignColumns + ` FROM group_campaign WHERE end_at > ?`
+	args := []any{endAfter}
+	if merchantID != 0 {
+		query += ` AND merchant_id = ?`
+		args = append(args, merchantID)
+	}
+	rows, err := db.QueryContext(ctx, query+` ORDER BY start_at ASC, id ASC`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("listCampaigns: %w", err)
+	}
+	defer rows.Close()
+
+	campaigns := make([]*Campaign, 0)
+	for rows.Next() {
+		c, err := scanCampaign(rows)
+		if err != nil {
+			return nil, fmt.Errorf("listCampaigns: 解析失败: %w", err)
+		}
+		campaigns = append(campaigns, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listCampaigns: %w", err)
+	}
+	return campaigns, nil
+}
+
+const groupColumns = "id, campaign_id, leader_user_id, status, group_size, paid, deadline, settled, created_at, updated_at"
+
+func scanGroup(row scanner) (*Group, error) {
+	var g Group
+	err := row.Scan(&g.ID, &g.CampaignID, &g.LeaderUserID, &g.Status, &g.GroupSize, &g.Paid, &g.Deadline, &g.Settled, &g.CreatedAt, &g.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &g, nil
+}
+
+func getGroup(ctx context.Context, q interface {
+	QueryRowContext(context.Context, string, ...any) *sql.Row
+}, id int64, forUpdate bool) (*Group, error) {
+	query := `SELECT ` + groupColumns + ` FROM group_buy WHERE id=?`
+	if forUpdate {
+		query += ` FOR UPDATE`
+	}
+	g, err := scanGroup(q.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrGroupNotFound
+		}
+		return nil, fmt.Errorf("getGroup: %w", err)
+	}
+	return g, nil
+}
+
+// listFormingGroups 查询活动下拼团中、未过期限的团，Joined 为占用名额的成员数
+func listFormingGroups(ctx context.Context, db *sql.DB, campaignID, now int64) ([]*Group, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT g.id, g.campaign_id, g.leader_user_id, g.status, g.group_size, g.paid, g.deadline, g.settled, g.created_at, g.updated_at,
+			(SELECT COUNT(*) FROM group_member m WHERE m.group_id = g.id AND m.status IN (?, ?, ?))
+		FROM group_buy g
+		WHERE g.campaign_id = ? AND g.status = ? AND g.deadline > ?
+		ORDER BY g.deadline ASC, g.id ASC
+	`, MemberJoining, MemberJoined, MemberConfirmed, campaignID, GroupForming, now)
+	if err != nil {
+		return nil, fmt.Errorf("listFormingGroups: %w", err)
+	}
+	defer rows.Close()
+
+	groups := make([]*Group, 0)
+	for rows.Next() {
+		var g Group
+		err := rows.Scan(&g.ID, &g.CampaignID, &g.LeaderUserID, &g.Status, &g.GroupSize, &g.Paid, &g.Deadline, &g.Settled, &g.CreatedAt, &g.UpdatedAt, &g.Joined)
+		if err != nil {
+			return nil, fmt.Errorf("listFormingGroups: 解析失败: %w", err)
+		}
+		groups = append(groups, &g)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listFormingGroups: %w", err)
+	}
+	return groups, nil
+}
+
+const memberColumns = "id, group_id, user_id, count, saga_id, order_id, status, created_at, updated_at"
+
+func listMembers(ctx context.Context, q interface {
+	QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+}, groupID int64) ([]*Member, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT `+memberColumns+` FROM group_member WHERE group_id=? ORDER BY id ASC
+	`, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("listMembers: %w", err)
+	}
+	defer rows.Close()
+
+	members := make([]*Member, 0)
+	for rows.Next() {
+		var m Member
+		err := rows.Scan(&m.ID, &m.GroupID, &m.UserID, &m.Count, &m.SagaID, &m.OrderID, &m.Status, &m.CreatedAt, &m.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("listMembers: 解析失败: %w", err)
+		}
+		members = append(members, &m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listMembers: %w", err)
+	}
+	return members, nil
+}
+
+func insertMember(ctx context.Context, tx *sql.Tx, m *Member) error {
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO group_member (group_id, user_id, count, saga_id, order_id, status, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`, m.GroupID, m.UserID, m.Count, m.SagaID, m.OrderID, m.Status, m.CreatedAt, m.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("insertMember: %w", err)
+	}
+	m.ID, err = result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("insertMember: %w", err)
+	}
+	return nil
+}
+
+// updateMember 以当前状态为条件更新成员，返回是否更新成功
+func updateMember(ctx context.Context, db *sql.DB, m *Member, from, to, orderID string, now int64) (bool, error) {
+	result, err := db.ExecContext(ctx, `
+		UPDATE group_member SET status=?, order_id=?, updated_at=? WHERE id=? AND status=?
+	`, to, orderID, now, m.ID, from)
+	if err != nil {
+		return false, fmt.Errorf("updateMember: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("updateMember: %w", err)
+	}
+	if n == 1 {
+		m.Status, m.OrderID, m.UpdatedAt = to, orderID, now
+	}
+	return n == 1, nil
+}
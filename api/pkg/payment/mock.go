@@ -0,0 +1,166 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	MockProviderName = "mock"
+	// MockSignatureHeader 回调签名：hex(HMAC-SHA256(secret, body))
+	MockSignatureHeader = "X-Mock-Signature"
+
+	defaultMockCallbackBaseURL = "http://127.0.0.1:8888"
+	defaultMockDelay           = 3 * time.Second
+	mockCallbackAttempts       = 5
+)
+
+// mockNotification 模拟渠道回调的请求体
+type mockNotification struct {
+	IntentID string `json:"intent_id"`
+	TxnID    string `json:"txn_id"`
+	Amount   int64  `json:"amount"`
+	Status   string `json:"status"` // success / fail
+}
+
+// MockProvider 本地模拟支付渠道：下单后等待 delay，向网关回调地址发送签名的支付成功通知，
+// 非 2xx 响应时按指数退避重试，模拟真实渠道的重复通知。
+type MockProvider struct {
+	secret      []byte
+	callbackURL string
+	delay       time.Duration
+	client      *http.Client
+}
+
+func NewMockProvider(secret, callbackBaseURL string, delay time.Duration) *MockProvider {
+	return &MockProvider{
+		secret:      []byte(secret),
+		callbackURL: strings.TrimRight(callbackBaseURL, "/") + "/payment_callback/" + MockProviderName,
+		delay:       delay,
+		client:      &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// MockEnabledFromEnv 读取 $PAYMENT_MOCK_ENABLED，只有显式开启时网关才注册模拟渠道
+func MockEnabledFromEnv() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv("PAYMENT_MOCK_ENABLED"))
+	return enabled
+}
+
+// NewMockProviderFromEnv 读取 $PAYMENT_MOCK_SECRET（必填）、$PAYMENT_CALLBACK_BASE_URL（默认本机网关）和 $PAYMENT_MOCK_DELAY（默认 3s）。
+// 未配置密钥时返回错误，不使用默认密钥，否则任何人都能伪造支付成功的回调
+func NewMockProviderFromEnv() (*MockProvider, error) {
+	secret := os.Getenv("PAYMENT_MOCK_SECRET")
+	if secret == "" {
+		return nil, errors.New("payment: 已开启模拟渠道，但 PAYMENT_MOCK_SECRET 未配置")
+	}
+	baseURL := os.Getenv("PAYMENT_CALLBACK_BASE_URL")
+	if baseURL == "" {
+		baseURL = defaultMockCallbackBaseURL
+	}
+	delay := defaultMockDelay
+	if v := os.Getenv("PAYMENT_MOCK_DELAY"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			log.Printf("payment: PAYMENT_MOCK_DELAY=%q 格式错误，使用默认值 %s", v, defaultMockDelay)
+		} else {
+			delay = d
+		}
+	}
+	return NewMockProvider(secret, baseURL, delay), nil
+}
+
+func (m *MockProvider) Name() string {
+	return MockProviderName
+}
+
+func (m *MockProvider) CreateCharge(_ context.Context, intent *Intent) (string, error) {
+	txnID, err := newID()
+	if err != nil {
+		return "", err
+	}
+	n := mockNotification{
+		IntentID: intent.ID,
+		TxnID:    "mock_" + txnID,
+		Amount:   intent.Amount,
+		Status:   "success",
+	}
+	go m.notify(n)
+	return "mock://pay/" + intent.ID, nil
+}
+
+func (m *MockProvider) mac(body []byte) []byte {
+	mac := hmac.New(sha256.New, m.secret)
+	mac.Write(body)
+	return mac.Sum(nil)
+}
+
+// notify 模拟用户完成支付后渠道发出的异步通知
+func (m *MockProvider) notify(n mockNotification) {
+	time.Sleep(m.delay)
+	body, err := json.Marshal(n)
+	if err != nil {
+		log.Printf("payment: mock 回调序列化失败: %v", err)
+		return
+	}
+	backoff := time.Second
+	for attempt := 1; attempt <= mockCallbackAttempts; attempt++ {
+		err = m.post(body)
+		if err == nil {
+			return
+		}
+		log.Printf("payment: mock 回调支付单 %s 第 %d 次失败: %v", n.IntentID, attempt, err)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+func (m *MockProvider) post(body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, m.callbackURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(MockSignatureHeader, hex.EncodeToString(m.mac(body)))
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (m *MockProvider) ParseCallback(header func(key string) string, body []byte) (*Callback, error) {
+	sig, err := hex.DecodeString(header(MockSignatureHeader))
+	if err != nil || !hmac.Equal(sig, m.mac(body)) {
+		return nil, ErrInvalidSignature
+	}
+	var n mockNotification
+	if err := json.Unmarshal(body, &n); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRequest, err.Error())
+	}
+	if n.IntentID == "" || n.TxnID == "" {
+		return nil, fmt.Errorf("%w: 回调缺少支付单号或流水号", ErrInvalidRequest)
+	}
+	return &Callback{
+		IntentID:  n.IntentID,
+		TxnID:     n.TxnID,
+		Amount:    n.Amount,
+		Succeeded: n.Status == "success",
+	}, nil
+}
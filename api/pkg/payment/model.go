@@ -0,0 +1,56 @@
+package payment
+
+import (
+	"context"
+	"errors"
+)
+
+// 支付单状态
+const (
+	StatusPending   = "pending"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+)
+
+// stockRefundReason 支付时库存预占已过期、库存又不足，自动退款的原因
+const stockRefundReason = "库存不足，自动退款"
+
+var (
+	ErrInvalidRequest   = errors.New("invalid payment request")
+	ErrIntentNotFound   = errors.New("支付单不存在")
+	ErrUnknownProvider  = errors.New("未知的支付渠道")
+	ErrInvalidSignature = errors.New("回调签名校验失败")
+	ErrAmountMismatch   = errors.New("回调金额与支付单不一致")
+)
+
+// Intent 对应 payment_intent 表的一行
+type Intent struct {
+	ID            string
+	OrderID       string
+	ReqUserID     int64
+	Provider      string
+	Amount        int64
+	Status        string
+	ProviderTxnID string
+	PayURL        string
+	CreatedAt     int64
+	UpdatedAt     int64
+}
+
+// Callback 支付渠道回调中与渠道无关的部分
+type Callback struct {
+	IntentID  string
+	TxnID     string // 渠道流水号，同一笔流水可能被通知多次
+	Amount    int64
+	Succeeded bool
+}
+
+// Provider 支付渠道，接入新渠道时实现该接口并在 NewService 中注册
+type Provider interface {
+	// Name 渠道名，同时是回调路由 /payment_callback/:provider 中的 provider
+	Name() string
+	// CreateCharge 向渠道下单，返回用户去支付的地址
+	CreateCharge(ctx context.Context, intent *Intent) (payURL string, err error)
+	// ParseCallback 校验回调签名并解析，签名不正确时返回 ErrInvalidSignature
+	ParseCallback(header func(key string) string, body []byte) (*Callback, error)
+}
@@ -0,0 +1,311 @@
+package payment
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	pkgCheckout "github.com/youperceive/cloudwego_instance/api/pkg/checkout"
+	pkgProduct "github.com/youperceive/cloudwego_instance/api/pkg/product"
+	"github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/base"
+	order_k "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order"
+	order_service_k "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order/orderservice"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/status"
+)
+
+// Service 管理支付单，并在支付渠道回调后把订单推进为已支付。
+// 订单只能经由这里变为已支付，网关的 /update 不再接受 status=2。
+type Service struct {
+	db        *sql.DB
+	orders    order_service_k.Client
+	providers map[string]Provider
+}
+
+func NewService(db *sql.DB, orders order_service_k.Client, providers ...Provider) *Service {
+	s := &Service{
+		db:        db,
+		orders:    orders,
+		providers: make(map[string]Provider, len(providers)),
+	}
+	for _, p := range providers {
+		s.providers[p.Name()] = p
+	}
+	return s
+}
+
+func invalidRequest(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvalidRequest, fmt.Sprintf(format, args...))
+}
+
+// CreateIntent 为 userID 的待支付订单创建支付单并向渠道下单。
+// 同一订单、同一渠道已有待支付的支付单时直接返回，不会重复下单。
+func (s *Service) CreateIntent(ctx context.Context, userID int64, orderID, providerName string) (*Intent, error) {
+	provider, ok := s.providers[providerName]
+	if !ok {
+		return nil, invalidRequest("%s: %s", ErrUnknownProvider.Error(), providerName)
+	}
+	if orderID == "" {
+		return nil, invalidRequest("订单ID不能为空")
+	}
+
+	resp, err := s.orders.QueryOrderInfo(ctx, &order_k.QueryOrderInfoRequest{Id: orderID})
+	if err != nil {
+		return nil, fmt.Errorf("CreateIntent: 查询订单失败: %w", err)
+	}
+	if resp.BaseResp == nil || resp.BaseResp.Code != base.Code_SUCCESS || resp.Order == nil {
+		return nil, invalidRequest("订单不存在")
+	}
+	o := resp.Order
+	if o.ReqUserId != userID {
+		return nil, invalidRequest("只能支付自己的订单")
+	}
+	if o.Status != status.PendingPayment {
+		return nil, invalidRequest("订单状态为 %d，不是待支付", o.Status)
+	}
+	if o.TotalAmount <= 0 {
+		return nil, invalidRequest("订单金额为 0，无需支付")
+	}
+
+	existing, err := findPendingIntent(ctx, s.db, orderID, providerName)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && existing.Amount == o.TotalAmount {
+		return existing, nil
+	}
+
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().Unix()
+	intent := &Intent{
+		ID:        id,
+		OrderID:   orderID,
+		ReqUserID: userID,
+		Provider:  providerName,
+		Amount:    o.TotalAmount,
+		Status:    StatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	// 先落库再下单，渠道回调到达时一定能找到支付单
+	if err := insertIntent(ctx, s.db, intent); err != nil {
+		return nil, err
+	}
+	payURL, err := provider.CreateCharge(ctx, intent)
+	if err != nil {
+		if failErr := failIntent(ctx, s.db, intent.ID); failErr != nil {
+			log.Printf("payment: 支付单 %s 下单失败后标记失败出错: %v", intent.ID, failErr)
+		}
+		return nil, fmt.Errorf("CreateIntent: 渠道 %s 下单失败: %w", providerName, err)
+	}
+	intent.PayURL = payURL
+	if err := savePayURL(ctx, s.db, intent); err != nil {
+		return nil, err
+	}
+	return intent, nil
+}
+
+// GetIntent 查询 userID 的支付单
+func (s *Service) GetIntent(ctx context.Context, userID int64, intentID string) (*Intent, error) {
+	intent, err := getIntent(ctx, s.db, intentID)
+	if err != nil {
+		return nil, err
+	}
+	if intent.ReqUserID != userID {
+		return nil, ErrIntentNotFound
+	}
+	return intent, nil
+}
+
+// HandleCallback 处理支付渠道的异步通知。
+// 返回 nil 表示通知已处理（包括重复通知），渠道不需要再重试；其他错误应让渠道稍后重试。
+// ErrUnknownProvider、ErrInvalidSignature、ErrInvalidRequest、ErrIntentNotFound 和 ErrAmountMismatch 重试也不会成功。
+func (s *Service) HandleCallback(ctx context.Context, providerName string, header func(key string) string, body []byte) error {
+	provider, ok := s.providers[providerName]
+	if !ok {
+		return ErrUnknownProvider
+	}
+	cb, err := provider.ParseCallback(header, body)
+	if err != nil {
+		return err
+	}
+
+	// 按渠道流水号去重：同一笔流水处理完成后，重复通知直接返回
+	processed, err := recordTransaction(ctx, s.db, providerName, cb)
+	if err != nil {
+		return err
+	}
+	if processed {
+		return nil
+	}
+
+	intent, err := getIntent(ctx, s.db, cb.IntentID)
+	if err != nil {
+		return err
+	}
+	if intent.Provider != providerName || cb.Amount != intent.Amount {
+		log.Printf("payment: 支付单 %s 回调与支付单不一致（渠道 %s/%s，金额 %d/%d），流水 %s 需人工核对",
+			intent.ID, providerName, intent.Provider, cb.Amount, intent.Amount, cb.TxnID)
+		return ErrAmountMismatch
+	}
+
+	if !cb.Succeeded {
+		if err := failIntent(ctx, s.db, intent.ID); err != nil {
+			return err
+		}
+		return markTransactionProcessed(ctx, s.db, providerName, cb.TxnID)
+	}
+
+	first, err := succeedIntent(ctx, s.db, intent.ID, cb.TxnID)
+	if err != nil {
+		return err
+	}
+	if !first {
+		// 同一支付单被另一笔流水支付过，本笔属于重复支付，需要在渠道侧退款
+		log.Printf("payment: 支付单 %s 已由其他流水支付，流水 %s 为重复支付，需退款", intent.ID, cb.TxnID)
+		return markTransactionProcessed(ctx, s.db, providerName, cb.TxnID)
+	}
+
+	if err := s.markOrderPaid(ctx, intent); err != nil {
+		return err
+	}
+	return markTransactionProcessed(ctx, s.db, providerName, cb.TxnID)
+}
+
+// markOrderPaid 把订单推进为已支付并确认库存预占，订单已是已支付时视为成功
+func (s *Service) markOrderPaid(ctx context.Context, intent *Intent) error {
+	paid := status.Paid
+	resp, err := s.orders.Update(ctx, &order_k.UpdateRequest{
+		Id:     intent.OrderID,
+		Status: &paid,
+	})
+	if err != nil {
+		return fmt.Errorf("markOrderPaid: %w", err)
+	}
+	if resp.BaseResp == nil {
+		return errors.New("markOrderPaid: 订单服务返回为空")
+	}
+
+	switch resp.BaseResp.Code {
+	case base.Code_SUCCESS:
+	case base.Code_INVALID_STATUS_TRANSITION:
+		info, err := s.orders.QueryOrderInfo(ctx, &order_k.QueryOrderInfoRequest{Id: intent.OrderID})
+		if err != nil {
+			return fmt.Errorf("markOrderPaid: %w", err)
+		}
+		if info.Order == nil || info.Order.Status != status.Paid {
+			// 订单已取消（如支付超时）但用户付了款，需要在渠道侧退款
+			log.Printf("payment: 订单 %s 不是待支付状态，支付单 %s 已收款，需退款: %s",
+				intent.OrderID, intent.ID, resp.BaseResp.Msg)
+			return nil
+		}
+	default:
+		return fmt.Errorf("markOrderPaid: 订单服务返回 %d: %s", resp.BaseResp.Code, resp.BaseResp.Msg)
+	}
+
+	// 确认失败时返回 error，由渠道重试回调；订单已是已支付，重试时直接确认
+	err = pkgProduct.ConfirmReservation(ctx, intent.OrderID)
+	if errors.Is(err, pkgProduct.ErrReservationNotFound) {
+		err = s.reconfirmReservation(ctx, intent.OrderID)
+	}
+	if err != nil {
+		return fmt.Errorf("markOrderPaid: 订单 %s 确认库存预占失败: %w", intent.OrderID, err)
+	}
+	return nil
+}
+
+// reconfirmReservation 预占已过期释放时按订单重新预占并确认；库存已不足时自动同意一笔全额退款。
+// 不是通过结算流程创建的订单没有预占记录，直接返回
+func (s *Service) reconfirmReservation(ctx context.Context, orderID string) error {
+	resp, err := s.orders.QueryOrderInfo(ctx, &order_k.QueryOrderInfoRequest{Id: orderID})
+	if err != nil {
+		return fmt.Errorf("reconfirmReservation: 查询订单失败: %w", err)
+	}
+	if resp.BaseResp == nil || resp.BaseResp.Code != base.Code_SUCCESS || resp.Order == nil {
+		return fmt.Errorf("reconfirmReservation: 查询订单失败: %v", resp.BaseResp)
+	}
+	o := resp.Order
+	if o.Ext[pkgCheckout.ExtSagaKey] == "" {
+		return nil
+	}
+
+	items := make([]pkgProduct.ReserveItem, 0, len(o.Items))
+	for _, it := range o.Items {
+		items = append(items, pkgProduct.ReserveItem{SkuID: it.SkuId, Count: int32(it.Count)})
+	}
+	_, err = pkgProduct.ReserveStock(ctx, orderID, items, 0)
+	switch {
+	case err == nil:
+		return pkgProduct.ConfirmReservation(ctx, orderID)
+	case errors.Is(err, pkgProduct.ErrInsufficientStock), errors.Is(err, pkgProduct.ErrSkuNotFound):
+		log.Printf("payment: 订单 %s 的库存预占已过期且库存不足，自动退款: %v", orderID, err)
+		return s.refundUnavailable(ctx, o)
+	default:
+		return err
+	}
+}
+
+// refundUnavailable 库存不足无法发货：为订单中尚未退款的部分申请退款并以商户身份同意，退款到账仍走 /complete_refund。
+// 库存没有扣减过，不退回库存；已有的自动退款单会被复用，重复调用只退一次
+func (s *Service) refundUnavailable(ctx context.Context, o *order_k.Order) error {
+	var refund *order_k.Refund
+	refunded := make(map[int64]int64)
+	for _, r := range o.Refunds {
+		if r.Status == order_k.RefundStatus_REJECTED {
+			continue
+		}
+		if r.Reason == stockRefundReason {
+			refund = r
+		}
+		for _, it := range r.Items {
+			refunded[it.ItemId] += it.Count
+		}
+	}
+
+	if refund == nil {
+		var items []*order_k.RefundItemForRequest
+		for _, it := range o.Items {
+			if left := it.Count - refunded[it.Id]; left > 0 {
+				items = append(items, &order_k.RefundItemForRequest{ItemId: it.Id, Count: left})
+			}
+		}
+		if len(items) == 0 {
+			return nil
+		}
+		resp, err := s.orders.RequestRefund(ctx, &order_k.RequestRefundRequest{
+			OrderId:   o.Id,
+			ReqUserId: o.ReqUserId,
+			Items:     items,
+			Reason:    stockRefundReason,
+		})
+		if err != nil {
+			return fmt.Errorf("refundUnavailable: %w", err)
+		}
+		if resp.BaseResp == nil || resp.BaseResp.Code != base.Code_SUCCESS {
+			return fmt.Errorf("refundUnavailable: 申请退款失败: %v", resp.BaseResp)
+		}
+		refund = &order_k.Refund{Id: resp.RefundId, Status: order_k.RefundStatus_REQUESTED}
+	}
+
+	if refund.Status != order_k.RefundStatus_REQUESTED {
+		return nil
+	}
+	resp, err := s.orders.ReviewRefund(ctx, &order_k.ReviewRefundRequest{
+		OrderId:    o.Id,
+		RefundId:   refund.Id,
+		RespUserId: o.RespUserId,
+		Approve:    true,
+	})
+	if err != nil {
+		return fmt.Errorf("refundUnavailable: %w", err)
+	}
+	if resp.BaseResp == nil || resp.BaseResp.Code != base.Code_SUCCESS {
+		return fmt.Errorf("refundUnavailable: 同意退款失败: %v", resp.BaseResp)
+	}
+	return nil
+}
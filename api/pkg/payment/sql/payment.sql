@@ -0,0 +1,31 @@
+-- 支付单：同一订单、同一渠道同时只有一个待支付的支付单
+CREATE TABLE `payment_intent` (
+  `id` varchar(32) NOT NULL,
+  `order_id` varchar(32) NOT NULL,
+  `req_user_id` bigint NOT NULL,
+  `provider` varchar(32) NOT NULL,
+  `amount` bigint NOT NULL,
+  `status` varchar(16) NOT NULL COMMENT 'pending/succeeded/failed',
+  `provider_txn_id` varchar(64) NOT NULL DEFAULT '',
+  `pay_url` varchar(255) NOT NULL DEFAULT '',
+  `created_at` bigint NOT NULL,
+  `updated_at` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_order_provider` (`order_id`, `provider`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+-- 支付渠道流水：按 (provider, txn_id) 去重，processed=1 表示回调已处理完毕
+CREATE TABLE `payment_transaction` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `provider` varchar(32) NOT NULL,
+  `txn_id` varchar(64) NOT NULL,
+  `intent_id` varchar(32) NOT NULL,
+  `amount` bigint NOT NULL,
+  `succeeded` tinyint NOT NULL,
+  `processed` tinyint NOT NULL DEFAULT '0',
+  `created_at` bigint NOT NULL,
+  `updated_at` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_provider_txn` (`provider`, `txn_id`),
+  KEY `idx_intent_id` (`intent_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
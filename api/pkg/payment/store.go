@@ -0,0 +1,139 @@
+package payment
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+)
+
+func newID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+const intentColumns = `id, order_id, req_user_id, provider, amount, status, provider_txn_id, pay_url, created_at, updated_at`
+
+func scanIntent(row interface{ Scan(...any) error }) (*Intent, error) {
+	var it Intent
+	err := row.Scan(&it.ID, &it.OrderID, &it.ReqUserID, &it.Provider, &it.Amount,
+		&it.Status, &it.ProviderTxnID, &it.PayURL, &it.CreatedAt, &it.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &it, nil
+}
+
+func insertIntent(ctx context.Context, db *sql.DB, it *Intent) error {
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO payment_intent (`+intentColumns+`)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, it.ID, it.OrderID, it.ReqUserID, it.Provider, it.Amount,
+		it.Status, it.ProviderTxnID, it.PayURL, it.CreatedAt, it.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("insertIntent: %w", err)
+	}
+	return nil
+}
+
+func getIntent(ctx context.Context, db *sql.DB, id string) (*Intent, error) {
+	it, err := scanIntent(db.QueryRowContext(ctx, `
+		SELECT `+intentColumns+` FROM payment_intent WHERE id=?
+	`, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrIntentNotFound
+		}
+		return nil, fmt.Errorf("getIntent: %w", err)
+	}
+	return it, nil
+}
+
+// findPendingIntent 查找订单在该渠道下待支付的支付单，没有时返回 nil
+func findPendingIntent(ctx context.Context, db *sql.DB, orderID, provider string) (*Intent, error) {
+	it, err := scanIntent(db.QueryRowContext(ctx, `
+		SELECT `+intentColumns+` FROM payment_intent
+		WHERE order_id=? AND provider=? AND status=? ORDER BY created_at DESC LIMIT 1
+	`, orderID, provider, StatusPending))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("findPendingIntent: %w", err)
+	}
+	return it, nil
+}
+
+func savePayURL(ctx context.Context, db *sql.DB, it *Intent) error {
+	it.UpdatedAt = time.Now().Unix()
+	_, err := db.ExecContext(ctx, `
+		UPDATE payment_intent SET pay_url=?, updated_at=? WHERE id=?
+	`, it.PayURL, it.UpdatedAt, it.ID)
+	if err != nil {
+		return fmt.Errorf("savePayURL: %w", err)
+	}
+	return nil
+}
+
+// succeedIntent 把支付单标记为支付成功并记录流水号。
+// 已被另一笔流水支付成功时返回 false（重复支付），同一流水重复通知时返回 true。
+func succeedIntent(ctx context.Context, db *sql.DB, id, txnID string) (bool, error) {
+	_, err := db.ExecContext(ctx, `
+		UPDATE payment_intent SET status=?, provider_txn_id=?, updated_at=?
+		WHERE id=? AND status<>?
+	`, StatusSucceeded, txnID, time.Now().Unix(), id, StatusSucceeded)
+	if err != nil {
+		return false, fmt.Errorf("succeedIntent: %w", err)
+	}
+	it, err := getIntent(ctx, db, id)
+	if err != nil {
+		return false, err
+	}
+	return it.ProviderTxnID == txnID, nil
+}
+
+// failIntent 只有待支付的支付单会被标记为失败，不会覆盖已成功的结果
+func failIntent(ctx context.Context, db *sql.DB, id string) error {
+	_, err := db.ExecContext(ctx, `
+		UPDATE payment_intent SET status=?, updated_at=? WHERE id=? AND status=?
+	`, StatusFailed, time.Now().Unix(), id, StatusPending)
+	if err != nil {
+		return fmt.Errorf("failIntent: %w", err)
+	}
+	return nil
+}
+
+// recordTransaction 按 (provider, txn_id) 记录渠道流水，返回该流水是否已处理完毕
+func recordTransaction(ctx context.Context, db *sql.DB, provider string, cb *Callback) (processed bool, err error) {
+	now := time.Now().Unix()
+	_, err = db.ExecContext(ctx, `
+		INSERT IGNORE INTO payment_transaction (provider, txn_id, intent_id, amount, succeeded, processed, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, 0, ?, ?)
+	`, provider, cb.TxnID, cb.IntentID, cb.Amount, cb.Succeeded, now, now)
+	if err != nil {
+		return false, fmt.Errorf("recordTransaction: %w", err)
+	}
+	err = db.QueryRowContext(ctx, `
+		SELECT processed FROM payment_transaction WHERE provider=? AND txn_id=?
+	`, provider, cb.TxnID).Scan(&processed)
+	if err != nil {
+		return false, fmt.Errorf("recordTransaction: %w", err)
+	}
+	return processed, nil
+}
+
+func markTransactionProcessed(ctx context.Context, db *sql.DB, provider, txnID string) error {
+	_, err := db.ExecContext(ctx, `
+		UPDATE payment_transaction SET processed=1, updated_at=? WHERE provider=? AND txn_id=?
+	`, time.Now().Unix(), provider, txnID)
+	if err != nil {
+		return fmt.Errorf("markTransactionProcessed: %w", err)
+	}
+	return nil
+}
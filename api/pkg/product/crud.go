@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 )
 
 // ====================== 商品纯 CRUD ======================
@@ -216,11 +217,11 @@ func GetSku(ctx context.Context, skuID int64) (*Sku, error) {
 	var s Sku
 
 	err := DB.QueryRowContext(ctx, `
-		SELECT id, merchant_id, product_id, sku_code, price, stock FROM sku WHERE id=?
-	`, skuID).Scan(&s.ID, &s.MerchantID, &s.ProductID, &s.SkuCode, &s.Price, &s.Stock)
+		SELECT id, merchant_id, product_id, sku_code, price, stock, reserved_stock FROM sku WHERE id=?
+	`, skuID).Scan(&s.ID, &s.MerchantID, &s.ProductID, &s.SkuCode, &s.Price, &s.Stock, &s.ReservedStock)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, errors.New("GetSku: SKU 不存在")
+			return nil, ErrSkuNotFound
 		}
 		return nil, fmt.Errorf("GetSku: 查询失败: %w", err)
 	}
@@ -246,18 +247,19 @@ func DeductSkuStock(ctx context.Context, skuID int64, count int32) (int32, error
 		}
 	}()
 
-	// 查询库存并加行锁（防超卖）
-	var stock int32
+	// 查询库存并加行锁（防超卖），已被预占的库存不能直接扣减
+	var stock, reserved int32
 	err = tx.QueryRowContext(ctx, `
-		SELECT stock FROM sku WHERE id=? FOR UPDATE
-	`, skuID).Scan(&stock)
+		SELECT stock, reserved_stock FROM sku WHERE id=? FOR UPDATE
+	`, skuID).Scan(&stock, &reserved)
 	if err != nil {
 		return 0, fmt.Errorf("DeductSkuStock: 查询库存失败: %w", err)
 	}
 
 	// 校验库存
-	if stock < count {
-		return 0, errors.New("DeductSkuStock: 库存不足")
+	if stock-reserved < count {
+		err = errors.New("DeductSkuStock: 库存不足")
+		return 0, err
 	}
 
 	// 扣减库存
@@ -370,7 +372,7 @@ func ListSkuByProduct(ctx context.Context, merchantID, productID int64) ([]*Sku,
 
 	// 2. 查询该商品下的所有SKU
 	rows, err := DB.QueryContext(ctx, `
-		SELECT id, merchant_id, product_id, sku_code, price, stock FROM sku 
+		SELECT id, merchant_id, product_id, sku_code, price, stock, reserved_stock FROM sku 
 		WHERE product_id=? 
 		ORDER BY id ASC
 	`, productID)
@@ -383,7 +385,7 @@ func ListSkuByProduct(ctx context.Context, merchantID, productID int64) ([]*Sku,
 	skus := make([]*Sku, 0)
 	for rows.Next() {
 		var s Sku
-		err := rows.Scan(&s.ID, &s.MerchantID, &s.ProductID, &s.SkuCode, &s.Price, &s.Stock)
+		err := rows.Scan(&s.ID, &s.MerchantID, &s.ProductID, &s.SkuCode, &s.Price, &s.Stock, &s.ReservedStock)
 		if err != nil {
 			return nil, fmt.Errorf("ListSkuByProduct: 解析SKU失败: %w", err)
 		}
@@ -398,6 +400,41 @@ func ListSkuByProduct(ctx context.Context, merchantID, productID int64) ([]*Sku,
 	return skus, nil
 }
 
+// ListSkuByIDs 按 SKU ID 批量查询（购物车用，无需商户ID），不存在的 SKU 不出现在结果中
+func ListSkuByIDs(ctx context.Context, skuIDs []int64) (map[int64]*Sku, error) {
+	skus := make(map[int64]*Sku, len(skuIDs))
+	if len(skuIDs) == 0 {
+		return skus, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(skuIDs)), ",")
+	args := make([]any, 0, len(skuIDs))
+	for _, id := range skuIDs {
+		args = append(args, id)
+	}
+	rows, err := DB.QueryContext(ctx, `
+		SELECT id, merchant_id, product_id, sku_code, price, stock, reserved_stock FROM sku
+		WHERE id IN (`+placeholders+`)
+	`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ListSkuByIDs: 查询SKU失败: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var s Sku
+		err := rows.Scan(&s.ID, &s.MerchantID, &s.ProductID, &s.SkuCode, &s.Price, &s.Stock, &s.ReservedStock)
+		if err != nil {
+			return nil, fmt.Errorf("ListSkuByIDs: 解析SKU失败: %w", err)
+		}
+		skus[s.ID] = &s
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("ListSkuByIDs: 遍历SKU失败: %w", err)
+	}
+	return skus, nil
+}
+
 // Merchant 商户结构体（精准匹配user表：ID对应id，Name对应username）
 type Merchant struct {
 	ID   int64  `db:"id"`   // 商户ID（user表的id，int类型转int64）
@@ -11,10 +11,7 @@ import (
 // DB 全局数据库连接（仅初始化，无业务逻辑）
 var DB *sql.DB
 
-func init() {
-	InitDB()
-}
-
+// InitDB 连接 $MYSQL_DSN 并设置 DB，由 shared.Init 在启动时调用一次
 func InitDB() {
 	// 从环境变量读取，兜底硬编码
 	dsn := os.Getenv("MYSQL_DSN")
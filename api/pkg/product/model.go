@@ -1,5 +1,10 @@
 package product
 
+import "errors"
+
+// ErrSkuNotFound SKU 不存在，调用方据此返回 NOT_FOUND
+var ErrSkuNotFound = errors.New("GetSku: SKU 不存在")
+
 // Product 商品原生结构体（匹配数据库字段）
 type Product struct {
 	ID         int64             `db:"id"`
@@ -16,4 +21,6 @@ type Sku struct {
 	SkuCode    string `db:"sku_code"`
 	Price      int64  `db:"price"` // 分
 	Stock      int32  `db:"stock"`
+	// ReservedStock 已预占未确认的库存，可售库存为 Stock - ReservedStock
+	ReservedStock int32 `db:"reserved_stock"`
 }
@@ -0,0 +1,362 @@
+package product
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// 预占状态
+const (
+	ReservationHeld      = 1 // 预占中
+	ReservationConfirmed = 2 // 已确认（库存已实际扣减）
+	ReservationReleased  = 3 // 已释放（取消或超时）
+)
+
+const defaultReservationTTL = 30 * time.Minute
+
+var (
+	ErrInsufficientStock    = errors.New("库存不足")
+	ErrReservationNotFound  = errors.New("预占记录不存在或已释放")
+	ErrReservationConfirmed = errors.New("预占已确认，不能重复预占")
+)
+
+// ReserveItem 一个 SKU 的预占数量
+type ReserveItem struct {
+	SkuID int64
+	Count int32
+}
+
+// ReservationTTLFromEnv 读取 $STOCK_RESERVATION_TTL（如 30m），未配置或格式错误时使用 30 分钟
+func ReservationTTLFromEnv() time.Duration {
+	v := os.Getenv("STOCK_RESERVATION_TTL")
+	if v == "" {
+		return defaultReservationTTL
+	}
+	ttl, err := time.ParseDuration(v)
+	if err != nil || ttl <= 0 {
+		log.Printf("product: STOCK_RESERVATION_TTL=%q 格式错误，使用默认值 %s", v, defaultReservationTTL)
+		return defaultReservationTTL
+	}
+	return ttl
+}
+
+// mergeReserveItems 合并同一 SKU 的数量，并按 SKU ID 排序，保证加锁顺序一致避免死锁
+func mergeReserveItems(items []ReserveItem) ([]ReserveItem, error) {
+	counts := make(map[int64]int32, len(items))
+	for _, it := range items {
+		if it.SkuID <= 0 || it.Count <= 0 {
+			return nil, errors.New("SKU ID 和预占数量必须 > 0")
+		}
+		counts[it.SkuID] += it.Count
+	}
+	merged := make([]ReserveItem, 0, len(counts))
+	for skuID, count := range counts {
+		merged = append(merged, ReserveItem{SkuID: skuID, Count: count})
+	}
+	sort.Slice(merged, func(i, j int) bool { return merged[i].SkuID < merged[j].SkuID })
+	return merged, nil
+}
+
+// ReserveStock 以 ref 为凭证预占库存，全部成功或全部失败，返回过期时间。
+// 同一 ref 预占中的 SKU 会被跳过，重复调用是安全的；已释放的重新预占，已确认的返回 ErrReservationConfirmed。
+func ReserveStock(ctx context.Context, ref string, items []ReserveItem, ttl time.Duration) (_ int64, err error) {
+	if ref == "" || len(items) == 0 {
+		return 0, errors.New("ReserveStock: 预占凭证和预占项不能为空")
+	}
+	merged, err := mergeReserveItems(items)
+	if err != nil {
+		return 0, fmt.Errorf("ReserveStock: %w", err)
+	}
+	if ttl <= 0 {
+		ttl = defaultReservationTTL
+	}
+
+	tx, err := DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("ReserveStock: 开启事务失败: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	now := time.Now().Unix()
+	expiresAt := now + int64(ttl/time.Second)
+	for _, it := range merged {
+		var resStatus int
+		exists := true
+		if scanErr := tx.QueryRowContext(ctx, `
+			SELECT status FROM sku_reservation WHERE ref=? AND sku_id=? FOR UPDATE
+		`, ref, it.SkuID).Scan(&resStatus); scanErr != nil {
+			if !errors.Is(scanErr, sql.ErrNoRows) {
+				err = fmt.Errorf("ReserveStock: 查询预占记录失败: %w", scanErr)
+				return 0, err
+			}
+			exists = false
+		}
+		if exists && resStatus == ReservationHeld {
+			continue
+		}
+		// 已确认的预占库存已实际扣减，再预占会重复占用库存
+		if exists && resStatus == ReservationConfirmed {
+			err = fmt.Errorf("ReserveStock: SKU %d: %w", it.SkuID, ErrReservationConfirmed)
+			return 0, err
+		}
+
+		var stock, reserved int32
+		err = tx.QueryRowContext(ctx, `
+			SELECT stock, reserved_stock FROM sku WHERE id=? FOR UPDATE
+		`, it.SkuID).Scan(&stock, &reserved)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				err = fmt.Errorf("ReserveStock: SKU %d: %w", it.SkuID, ErrSkuNotFound)
+				return 0, err
+			}
+			return 0, fmt.Errorf("ReserveStock: 查询库存失败: %w", err)
+		}
+		if stock-reserved < it.Count {
+			err = fmt.Errorf("ReserveStock: SKU %d 可售库存 %d，需预占 %d: %w", it.SkuID, stock-reserved, it.Count, ErrInsufficientStock)
+			return 0, err
+		}
+
+		if _, err = tx.ExecContext(ctx, `
+			UPDATE sku SET reserved_stock = reserved_stock + ? WHERE id=?
+		`, it.Count, it.SkuID); err != nil {
+			return 0, fmt.Errorf("ReserveStock: 预占库存失败: %w", err)
+		}
+		if exists {
+			// 已释放（取消或超时）的预占按本次的数量和有效期重新预占
+			if _, err = tx.ExecContext(ctx, `
+				UPDATE sku_reservation SET count=?, status=?, expires_at=?, updated_at=? WHERE ref=? AND sku_id=?
+			`, it.Count, ReservationHeld, expiresAt, now, ref, it.SkuID); err != nil {
+				return 0, fmt.Errorf("ReserveStock: 更新预占记录失败: %w", err)
+			}
+			continue
+		}
+		if _, err = tx.ExecContext(ctx, `
+			INSERT INTO sku_reservation (ref, sku_id, count, status, expires_at, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+		`, ref, it.SkuID, it.Count, ReservationHeld, expiresAt, now, now); err != nil {
+			return 0, fmt.Errorf("ReserveStock: 写入预占记录失败: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("ReserveStock: 提交事务失败: %w", err)
+	}
+	return expiresAt, nil
+}
+
+// RebindReservation 把预占凭证从 from 换成 to（如结算流程 id 换成订单 id），只影响预占中的记录。
+// 传入 skuIDs 时只换绑这些 SKU，用于一次预占拆分到多个子订单
+func RebindReservation(ctx context.Context, from, to string, skuIDs ...int64) error {
+	query := `UPDATE sku_reservation SET ref=?, updated_at=? WHERE ref=? AND status=?`
+	args := []any{to, time.Now().Unix(), from, ReservationHeld}
+	if len(skuIDs) > 0 {
+		placeholders := strings.TrimSuffix(strings.Repeat("?,", len(skuIDs)), ",")
+		query += ` AND sku_id IN (` + placeholders + `)`
+		for _, id := range skuIDs {
+			args = append(args, id)
+		}
+	}
+	_, err := DB.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("RebindReservation: %w", err)
+	}
+	return nil
+}
+
+// ConfirmReservation 确认 ref 下的预占：实际扣减库存。已确认过时直接返回。
+func ConfirmReservation(ctx context.Context, ref string) error {
+	return settleReservation(ctx, ref, ReservationConfirmed)
+}
+
+// ReleaseReservation 释放 ref 下的预占，库存重新可售。没有预占中的记录时直接返回。
+func ReleaseReservation(ctx context.Context, ref string) error {
+	return settleReservation(ctx, ref, ReservationReleased)
+}
+
+// settleReservation 把 ref 下预占中的记录结算为 to 状态，与 sku 库存在同一事务中更新
+func settleReservation(ctx context.Context, ref string, to int) (err error) {
+	tx, err := DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("settleReservation: 开启事务失败: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT id, sku_id, count, status FROM sku_reservation WHERE ref=? ORDER BY sku_id ASC FOR UPDATE
+	`, ref)
+	if err != nil {
+		return fmt.Errorf("settleReservation: 查询预占记录失败: %w", err)
+	}
+	type record struct {
+		id, skuID     int64
+		count, status int32
+	}
+	var records []record
+	for rows.Next() {
+		var r record
+		if err = rows.Scan(&r.id, &r.skuID, &r.count, &r.status); err != nil {
+			rows.Close()
+			return fmt.Errorf("settleReservation: 解析预占记录失败: %w", err)
+		}
+		records = append(records, r)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("settleReservation: %w", err)
+	}
+
+	held := 0
+	for _, r := range records {
+		if r.status != ReservationHeld {
+			// 确认时如果已被释放（超时），说明库存可能已被他人占用，不能再扣减
+			if to == ReservationConfirmed && r.status == ReservationReleased {
+				err = fmt.Errorf("settleReservation: SKU %d: %w", r.skuID, ErrReservationNotFound)
+				return err
+			}
+			continue
+		}
+		held++
+
+		stockDelta := int32(0)
+		if to == ReservationConfirmed {
+			stockDelta = r.count
+		}
+		if _, err = tx.ExecContext(ctx, `
+			UPDATE sku SET stock = stock - ?, reserved_stock = reserved_stock - ? WHERE id=?
+		`, stockDelta, r.count, r.skuID); err != nil {
+			return fmt.Errorf("settleReservation: 更新库存失败: %w", err)
+		}
+		if _, err = tx.ExecContext(ctx, `
+			UPDATE sku_reservation SET status=?, updated_at=? WHERE id=?
+		`, to, time.Now().Unix(), r.id); err != nil {
+			return fmt.Errorf("settleReservation: 更新预占记录失败: %w", err)
+		}
+	}
+	if to == ReservationConfirmed && len(records) == 0 {
+		err = fmt.Errorf("settleReservation: ref %s: %w", ref, ErrReservationNotFound)
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("settleReservation: 提交事务失败: %w", err)
+	}
+	return nil
+}
+
+// ReleaseExpiredReservations 释放已过期的预占，返回释放的凭证数量
+func ReleaseExpiredReservations(ctx context.Context, limit int) (int, error) {
+	rows, err := DB.QueryContext(ctx, `
+		SELECT DISTINCT ref FROM sku_reservation WHERE status=? AND expires_at < ? LIMIT ?
+	`, ReservationHeld, time.Now().Unix(), limit)
+	if err != nil {
+		return 0, fmt.Errorf("ReleaseExpiredReservations: 查询过期预占失败: %w", err)
+	}
+	var refs []string
+	for rows.Next() {
+		var ref string
+		if err := rows.Scan(&ref); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("ReleaseExpiredReservations: 解析失败: %w", err)
+		}
+		refs = append(refs, ref)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("ReleaseExpiredReservations: %w", err)
+	}
+
+	for _, ref := range refs {
+		if err := releaseExpired(ctx, ref); err != nil {
+			return 0, err
+		}
+	}
+	return len(refs), nil
+}
+
+// releaseExpired 只释放 ref 下仍处于预占中且已过期的记录，避免与确认并发时误释放
+func releaseExpired(ctx context.Context, ref string) (err error) {
+	tx, err := DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("releaseExpired: 开启事务失败: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT id, sku_id, count FROM sku_reservation
+		WHERE ref=? AND status=? AND expires_at < ? ORDER BY sku_id ASC FOR UPDATE
+	`, ref, ReservationHeld, time.Now().Unix())
+	if err != nil {
+		return fmt.Errorf("releaseExpired: 查询预占记录失败: %w", err)
+	}
+	type record struct {
+		id, skuID int64
+		count     int32
+	}
+	var records []record
+	for rows.Next() {
+		var r record
+		if err = rows.Scan(&r.id, &r.skuID, &r.count); err != nil {
+			rows.Close()
+			return fmt.Errorf("releaseExpired: 解析预占记录失败: %w", err)
+		}
+		records = append(records, r)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("releaseExpired: %w", err)
+	}
+
+	for _, r := range records {
+		if _, err = tx.ExecContext(ctx, `
+			UPDATE sku SET reserved_stock = reserved_stock - ? WHERE id=?
+		`, r.count, r.skuID); err != nil {
+			return fmt.Errorf("releaseExpired: 更新库存失败: %w", err)
+		}
+		if _, err = tx.ExecContext(ctx, `
+			UPDATE sku_reservation SET status=?, updated_at=? WHERE id=?
+		`, ReservationReleased, time.Now().Unix(), r.id); err != nil {
+			return fmt.Errorf("releaseExpired: 更新预占记录失败: %w", err)
+		}
+	}
+	return tx.Commit()
+}
+
+// RunReservationSweeper 定期释放过期预占，直到 ctx 结束。多个网关实例同时运行是安全的。
+func RunReservationSweeper(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := ReleaseExpiredReservations(ctx, 100)
+			if err != nil {
+				log.Printf("product: 释放过期预占失败: %v", err)
+				continue
+			}
+			if n > 0 {
+				log.Printf("product: 已释放 %d 个过期预占", n)
+			}
+		}
+	}
+}
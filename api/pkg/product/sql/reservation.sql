@@ -0,0 +1,16 @@
+-- 库存预占：sku.stock 为实际库存（含已预占部分），reserved_stock 为预占中的数量
+ALTER TABLE `sku` ADD COLUMN `reserved_stock` int NOT NULL DEFAULT '0' AFTER `stock`;
+
+CREATE TABLE `sku_reservation` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `ref` varchar(64) NOT NULL COMMENT '预占凭证，如结算流程ID/订单ID',
+  `sku_id` bigint NOT NULL,
+  `count` int NOT NULL,
+  `status` tinyint NOT NULL COMMENT '1=预占中 2=已确认 3=已释放',
+  `expires_at` bigint NOT NULL COMMENT '预占过期时间（unix 秒）',
+  `created_at` bigint NOT NULL,
+  `updated_at` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_ref_sku` (`ref`, `sku_id`),
+  KEY `idx_status_expires_at` (`status`, `expires_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
@@ -0,0 +1,10 @@
+-- 退货入库流水：同一凭证（如退款单ID）对同一 SKU 只退回一次库存
+CREATE TABLE `sku_stock_return` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `ref` varchar(64) NOT NULL COMMENT '退回凭证，如 refund:<退款单ID>',
+  `sku_id` bigint NOT NULL,
+  `count` int NOT NULL,
+  `created_at` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_ref_sku` (`ref`, `sku_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
@@ -32,6 +32,7 @@ CREATE TABLE `sku` (
   `sku_code` varchar(64) NOT NULL,
   `price` bigint NOT NULL,
   `stock` int NOT NULL DEFAULT '0',
+  `reserved_stock` int NOT NULL DEFAULT '0',
   PRIMARY KEY (`id`),
   UNIQUE KEY `uk_product_sku_code` (`product_id`,`sku_code`),
   KEY `idx_merchant_id` (`merchant_id`)
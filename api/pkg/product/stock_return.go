@@ -0,0 +1,62 @@
+package product
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ReturnStock 以 ref 为凭证把 items 退回库存（如退款审核通过后），全部成功或全部失败。
+// 同一 ref 已退回过的 SKU 会被跳过，重复调用是安全的。
+func ReturnStock(ctx context.Context, ref string, items []ReserveItem) (err error) {
+	if ref == "" || len(items) == 0 {
+		return errors.New("ReturnStock: 退回凭证和退回项不能为空")
+	}
+	merged, err := mergeReserveItems(items)
+	if err != nil {
+		return fmt.Errorf("ReturnStock: %w", err)
+	}
+
+	tx, err := DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("ReturnStock: 开启事务失败: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	now := time.Now().Unix()
+	for _, it := range merged {
+		// 先写流水，唯一键冲突说明已经退回过
+		var result sql.Result
+		result, err = tx.ExecContext(ctx, `
+			INSERT IGNORE INTO sku_stock_return (ref, sku_id, count, created_at) VALUES (?, ?, ?, ?)
+		`, ref, it.SkuID, it.Count, now)
+		if err != nil {
+			return fmt.Errorf("ReturnStock: 写入退回流水失败: %w", err)
+		}
+		if n, _ := result.RowsAffected(); n == 0 {
+			continue
+		}
+
+		result, err = tx.ExecContext(ctx, `
+			UPDATE sku SET stock = stock + ? WHERE id=?
+		`, it.Count, it.SkuID)
+		if err != nil {
+			return fmt.Errorf("ReturnStock: 更新库存失败: %w", err)
+		}
+		if n, _ := result.RowsAffected(); n == 0 {
+			err = fmt.Errorf("ReturnStock: SKU %d: %w", it.SkuID, ErrSkuNotFound)
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("ReturnStock: 提交事务失败: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package seckill
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 活动和每人已抢购数量在活动结束后再保留一段时间，供排队中的请求失败时退回名额
+const (
+	keepAfterEnd = 7 * 24 * time.Hour
+	requestTTL   = 7 * 24 * time.Hour
+)
+
+const queueKey = "seckill:queue"
+
+// campaignKey 活动的剩余库存和抢购规则
+func campaignKey(id int64) string {
+	return "seckill:campaign:" + strconv.FormatInt(id, 10)
+}
+
+// boughtKey 每个用户已抢购的数量，field 为 user_id
+func boughtKey(id int64) string {
+	return "seckill:bought:" + strconv.FormatInt(id, 10)
+}
+
+// requestKey 抢购请求及其处理结果
+func requestKey(id string) string {
+	return "seckill:req:" + id
+}
+
+// warmScript 预热活动库存和每人已抢购数量（ARGV[6] 起为 user_id、数量交替），
+// 已预热过的活动不覆盖（剩余库存以 Redis 为准）
+var warmScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	return 0
+end
+redis.call('DEL', KEYS[2])
+for i = 6, #ARGV, 2 do
+	redis.call('HSET', KEYS[2], ARGV[i], ARGV[i + 1])
+end
+if #ARGV >= 6 then
+	redis.call('EXPIREAT', KEYS[2], ARGV[5])
+end
+redis.call('HSET', KEYS[1], 'stock', ARGV[1], 'start_at', ARGV[2], 'end_at', ARGV[3], 'limit', ARGV[4], 'expire_at', ARGV[5])
+redis.call('EXPIREAT', KEYS[1], ARGV[5])
+return 1
+`)
+
+// buyScript 校验活动时间、库存和限购后扣减库存，记录请求并投递到下单队列。
+// 返回 0 表示成功，-1 活动未预热，-2 未开始，-3 已结束，-4 库存不足，-5 超过限购
+var buyScript = redis.NewScript(`
+redis.replicate_commands()
+local c = redis.call('HMGET', KEYS[1], 'stock', 'start_at', 'end_at', 'limit', 'expire_at')
+if not c[1] then
+	return -1
+end
+local now = tonumber(ARGV[3])
+if now < tonumber(c[2]) then
+	return -2
+end
+if now >= tonumber(c[3]) then
+	return -3
+end
+local count = tonumber(ARGV[2])
+if tonumber(c[1]) < count then
+	return -4
+end
+local bought = tonumber(redis.call('HGET', KEYS[2], ARGV[1]) or '0')
+if bought + count > tonumber(c[4]) then
+	return -5
+end
+redis.call('HINCRBY', KEYS[1], 'stock', -count)
+redis.call('HINCRBY', KEYS[2], ARGV[1], count)
+redis.call('EXPIREAT', KEYS[2], c[5])
+redis.call('HSET', KEYS[3], 'campaign_id', ARGV[6], 'user_id', ARGV[1], 'count', count, 'status', 'queued')
+redis.call('PEXPIRE', KEYS[3], ARGV[5])
+redis.call('XADD', KEYS[4], '*', 'request_id', ARGV[4])
+return 0
+`)
+
+// releaseScript 下单失败时退回名额并记录原因，只对排队中的请求生效，重复调用不会多退
+var releaseScript = redis.NewScript(`
+local r = redis.call('HMGET', KEYS[3], 'user_id', 'count', 'status')
+if not r[1] or r[3] ~= 'queued' then
+	return 0
+end
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	redis.call('HINCRBY', KEYS[1], 'stock', r[2])
+	redis.call('HINCRBY', KEYS[2], r[1], -tonumber(r[2]))
+end
+redis.call('HSET', KEYS[3], 'status', 'failed', 'error', ARGV[1])
+return 1
+`)
+
+// succeedScript 记录下单成功，请求已过期时不再写入
+var succeedScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'status', 'succeeded', 'order_id', ARGV[1])
+return 1
+`)
@@ -0,0 +1,190 @@
+package seckill
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestService(t *testing.T) (*Service, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	return NewService(nil, rdb, nil, 1), mr
+}
+
+// activeCampaign 返回一个进行中的活动并预热
+func activeCampaign(t *testing.T, s *Service, quota, limit int64) *Campaign {
+	t.Helper()
+	now := time.Now().Unix()
+	c := &Campaign{ID: 1, Quota: quota, PerUserLimit: limit, StartAt: now - 60, EndAt: now + 3600}
+	if err := s.restore(context.Background(), c, nil); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func stock(t *testing.T, mr *miniredis.Miniredis, campaignID int64) string {
+	t.Helper()
+	return mr.HGet(campaignKey(campaignID), "stock")
+}
+
+func TestWarmKeepsExistingStock(t *testing.T) {
+	ctx := context.Background()
+	s, mr := newTestService(t)
+	c := activeCampaign(t, s, 10, 2)
+
+	if _, err := s.Buy(ctx, 7, c.ID, 2); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.warm(ctx, c); err != nil {
+		t.Fatal(err)
+	}
+	if got := stock(t, mr, c.ID); got != "8" {
+		t.Fatalf("stock = %s, want 8", got)
+	}
+}
+
+func TestRestoreDeductsPurchases(t *testing.T) {
+	ctx := context.Background()
+	s, mr := newTestService(t)
+	now := time.Now().Unix()
+	c := &Campaign{ID: 1, Quota: 5, PerUserLimit: 2, StartAt: now - 60, EndAt: now + 3600}
+
+	// Redis 丢失数据后残留的计数被覆盖
+	mr.HSet(boughtKey(c.ID), "9", "2")
+	if err := s.restore(ctx, c, map[int64]int64{7: 2, 8: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if got := stock(t, mr, c.ID); got != "2" {
+		t.Fatalf("stock = %s, want 2", got)
+	}
+	if got := mr.HGet(boughtKey(c.ID), "9"); got != "" {
+		t.Fatalf("stale bought = %s, want empty", got)
+	}
+	if _, err := s.Buy(ctx, 7, c.ID, 1); !errors.Is(err, ErrLimitExceeded) {
+		t.Fatalf("err = %v, want ErrLimitExceeded", err)
+	}
+	if _, err := s.Buy(ctx, 8, c.ID, 1); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBuy(t *testing.T) {
+	ctx := context.Background()
+	s, mr := newTestService(t)
+	c := activeCampaign(t, s, 3, 2)
+
+	requestID, err := s.Buy(ctx, 7, c.ID, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := stock(t, mr, c.ID); got != "1" {
+		t.Fatalf("stock = %s, want 1", got)
+	}
+	if got := mr.HGet(boughtKey(c.ID), "7"); got != "2" {
+		t.Fatalf("bought = %s, want 2", got)
+	}
+	if got := mr.HGet(requestKey(requestID), "status"); got != StatusQueued {
+		t.Fatalf("status = %s, want queued", got)
+	}
+	if entries, _ := mr.Stream(queueKey); len(entries) != 1 {
+		t.Fatalf("queue length = %d, want 1", len(entries))
+	}
+
+	if _, err := s.Buy(ctx, 7, c.ID, 1); !errors.Is(err, ErrLimitExceeded) {
+		t.Fatalf("err = %v, want ErrLimitExceeded", err)
+	}
+	if _, err := s.Buy(ctx, 8, c.ID, 2); !errors.Is(err, ErrSoldOut) {
+		t.Fatalf("err = %v, want ErrSoldOut", err)
+	}
+	if got := stock(t, mr, c.ID); got != "1" {
+		t.Fatalf("stock after rejected buys = %s, want 1", got)
+	}
+}
+
+func TestBuyOutsideCampaignTime(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestService(t)
+	now := time.Now().Unix()
+
+	notStarted := &Campaign{ID: 1, Quota: 10, PerUserLimit: 1, StartAt: now + 60, EndAt: now + 3600}
+	ended := &Campaign{ID: 2, Quota: 10, PerUserLimit: 1, StartAt: now - 3600, EndAt: now - 60}
+	for _, c := range []*Campaign{notStarted, ended} {
+		if err := s.restore(ctx, c, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := s.Buy(ctx, 7, notStarted.ID, 1); !errors.Is(err, ErrNotStarted) {
+		t.Fatalf("err = %v, want ErrNotStarted", err)
+	}
+	if _, err := s.Buy(ctx, 7, ended.ID, 1); !errors.Is(err, ErrEnded) {
+		t.Fatalf("err = %v, want ErrEnded", err)
+	}
+}
+
+func TestRelease(t *testing.T) {
+	ctx := context.Background()
+	s, mr := newTestService(t)
+	c := activeCampaign(t, s, 3, 2)
+
+	requestID, err := s.Buy(ctx, 7, c.ID, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 重复退回只生效一次
+	for i := 0; i < 2; i++ {
+		if !s.release(ctx, c.ID, requestID, "库存不足") {
+			t.Fatal("release failed")
+		}
+	}
+	if got := stock(t, mr, c.ID); got != "3" {
+		t.Fatalf("stock = %s, want 3", got)
+	}
+	if got := mr.HGet(boughtKey(c.ID), "7"); got != "0" {
+		t.Fatalf("bought = %s, want 0", got)
+	}
+	if got := mr.HGet(requestKey(requestID), "status"); got != StatusFailed {
+		t.Fatalf("status = %s, want failed", got)
+	}
+	if got := mr.HGet(requestKey(requestID), "error"); got != "库存不足" {
+		t.Fatalf("error = %s", got)
+	}
+}
+
+func TestSucceededRequestIsNotReleased(t *testing.T) {
+	ctx := context.Background()
+	s, mr := newTestService(t)
+	c := activeCampaign(t, s, 3, 2)
+
+	requestID, err := s.Buy(ctx, 7, c.ID, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := succeedScript.Run(ctx, s.rdb, []string{requestKey(requestID)}, "order-1").Err(); err != nil {
+		t.Fatal(err)
+	}
+	if !s.release(ctx, c.ID, requestID, "late failure") {
+		t.Fatal("release failed")
+	}
+	if got := stock(t, mr, c.ID); got != "2" {
+		t.Fatalf("stock = %s, want 2", got)
+	}
+
+	res, err := s.Result(ctx, 7, requestID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Status != StatusSucceeded || res.OrderID != "order-1" {
+		t.Fatalf("unexpected result: %+v", res)
+	}
+	if _, err := s.Result(ctx, 8, requestID); !errors.Is(err, ErrRequestNotFound) {
+		t.Fatalf("err = %v, want ErrRequestNotFound", err)
+	}
+}
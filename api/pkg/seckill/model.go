@@ -0,0 +1,49 @@
+package seckill
+
+import "errors"
+
+// OrderType 秒杀订单的类型，订单 ext 中以 ExtCampaignKey 记录活动 id
+const (
+	OrderType      = 2
+	ExtCampaignKey = "seckill_id"
+)
+
+// 抢购请求状态
+const (
+	StatusQueued    = "queued"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+)
+
+var (
+	ErrInvalidRequest   = errors.New("invalid seckill request")
+	ErrCampaignNotFound = errors.New("秒杀活动不存在")
+	ErrNotStarted       = errors.New("秒杀活动尚未开始")
+	ErrEnded            = errors.New("秒杀活动已结束")
+	ErrSoldOut          = errors.New("活动库存不足")
+	ErrLimitExceeded    = errors.New("超过每人限购数量")
+	ErrRequestNotFound  = errors.New("抢购请求不存在或已过期")
+)
+
+// Campaign 对应 seckill_campaign 表的一行
+type Campaign struct {
+	ID           int64
+	MerchantID   int64
+	ProductID    int64
+	SkuID        int64
+	Quota        int64
+	PerUserLimit int64
+	StartAt      int64
+	EndAt        int64
+	CreatedAt    int64
+	// Remaining 剩余活动库存，取自 Redis，不落库
+	Remaining int64
+}
+
+// Result 抢购请求的处理结果
+type Result struct {
+	RequestID string
+	Status    string
+	OrderID   string
+	Error     string
+}
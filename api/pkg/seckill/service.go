@@ -0,0 +1,300 @@
+package seckill
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	pkgCheckout "github.com/youperceive/cloudwego_instance/api/pkg/checkout"
+	pkgProduct "github.com/youperceive/cloudwego_instance/api/pkg/product"
+)
+
+const defaultWorkers = 4
+
+// Service 秒杀：活动配置保存在 MySQL，剩余库存和限购计数在 Redis 中原子扣减，
+// 抢到名额的请求进入 Redis Stream，由 Run 按固定并发走结算流程下单，避免瞬时流量直接打到库存行锁上；
+// 进入下单流程的请求记入 MySQL，Redis 数据丢失后据此重建库存
+type Service struct {
+	db           *sql.DB
+	rdb          *redis.Client
+	orchestrator *pkgCheckout.Orchestrator
+	// workers 同时下单的请求数
+	workers int
+}
+
+func NewService(db *sql.DB, rdb *redis.Client, orchestrator *pkgCheckout.Orchestrator, workers int) *Service {
+	return &Service{
+		db:           db,
+		rdb:          rdb,
+		orchestrator: orchestrator,
+		workers:      workers,
+	}
+}
+
+// NewServiceFromEnv 连接 $SECKILL_REDIS_ADDR（未配置时使用 $REDIS_ADDR），下单并发读取 $SECKILL_WORKERS，默认 4
+func NewServiceFromEnv(db *sql.DB, orchestrator *pkgCheckout.Orchestrator) *Service {
+	addr := os.Getenv("SECKILL_REDIS_ADDR")
+	if addr == "" {
+		addr = os.Getenv("REDIS_ADDR")
+	}
+	rdb := redis.NewClient(&redis.Options{Addr: addr})
+	if err := rdb.Ping(context.Background()).Err(); err != nil {
+		log.Printf("seckill: 连接 redis 失败: %v", err)
+	}
+
+	workers := defaultWorkers
+	if v := os.Getenv("SECKILL_WORKERS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			log.Printf("seckill: SECKILL_WORKERS=%q 格式错误，使用默认值 %d", v, defaultWorkers)
+		} else {
+			workers = n
+		}
+	}
+	return NewService(db, rdb, orchestrator, workers)
+}
+
+func invalid(msg string) error {
+	return fmt.Errorf("%w: %s", ErrInvalidRequest, msg)
+}
+
+// CreateCampaign 商户为自己的 SKU 创建活动并预热库存。活动库存不从 SKU 中预先扣除，
+// 下单时仍按结算流程预占库存，SKU 库存不足时该请求失败并退回名额
+func (s *Service) CreateCampaign(ctx context.Context, merchantID int64, c *Campaign) (*Campaign, error) {
+	now := time.Now().Unix()
+	switch {
+	case c.Quota <= 0 || c.Quota > math.MaxInt32:
+		return nil, invalid("活动库存不合法")
+	case c.PerUserLimit <= 0 || c.PerUserLimit > c.Quota:
+		return nil, invalid("每人限购数量必须在 1 到活动库存之间")
+	case c.EndAt <= c.StartAt || c.EndAt <= now:
+		return nil, invalid("结束时间必须晚于开始时间和当前时间")
+	}
+
+	sku, err := pkgProduct.GetSku(ctx, c.SkuID)
+	if err != nil {
+		if errors.Is(err, pkgProduct.ErrSkuNotFound) {
+			return nil, invalid(err.Error())
+		}
+		return nil, err
+	}
+	if sku.MerchantID != merchantID {
+		return nil, invalid("只能为自己的 SKU 创建秒杀活动")
+	}
+	if c.Quota > int64(sku.Stock-sku.ReservedStock) {
+		return nil, invalid("活动库存不能超过 SKU 当前可售库存")
+	}
+
+	campaign := &Campaign{
+		MerchantID:   merchantID,
+		ProductID:    sku.ProductID,
+		SkuID:        sku.ID,
+		Quota:        c.Quota,
+		PerUserLimit: c.PerUserLimit,
+		StartAt:      c.StartAt,
+		EndAt:        c.EndAt,
+		CreatedAt:    now,
+	}
+	if err := insertCampaign(ctx, s.db, campaign); err != nil {
+		return nil, err
+	}
+	if err := s.restore(ctx, campaign, nil); err != nil {
+		// 活动已创建，抢购或查询时会再次预热
+		log.Printf("seckill: 预热活动 %d 失败: %v", campaign.ID, err)
+	}
+	campaign.Remaining = campaign.Quota
+	return campaign, nil
+}
+
+// warm 预热未预热的活动：按 seckill_purchase 中未退回的抢购记录重建剩余库存和每人已抢购数量，
+// 避免 Redis 数据丢失后按完整的活动库存重新开售
+func (s *Service) warm(ctx context.Context, c *Campaign) error {
+	n, err := s.rdb.Exists(ctx, campaignKey(c.ID)).Result()
+	if err != nil || n > 0 {
+		return err
+	}
+	bought, err := sumPurchases(ctx, s.db, c.ID)
+	if err != nil {
+		return err
+	}
+	return s.restore(ctx, c, bought)
+}
+
+// restore 写入活动库存，剩余库存为活动库存减去 bought 中的数量之和；已预热过的活动不覆盖
+func (s *Service) restore(ctx context.Context, c *Campaign, bought map[int64]int64) error {
+	expireAt := time.Unix(c.EndAt, 0).Add(keepAfterEnd).Unix()
+	stock := c.Quota
+	args := []any{0, c.StartAt, c.EndAt, c.PerUserLimit, expireAt}
+	for userID, count := range bought {
+		stock -= count
+		args = append(args, userID, count)
+	}
+	args[0] = max(stock, 0)
+	return warmScript.Run(ctx, s.rdb, []string{campaignKey(c.ID), boughtKey(c.ID)}, args...).Err()
+}
+
+// WarmUp 预热所有未结束的活动，网关启动时调用，用于 Redis 数据丢失后恢复
+func (s *Service) WarmUp(ctx context.Context) error {
+	campaigns, err := listCampaigns(ctx, s.db, 0, time.Now().Unix())
+	if err != nil {
+		return err
+	}
+	for _, c := range campaigns {
+		if err := s.warm(ctx, c); err != nil {
+			return fmt.Errorf("seckill: 预热活动 %d 失败: %w", c.ID, err)
+		}
+	}
+	return nil
+}
+
+// fillRemaining 从 Redis 读取剩余库存，未预热的进行中活动先预热
+func (s *Service) fillRemaining(ctx context.Context, c *Campaign) error {
+	if c.EndAt <= time.Now().Unix() {
+		c.Remaining = 0
+		return nil
+	}
+	stock, err := s.rdb.HGet(ctx, campaignKey(c.ID), "stock").Int64()
+	if errors.Is(err, redis.Nil) {
+		if err := s.warm(ctx, c); err != nil {
+			return err
+		}
+		stock, err = s.rdb.HGet(ctx, campaignKey(c.ID), "stock").Int64()
+	}
+	if err != nil {
+		return fmt.Errorf("seckill: 查询活动 %d 剩余库存失败: %w", c.ID, err)
+	}
+	c.Remaining = stock
+	return nil
+}
+
+func (s *Service) GetCampaign(ctx context.Context, id int64) (*Campaign, error) {
+	c, err := getCampaign(ctx, s.db, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.fillRemaining(ctx, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// ListCampaigns 查询未结束的活动，merchantID 为 0 时查询所有商户
+func (s *Service) ListCampaigns(ctx context.Context, merchantID int64) ([]*Campaign, error) {
+	campaigns, err := listCampaigns(ctx, s.db, merchantID, time.Now().Unix())
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range campaigns {
+		if err := s.fillRemaining(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+	return campaigns, nil
+}
+
+func newRequestID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// Buy 抢购，成功时返回请求 id，此时名额已扣减、请求已进入下单队列
+func (s *Service) Buy(ctx context.Context, userID, campaignID, count int64) (string, error) {
+	if campaignID <= 0 {
+		return "", invalid("活动ID必须大于 0")
+	}
+	if count <= 0 {
+		return "", invalid("抢购数量必须大于 0")
+	}
+	requestID, err := newRequestID()
+	if err != nil {
+		return "", err
+	}
+
+	keys := []string{campaignKey(campaignID), boughtKey(campaignID), requestKey(requestID), queueKey}
+	run := func() (int64, error) {
+		return buyScript.Run(ctx, s.rdb, keys,
+			userID, count, time.Now().Unix(), requestID, requestTTL.Milliseconds(), campaignID).Int64()
+	}
+	code, err := run()
+	if err == nil && code == -1 {
+		// 活动未预热（刚创建时预热失败或 Redis 数据丢失），从 MySQL 加载后重试一次
+		c, getErr := getCampaign(ctx, s.db, campaignID)
+		if getErr != nil {
+			return "", getErr
+		}
+		if err := s.warm(ctx, c); err != nil {
+			return "", err
+		}
+		code, err = run()
+	}
+	if err != nil {
+		return "", fmt.Errorf("seckill: 抢购失败: %w", err)
+	}
+
+	switch code {
+	case 0:
+		return requestID, nil
+	case -1:
+		return "", ErrCampaignNotFound
+	case -2:
+		return "", ErrNotStarted
+	case -3:
+		return "", ErrEnded
+	case -4:
+		return "", ErrSoldOut
+	case -5:
+		return "", ErrLimitExceeded
+	}
+	return "", fmt.Errorf("seckill: 抢购脚本返回未知结果 %d", code)
+}
+
+// Result 查询当前用户的抢购请求。Redis 中的请求过期后，按同 id 的结算流程返回结果
+func (s *Service) Result(ctx context.Context, userID int64, requestID string) (*Result, error) {
+	v, err := s.rdb.HMGet(ctx, requestKey(requestID), "user_id", "status", "order_id", "error").Result()
+	if err != nil {
+		return nil, fmt.Errorf("seckill: 查询抢购请求失败: %w", err)
+	}
+	if v[0] != nil {
+		if v[0] != strconv.FormatInt(userID, 10) {
+			return nil, ErrRequestNotFound
+		}
+		res := &Result{RequestID: requestID}
+		res.Status, _ = v[1].(string)
+		res.OrderID, _ = v[2].(string)
+		res.Error, _ = v[3].(string)
+		return res, nil
+	}
+
+	saga, err := s.orchestrator.Get(ctx, requestID)
+	if err != nil {
+		if errors.Is(err, pkgCheckout.ErrSagaNotFound) {
+			return nil, ErrRequestNotFound
+		}
+		return nil, err
+	}
+	if saga.Request.ReqUserID != userID {
+		return nil, ErrRequestNotFound
+	}
+	res := &Result{RequestID: requestID, Status: StatusQueued}
+	switch saga.Status {
+	case pkgCheckout.StatusCompleted:
+		res.Status = StatusSucceeded
+		res.OrderID = saga.OrderID
+	case pkgCheckout.StatusFailed:
+		res.Status = StatusFailed
+		res.Error = saga.Error
+	}
+	return res, nil
+}
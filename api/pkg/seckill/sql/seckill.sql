@@ -0,0 +1,28 @@
+-- 秒杀活动：剩余库存和每人已抢购数量保存在 Redis，这里只保存活动配置
+CREATE TABLE `seckill_campaign` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `merchant_id` bigint NOT NULL COMMENT '商户ID',
+  `product_id` bigint NOT NULL,
+  `sku_id` bigint NOT NULL,
+  `quota` int NOT NULL COMMENT '活动库存',
+  `per_user_limit` int NOT NULL COMMENT '每人限购数量',
+  `start_at` bigint NOT NULL COMMENT '开始时间（unix 秒）',
+  `end_at` bigint NOT NULL COMMENT '结束时间（unix 秒）',
+  `created_at` bigint NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_end_at` (`end_at`),
+  KEY `idx_merchant_end_at` (`merchant_id`, `end_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+-- 进入下单流程的抢购请求，Redis 数据丢失后据此重建剩余库存和每人已抢购数量
+CREATE TABLE `seckill_purchase` (
+  `request_id` varchar(32) NOT NULL COMMENT '抢购请求ID，也是结算流程ID',
+  `campaign_id` bigint NOT NULL,
+  `user_id` bigint NOT NULL,
+  `count` int NOT NULL,
+  `released` tinyint NOT NULL DEFAULT 0 COMMENT '下单失败、名额已退回',
+  `created_at` bigint NOT NULL,
+  `updated_at` bigint NOT NULL,
+  PRIMARY KEY (`request_id`),
+  KEY `idx_campaign_user` (`campaign_id`, `user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
@@ -0,0 +1,123 @@
+package seckill
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const campaignColumns = "id, merchant_id, product_id, sku_id, quota, per_user_limit, start_at, end_at, created_at"
+
+func scanCampaign(row interface{ Scan(...any) error }) (*Campaign, error) {
+	var c Campaign
+	err := row.Scan(&c.ID, &c.MerchantID, &c.ProductID, &c.SkuID, &c.Quota, &c.PerUserLimit, &c.StartAt, &c.EndAt, &c.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func insertCampaign(ctx context.Context, db *sql.DB, c *Campaign) error {
+	result, err := db.ExecContext(ctx, `
+		INSERT INTO seckill_campaign (merchant_id, product_id, sku_id, quota, per_user_limit, start_at, end_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`, c.MerchantID, c.ProductID, c.SkuID, c.Quota, c.PerUserLimit, c.StartAt, c.EndAt, c.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("insertCampaign: %w", err)
+	}
+	c.ID, err = result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("insertCampaign: %w", err)
+	}
+	return nil
+}
+
+func getCampaign(ctx context.Context, db *sql.DB, id int64) (*Campaign, error) {
+	c, err := scanCampaign(db.QueryRowContext(ctx,
+		`SELECT `+campaignColumns+` FROM seckill_campaign WHERE id=?`, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrCampaignNotFound
+		}
+		return nil, fmt.Errorf("getCampaign: %w", err)
+	}
+	return c, nil
+}
+
+// listCampaigns 查询 endAfter 之后结束的活动，merchantID 为 0 时不按商户过滤
+func listCampaigns(ctx context.Context, db *sql.DB, merchantID, endAfter int64) ([]*Campaign, error) {
+	query := `SELECT ` + campaignColumns + ` FROM seckill_campaign WHERE end_at > ?`
+	args := []any{endAfter}
+	if merchantID != 0 {
+		query += ` AND merchant_id = ?`
+		args = append(args, merchantID)
+	}
+	rows, err := db.QueryContext(ctx, query+` ORDER BY start_at ASC, id ASC`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("listCampaigns: %w", err)
+	}
+	defer rows.Close()
+
+	campaigns := make([]*Campaign, 0)
+	for rows.Next() {
+		c, err := scanCampaign(rows)
+		if err != nil {
+			return nil, fmt.Errorf("listCampaigns: 解析失败: %w", err)
+		}
+		campaigns = append(campaigns, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listCampaigns: %w", err)
+	}
+	return campaigns, nil
+}
+
+// insertPurchase 记录进入下单流程的抢购请求，重复投递时不重复写入
+func insertPurchase(ctx context.Context, db *sql.DB, requestID string, campaignID, userID, count int64) error {
+	now := time.Now().Unix()
+	_, err := db.ExecContext(ctx, `
+		INSERT IGNORE INTO seckill_purchase (request_id, campaign_id, user_id, count, released, created_at, updated_at)
+		VALUES (?, ?, ?, ?, 0, ?, ?)
+	`, requestID, campaignID, userID, count, now, now)
+	if err != nil {
+		return fmt.Errorf("insertPurchase: %w", err)
+	}
+	return nil
+}
+
+// markPurchaseReleased 下单失败、名额退回后不再计入已售
+func markPurchaseReleased(ctx context.Context, db *sql.DB, requestID string) error {
+	_, err := db.ExecContext(ctx, `
+		UPDATE seckill_purchase SET released=1, updated_at=? WHERE request_id=?
+	`, time.Now().Unix(), requestID)
+	if err != nil {
+		return fmt.Errorf("markPurchaseReleased: %w", err)
+	}
+	return nil
+}
+
+// sumPurchases 汇总活动中每个用户未退回的抢购数量，key 为 user_id
+func sumPurchases(ctx context.Context, db *sql.DB, campaignID int64) (map[int64]int64, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT user_id, SUM(count) FROM seckill_purchase WHERE campaign_id=? AND released=0 GROUP BY user_id
+	`, campaignID)
+	if err != nil {
+		return nil, fmt.Errorf("sumPurchases: %w", err)
+	}
+	defer rows.Close()
+
+	bought := make(map[int64]int64)
+	for rows.Next() {
+		var userID, count int64
+		if err := rows.Scan(&userID, &count); err != nil {
+			return nil, fmt.Errorf("sumPurchases: 解析失败: %w", err)
+		}
+		bought[userID] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("sumPurchases: %w", err)
+	}
+	return bought, nil
+}
@@ -0,0 +1,189 @@
+package seckill
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	pkgCheckout "github.com/youperceive/cloudwego_instance/api/pkg/checkout"
+)
+
+const (
+	groupName = "seckill_worker"
+	// claimIdle 投递后超过该时间仍未确认的消息（处理失败或实例崩溃）会被重新领取
+	claimIdle     = time.Minute
+	claimInterval = time.Minute
+	readBlock     = 5 * time.Second
+)
+
+// Run 消费下单队列直到 ctx 结束：每个请求以请求 id 作为结算流程 id 下单，重复投递不会重复创建订单；
+// 下单失败时退回名额。处理出错的消息不确认，claimIdle 后重新领取
+func (s *Service) Run(ctx context.Context) {
+	err := s.rdb.XGroupCreateMkStream(ctx, queueKey, groupName, "0").Err()
+	if err != nil && !strings.Contains(err.Error(), "BUSYGROUP") {
+		log.Printf("seckill: 创建消费组失败: %v", err)
+		return
+	}
+	hostname, _ := os.Hostname()
+	consumer := fmt.Sprintf("%s-%d", hostname, os.Getpid())
+
+	sem := make(chan struct{}, s.workers)
+	dispatch := func(msgs []redis.XMessage) {
+		for _, msg := range msgs {
+			sem <- struct{}{}
+			go func(msg redis.XMessage) {
+				defer func() { <-sem }()
+				requestID, _ := msg.Values["request_id"].(string)
+				if !s.process(ctx, requestID) {
+					return
+				}
+				if err := s.rdb.XAck(ctx, queueKey, groupName, msg.ID).Err(); err != nil {
+					log.Printf("seckill: 确认消息 %s 失败: %v", msg.ID, err)
+					return
+				}
+				s.rdb.XDel(ctx, queueKey, msg.ID)
+			}(msg)
+		}
+	}
+
+	var lastClaim time.Time
+	for ctx.Err() == nil {
+		if time.Since(lastClaim) >= claimInterval {
+			lastClaim = time.Now()
+			msgs, err := s.claimStale(ctx, consumer)
+			if err != nil {
+				log.Printf("seckill: 领取超时消息失败: %v", err)
+			}
+			dispatch(msgs)
+		}
+
+		streams, err := s.rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    groupName,
+			Consumer: consumer,
+			Streams:  []string{queueKey, ">"},
+			Count:    int64(s.workers),
+			Block:    readBlock,
+		}).Result()
+		if err != nil {
+			if !errors.Is(err, redis.Nil) && ctx.Err() == nil {
+				log.Printf("seckill: 读取下单队列失败: %v", err)
+				time.Sleep(time.Second)
+			}
+			continue
+		}
+		for _, stream := range streams {
+			dispatch(stream.Messages)
+		}
+	}
+}
+
+// claimStale 领取超过 claimIdle 未确认的消息
+func (s *Service) claimStale(ctx context.Context, consumer string) ([]redis.XMessage, error) {
+	pending, err := s.rdb.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: queueKey,
+		Group:  groupName,
+		Idle:   claimIdle,
+		Start:  "-",
+		End:    "+",
+		Count:  100,
+	}).Result()
+	if err != nil || len(pending) == 0 {
+		return nil, err
+	}
+	ids := make([]string, 0, len(pending))
+	for _, p := range pending {
+		ids = append(ids, p.ID)
+	}
+	return s.rdb.XClaim(ctx, &redis.XClaimArgs{
+		Stream:   queueKey,
+		Group:    groupName,
+		Consumer: consumer,
+		MinIdle:  claimIdle,
+		Messages: ids,
+	}).Result()
+}
+
+// process 为一个抢购请求下单，返回 true 表示已处理完毕（成功、失败或请求已过期），可以确认消息
+func (s *Service) process(ctx context.Context, requestID string) bool {
+	v, err := s.rdb.HMGet(ctx, requestKey(requestID), "campaign_id", "user_id", "count", "status").Result()
+	if err != nil {
+		log.Printf("seckill: 查询抢购请求 %s 失败: %v", requestID, err)
+		return false
+	}
+	if v[0] == nil {
+		log.Printf("seckill: 抢购请求 %s 已过期，跳过", requestID)
+		return true
+	}
+	if v[3] != StatusQueued {
+		return true
+	}
+	campaignID, _ := strconv.ParseInt(v[0].(string), 10, 64)
+	userID, _ := strconv.ParseInt(v[1].(string), 10, 64)
+	count, _ := strconv.ParseInt(v[2].(string), 10, 64)
+
+	c, err := getCampaign(ctx, s.db, campaignID)
+	if errors.Is(err, ErrCampaignNotFound) {
+		return s.release(ctx, campaignID, requestID, err.Error())
+	}
+	if err != nil {
+		log.Printf("seckill: 抢购请求 %s 查询活动失败: %v", requestID, err)
+		return false
+	}
+	// 下单前先落库，Redis 数据丢失后预热时据此扣除已售的名额
+	if err := insertPurchase(ctx, s.db, requestID, campaignID, userID, count); err != nil {
+		log.Printf("seckill: 记录抢购请求 %s 失败: %v", requestID, err)
+		return false
+	}
+	// fail 先在 MySQL 中标记退回，再退回 Redis 中的名额
+	fail := func(reason string) bool {
+		if err := markPurchaseReleased(ctx, s.db, requestID); err != nil {
+			log.Printf("seckill: 标记抢购请求 %s 退回失败: %v", requestID, err)
+			return false
+		}
+		return s.release(ctx, campaignID, requestID, reason)
+	}
+
+	saga, err := s.orchestrator.CheckoutWithID(ctx, requestID, &pkgCheckout.Request{
+		Type:       OrderType,
+		ReqUserID:  userID,
+		RespUserID: c.MerchantID,
+		Items: []*pkgCheckout.Item{{
+			ProductID: c.ProductID,
+			SkuID:     c.SkuID,
+			Count:     count,
+		}},
+		Ext: map[string]string{ExtCampaignKey: strconv.FormatInt(c.ID, 10)},
+	})
+	switch {
+	case errors.Is(err, pkgCheckout.ErrInvalidRequest):
+		return fail(err.Error())
+	case err != nil:
+		// 流程未结束，消息不确认，稍后重新领取时接着执行
+		log.Printf("seckill: 抢购请求 %s 下单未完成: %v", requestID, err)
+		return false
+	case saga.Status == pkgCheckout.StatusCompleted:
+		if err := succeedScript.Run(ctx, s.rdb, []string{requestKey(requestID)}, saga.OrderID).Err(); err != nil {
+			log.Printf("seckill: 记录抢购请求 %s 的结果失败: %v", requestID, err)
+			return false
+		}
+		return true
+	default:
+		return fail(saga.Error)
+	}
+}
+
+// release 下单失败，退回名额
+func (s *Service) release(ctx context.Context, campaignID int64, requestID, reason string) bool {
+	keys := []string{campaignKey(campaignID), boughtKey(campaignID), requestKey(requestID)}
+	if err := releaseScript.Run(ctx, s.rdb, keys, reason).Err(); err != nil {
+		log.Printf("seckill: 退回抢购请求 %s 的名额失败: %v", requestID, err)
+		return false
+	}
+	return true
+}
@@ -5,11 +5,15 @@ package main
 import (
 	"github.com/cloudwego/hertz/pkg/app/server"
 	handler "github.com/youperceive/cloudwego_instance/api/biz/handler"
+	order "github.com/youperceive/cloudwego_instance/api/biz/handler/order"
 )
 
 // customizeRegister registers customize routers.
 func customizedRegister(r *server.Hertz) {
 	r.GET("/ping", handler.Ping)
 
+	// 报表的 CSV 导出，参数与 /report 相同
+	r.POST("/report_csv", order.ReportCSV)
+
 	// your code ...
 }
@@ -6,6 +6,9 @@ enum Code {
     DB_ERR = 2,
     SERVICE_ERR = 3,
     NOT_FOUND = 4,
+    INVALID_STATUS_TRANSITION = 5, // 订单状态机不允许的状态流转
+    VERSION_CONFLICT = 6,          // 乐观锁冲突：数据已被其他请求修改
+    IDEMPOTENCY_CONFLICT = 7,      // 幂等键已被另一个内容不同的请求使用
 }
 
 struct BaseResponse {
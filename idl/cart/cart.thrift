@@ -0,0 +1,90 @@
+namespace go cart
+
+include "../base/base.thrift"
+
+// 购物车项：购物车只保存 sku_id 和数量，价格、库存在查询时从 sku 表实时读取
+struct CartItem {
+    1: i64 sku_id,           // 规格 id
+    2: i64 product_id,       // 商品 id
+    3: i64 merchant_id,      // 商户 id
+    4: string sku_code,      // 规格编码
+    5: i64 count,            // 加购数量
+    6: i64 price,            // 当前单价（分）
+    7: i64 stock,            // 当前可售库存（库存 - 已预占）
+    8: bool available,       // SKU 存在且可售库存不少于加购数量
+    9: i64 added_at,         // 加购时间（unix 秒）
+}
+
+struct AddCartItemRequest {
+    1: i64 sku_id,
+    2: i64 count,            // 增加的数量，≥1；购物车中已有该 SKU 时累加
+}
+
+struct AddCartItemResponse {
+    1: base.BaseResponse baseResp,
+    2: i64 count,            // 累加后的数量
+}
+
+struct UpdateCartItemRequest {
+    1: i64 sku_id,
+    2: i64 count,            // 新的数量，≥1；删除请用 /cart_remove
+}
+
+struct UpdateCartItemResponse {
+    1: base.BaseResponse baseResp,
+}
+
+struct RemoveCartItemRequest {
+    1: list<i64> sku_ids,
+}
+
+struct RemoveCartItemResponse {
+    1: base.BaseResponse baseResp,
+}
+
+struct ListCartRequest {
+}
+
+struct ListCartResponse {
+    1: base.BaseResponse baseResp,
+    2: list<CartItem> items,  // 按加购时间倒序
+    3: i64 total_amount,      // 可结算项的总金额（分）
+}
+
+struct ClearCartRequest {
+}
+
+struct ClearCartResponse {
+    1: base.BaseResponse baseResp,
+}
+
+struct CartCheckoutRequest {
+    1: i32 type,                      // 订单类型
+    2: optional list<i64> sku_ids,    // 要结算的 SKU，不传则结算整个购物车
+    3: map<string, string> ext,       // 订单扩展字段，写入每个订单
+    4: optional i64 address_id,       // 收货地址 id，所有订单使用同一地址
+}
+
+// 整个购物车走一次结算流程：订单服务按 SKU 所属商户拆分为一个父订单和每个商户一个子订单，全部成功或全部失败
+struct CartCheckoutOrder {
+    1: i64 merchant_id,
+    2: list<i64> sku_ids,
+    3: string order_id,
+}
+
+struct CartCheckoutResponse {
+    1: base.BaseResponse baseResp,
+    2: string saga_id,
+    3: string parent_order_id,           // 成功时返回父订单 id
+    4: list<CartCheckoutOrder> orders,   // 成功时返回各商户的子订单，按商户 id 升序
+}
+
+// 购物车：按 token 中的用户保存在 Redis，每次写入都会刷新过期时间
+service CartService {
+    AddCartItemResponse AddCartItem(1: AddCartItemRequest req) (api.post = "/cart_add"),
+    UpdateCartItemResponse UpdateCartItem(1: UpdateCartItemRequest req) (api.post = "/cart_update"),
+    RemoveCartItemResponse RemoveCartItem(1: RemoveCartItemRequest req) (api.post = "/cart_remove"),
+    ListCartResponse ListCart(1: ListCartRequest req) (api.post = "/cart_list"),
+    ClearCartResponse ClearCart(1: ClearCartRequest req) (api.post = "/cart_clear"),
+    CartCheckoutResponse CartCheckout(1: CartCheckoutRequest req) (api.post = "/cart_checkout"),
+}
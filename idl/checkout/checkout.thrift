@@ -0,0 +1,30 @@
+namespace go checkout
+
+include "../base/base.thrift"
+
+// 结算项：价格由订单服务按商品目录计算，这里不接收价格
+struct CheckoutItem {
+    1: i64 product_id,          // 商品 id
+    2: i64 sku_id,              // 规格 id
+    3: i64 count,               // 购买数量，≥1
+    4: map<string, string> ext, // 订单项扩展字段
+}
+
+struct CheckoutRequest {
+    1: i32 type,                // 订单类型
+    2: i64 resp_user_id,        // 商户 id
+    3: list<CheckoutItem> items,
+    4: map<string, string> ext, // 订单扩展字段
+    5: optional i64 address_id, // 收货地址 id，必须是当前用户的地址
+}
+
+struct CheckoutResponse {
+    1: base.BaseResponse baseResp,
+    2: string saga_id,  // 结算流程 id，可用于排查补偿记录
+    3: string order_id, // 成功时返回订单 id
+}
+
+// 结算：扣减库存 + 创建订单，任一步失败时自动补偿（回补库存、取消订单）
+service CheckoutService {
+    CheckoutResponse Checkout(1: CheckoutRequest req) (api.post = "/checkout"),
+}
@@ -0,0 +1,106 @@
+namespace go groupbuy
+
+include "../base/base.thrift"
+
+// 团购活动：第一个买家开团，其他买家凭团 id 参团，在成团期限内支付人数达到成团人数即成团
+struct GroupCampaign {
+    1: i64 id,
+    2: i64 merchant_id,
+    3: i64 product_id,
+    4: i64 sku_id,
+    5: i64 group_size,     // 成团人数，≥2
+    6: i64 group_ttl,      // 成团期限（秒），从开团时算起
+    7: i64 start_at,       // 活动开始时间（unix 秒），之后才能开团
+    8: i64 end_at,         // 活动结束时间（unix 秒），之后不能再开团，已开的团按各自期限结算
+    9: i64 created_at,
+}
+
+struct GroupMember {
+    1: i64 user_id,
+    2: string order_id,    // 参团订单 id，下单中时为空
+    3: bool paid,          // 是否已支付
+    4: i64 joined_at,
+}
+
+// 一个团的进度
+struct Group {
+    1: i64 id,
+    2: i64 campaign_id,
+    3: i64 leader_user_id, // 开团人
+    4: string status,      // forming=拼团中 succeeded=已成团 failed=未成团（订单已取消，已支付的自动退款）
+    5: i64 group_size,     // 成团人数
+    6: i64 joined,         // 已参团人数（未支付的参团订单取消后释放名额）
+    7: i64 paid,           // 已支付人数，达到成团人数即成团
+    8: i64 deadline,       // 成团期限（unix 秒）
+    9: list<GroupMember> members,
+    10: i64 created_at,
+}
+
+struct CreateGroupCampaignRequest {
+    1: i64 sku_id,                        // 必须是当前用户（商户）的 SKU
+    2: i64 group_size,
+    3: optional i64 group_ttl = 86400,    // 默认 24 小时
+    4: i64 start_at,
+    5: i64 end_at,
+}
+
+struct CreateGroupCampaignResponse {
+    1: base.BaseResponse baseResp,
+    2: GroupCampaign campaign,
+}
+
+struct ListGroupCampaignRequest {
+    1: optional i64 merchant_id,          // 不传则查询所有商户
+}
+
+struct ListGroupCampaignResponse {
+    1: base.BaseResponse baseResp,
+    2: list<GroupCampaign> campaigns,     // 未结束的活动，按开始时间排序
+}
+
+struct OpenGroupRequest {
+    1: i64 campaign_id,
+    2: optional i64 count = 1,            // 购买数量，默认 1
+    3: map<string, string> ext,           // 订单扩展字段
+}
+
+struct JoinGroupRequest {
+    1: i64 group_id,
+    2: optional i64 count = 1,
+    3: map<string, string> ext,
+}
+
+// 开团、参团都会创建一个待支付的团购订单（type=3），需通过 /create_payment 支付
+struct GroupOrderResponse {
+    1: base.BaseResponse baseResp,
+    2: i64 group_id,
+    3: string order_id,
+    4: string saga_id,                    // 结算流程 id，可用于排查补偿记录
+}
+
+struct ListGroupRequest {
+    1: i64 campaign_id,
+}
+
+struct ListGroupResponse {
+    1: base.BaseResponse baseResp,
+    2: list<Group> groups,                // 拼团中的团，按成团期限排序，不含成员明细；paid 为后台定期统计的值
+}
+
+struct QueryGroupRequest {
+    1: i64 group_id,
+}
+
+struct QueryGroupResponse {
+    1: base.BaseResponse baseResp,
+    2: Group group,
+}
+
+service GroupBuyService {
+    CreateGroupCampaignResponse CreateGroupCampaign(1: CreateGroupCampaignRequest req) (api.post = "/group_campaign_create"),
+    ListGroupCampaignResponse ListGroupCampaign(1: ListGroupCampaignRequest req) (api.post = "/group_campaign_list"),
+    GroupOrderResponse OpenGroup(1: OpenGroupRequest req) (api.post = "/group_open"),
+    GroupOrderResponse JoinGroup(1: JoinGroupRequest req) (api.post = "/group_join"),
+    ListGroupResponse ListGroup(1: ListGroupRequest req) (api.post = "/group_list"),
+    QueryGroupResponse QueryGroup(1: QueryGroupRequest req) (api.post = "/group_query"),
+}
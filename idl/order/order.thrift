@@ -9,21 +9,113 @@ struct OrderItem {
     3: i64 product_id,          // 商品 id，非负
     4: i64 sku_id,              // 规格 id：蓝/XL/件，仅存储数值，无业务语义，非负
     5: i64 count,               // 购买数量，≥1（最少1件）
-    6: i64 price,               // 单价（分），≥0（避免浮点数精度问题）
+    6: i64 price,               // 单价（分），≥0（避免浮点数精度问题），下单时以商品目录价格为准
     7: map<string, string> ext, // 订单项扩展字段
+    8: i64 subtotal,            // 小计（分）= price * count
+}
+
+// 订单状态流转记录（每次状态变更追加一条，只增不改）
+struct StatusRecord {
+    1: i32 from,       // 变更前状态
+    2: i32 to,         // 变更后状态
+    3: i64 changed_at, // 变更时间戳（秒级）
+}
+
+enum RefundStatus {
+    REQUESTED = 1, // 买家已申请，等待商户审核
+    APPROVED = 2,  // 商户已同意，库存已退回，等待退款到账
+    REJECTED = 3,  // 商户已拒绝（终态）
+    COMPLETED = 4, // 退款已到账（终态）
+}
+
+// 退款单中的一个订单项
+struct RefundItem {
+    1: i64 item_id,    // 订单项 id（OrderItem.id）
+    2: i64 product_id, // 冗余自订单项，便于退回库存
+    3: i64 sku_id,     // 冗余自订单项，便于退回库存
+    4: i64 count,      // 退货数量，≥1，累计不能超过订单项的购买数量
+}
+
+// 退款单（内嵌在 Order.refunds 中，一个订单可以有多次部分退款）
+struct Refund {
+    1: i64 id,                // 退款单 id（雪花算法生成）
+    2: list<RefundItem> items,
+    3: i64 amount,            // 退款金额（分）
+    4: RefundStatus status,
+    5: string reason,         // 买家填写的退款原因
+    6: string reject_reason,  // 商户拒绝原因
+    7: i64 created_at,        // 申请时间（unix 秒）
+    8: i64 reviewed_at,       // 审核时间（unix 秒），未审核为 0
+    9: i64 completed_at,      // 退款到账时间（unix 秒），未到账为 0
+}
+
+enum ShipmentStatus {
+    SHIPPED = 1,   // 已发货，运输中
+    DELIVERED = 2, // 已签收（终态）
+}
+
+// 包裹中的一个订单项
+struct ShipmentItem {
+    1: i64 item_id,    // 订单项 id（OrderItem.id）
+    2: i64 product_id, // 冗余自订单项
+    3: i64 sku_id,     // 冗余自订单项
+    4: i64 count,      // 本包裹发出的数量，≥1，累计不能超过订单项的购买数量减去已退货数量
+}
+
+// 物流轨迹（由商户录入，买家可见，只增不改）
+struct TrackingEvent {
+    1: i64 occurred_at,    // 物流事件发生时间（unix 秒）
+    2: string description, // 事件描述，例如"已揽收"、"到达杭州转运中心"
+    3: string location,    // 事件发生地，可为空
+    4: i64 created_at,     // 录入时间（unix 秒）
+}
+
+// 发货单（内嵌在 Order.shipments 中，一个订单可以拆成多个包裹发货）
+struct Shipment {
+    1: i64 id,                     // 发货单 id（雪花算法生成）
+    2: string carrier,             // 承运商，例如"顺丰"
+    3: string tracking_no,         // 运单号
+    4: list<ShipmentItem> items,
+    5: ShipmentStatus status,
+    6: list<TrackingEvent> events, // 按录入先后排列
+    7: i64 shipped_at,             // 发货时间（unix 秒）
+    8: i64 delivered_at,           // 签收时间（unix 秒），未签收为 0
+}
+
+// 收货地址快照：下单时从用户地址簿复制，之后用户修改或删除地址不影响订单
+struct ShippingAddress {
+    1: i64 address_id,       // 地址簿中的地址 id，仅作溯源
+    2: string receiver_name,
+    3: string phone,
+    4: string province,
+    5: string city,
+    6: string district,
+    7: string detail,
 }
 
 // 订单主表（id 复用 MongoDB ObjectID 字符串）
 struct Order {
     1: string id,               // 订单唯一标识（MongoDB ObjectID 字符串）
     2: i32 type,                // 订单类型（决定 ext 字段语义）：1=普通订单，2=秒杀订单，3=团购订单
-    3: i32 status,              // 订单状态（仅存储数值）：1=待支付，2=已支付，3=已取消，4=已完成
+    3: i32 status,              // 订单状态（仅存储数值）：1=待支付，2=已支付，3=已取消，4=已完成，5=已成团（仅团购订单）
     4: i64 req_user_id,         // 订单发起人 id（A 向 B 下单，A 的 id），非负
     5: i64 resp_user_id,        // 订单接收人 id（A 向 B 下单，B 的 id），非负
     6: list<OrderItem> items,   // 订单明细
     7: i64 created_at,          // 创建时间戳（秒级）
     8: i64 updated_at,          // 更新时间戳（秒级）
     9: map<string, string> ext, // 订单扩展字段（按 type 存储差异化数据）
+    10: list<StatusRecord> status_history, // 状态流转记录，按时间先后排列
+    11: i64 version,                       // 乐观锁版本号，创建时为 1，每次写入 +1
+    12: i64 total_amount,                  // 订单总金额（分）= 各订单项 subtotal 之和
+    13: i64 pay_deadline,                  // 支付期限（unix 秒），超时未支付自动取消，0 表示不限
+    14: list<Refund> refunds,              // 退款单，按申请时间先后排列
+    15: i64 refunded_amount,               // 已到账的退款总额（分）
+    16: string idempotency_key,            // 创建时传入的幂等键，未传为空
+    17: string request_digest,             // 创建请求的摘要，用于识别同一幂等键下内容不同的请求
+    18: string parent_id,                  // 由 CreateFromCart 拆分出的子订单所属的父订单 id，其他订单为空
+    19: list<Shipment> shipments,          // 发货单，按发货时间先后排列
+    20: i64 auto_confirm_at,               // 全部发出后自动确认收货的时间（unix 秒），0 表示尚未全部发出或不自动确认
+    21: ShippingAddress shipping_address,  // 收货地址快照，下单时未指定地址为空，创建后不可修改
 }
 
 // 创建订单时的订单项参数（剥离 id/order_id，由服务端生成）
@@ -31,7 +123,7 @@ struct OrderItemForCreate {
     1: i64 product_id,          // 商品 id，非负
     2: i64 sku_id,              // 规格 id，非负
     3: i64 count,               // 购买数量，≥1
-    4: i64 price,               // 单价（分），≥0
+    4: i64 price,               // 单价（分），仅作参考：服务端会按商品目录价格覆盖
     5: map<string, string> ext,
 }
 
@@ -39,9 +131,11 @@ struct CreateRequest {
     1: i32 type,
     2: i32 status,
     3: i64 req_user_id,
-    4: i64 resp_user_id,
+    4: i64 resp_user_id,               // 商户 id，所有订单项的 SKU 都必须属于该商户
     5: list<OrderItemForCreate> items,
     6: map<string, string> ext,
+    7: optional string idempotency_key, // 幂等键（≤64 字符），同一 req_user_id 下唯一：相同请求重试返回原订单 id，内容不同返回 IDEMPOTENCY_CONFLICT
+    8: optional i64 address_id,         // 收货地址 id，必须属于 req_user_id，下单时复制为 Order.shipping_address
 }
 
 struct CreateResponse {
@@ -49,10 +143,18 @@ struct CreateResponse {
     2: string order_id,            // 创建成功的订单 id（MongoDB ObjectID 字符串）
 }
 
+enum ExtUpdateMode {
+    MERGE = 1,   // 逐个 key 写入 Order.ext，未出现的 key 保持不变
+    REPLACE = 2, // 用 ext 整体替换 Order.ext
+}
+
 struct UpdateRequest {
     1: string id,
-    2: optional i32 status,              // 订单状态，调用方自行确认业务语义，服务端仅存储值
-    3: optional map<string, string> ext, // 扩展字段：覆盖（而非合并）原有值，如需合并请传全量
+    2: optional i32 status,                          // 目标订单状态，服务端按订单类型的状态机校验，非法流转返回 INVALID_STATUS_TRANSITION
+    3: optional map<string, string> ext,             // 只作用于 Order.ext，key 不能为空、不能包含 . 和 $
+    4: optional i64 expected_version,                // 期望的 Order.version，与当前版本不一致时返回 VERSION_CONFLICT，不传则不校验
+    5: optional ExtUpdateMode ext_mode = ExtUpdateMode.MERGE, // ext 的写入方式，默认合并
+    6: optional list<string> ext_delete_keys,        // 从 Order.ext 删除的 key，仅 MERGE 模式可用，不能与 ext 中的 key 重复
 }
 
 struct UpdateResponse {
@@ -91,9 +193,257 @@ struct QueryOrderIdResponse {
     5: i32 page_size,              // 当前页大小（和请求一致）
 }
 
+struct BatchQueryOrderInfoRequest {
+    1: list<string> ids,             // 订单 id 列表，1≤len≤100
+    2: optional list<string> fields, // 只返回这些字段（Order 的字段名，如 status、items），不传返回完整订单
+}
+
+struct BatchQueryOrderInfoResponse {
+    1: base.BaseResponse baseResp,
+    2: list<Order> orders,          // 按请求中 ids 的顺序排列，重复的 id 只返回一次，不包含未找到的订单
+    3: list<string> not_found_ids,  // 不存在或格式错误的订单 id，合法的 id 统一为小写
+}
+
+struct RefundItemForRequest {
+    1: i64 item_id, // 订单项 id
+    2: i64 count,   // 退货数量，≥1
+}
+
+// 只有已支付或已完成的订单可以申请退款
+struct RequestRefundRequest {
+    1: string order_id,
+    2: i64 req_user_id,                    // 申请人，必须是订单的 req_user_id
+    3: list<RefundItemForRequest> items,   // 退货的订单项，同一订单项不能重复出现
+    4: optional i64 amount,                // 退款金额（分），不传时按 price * count 计算，不能超过该值
+    5: string reason,
+}
+
+struct RequestRefundResponse {
+    1: base.BaseResponse baseResp,
+    2: i64 refund_id,
+}
+
+struct ReviewRefundRequest {
+    1: string order_id,
+    2: i64 refund_id,
+    3: i64 resp_user_id,                   // 审核人，必须是订单的 resp_user_id
+    4: bool approve,                       // true 同意，false 拒绝
+    5: optional string reject_reason,      // 拒绝时填写
+}
+
+struct ReviewRefundResponse {
+    1: base.BaseResponse baseResp,
+    2: Refund refund,                      // 审核后的退款单，同意时调用方据此退回库存
+}
+
+struct CompleteRefundRequest {
+    1: string order_id,
+    2: i64 refund_id,
+    3: i64 resp_user_id,                   // 操作人，必须是订单的 resp_user_id
+}
+
+struct CompleteRefundResponse {
+    1: base.BaseResponse baseResp,
+}
+
+struct QueryRefundsRequest {
+    1: string order_id,
+    2: optional list<RefundStatus> statuses, // 只返回这些状态的退款单，不传返回全部
+}
+
+struct QueryRefundsResponse {
+    1: base.BaseResponse baseResp,
+    2: list<Refund> refunds,
+    3: i64 refunded_amount,                // 已到账的退款总额（分）
+    4: i64 refundable_amount,              // 还可以申请的退款金额（分）
+}
+
+struct ShipmentItemForRequest {
+    1: i64 item_id, // 订单项 id
+    2: i64 count,   // 发货数量，≥1
+}
+
+// 只有已支付（团购订单为已成团）的订单可以发货
+struct CreateShipmentRequest {
+    1: string order_id,
+    2: i64 resp_user_id,                   // 发货人，必须是订单的 resp_user_id
+    3: string carrier,
+    4: string tracking_no,
+    5: list<ShipmentItemForRequest> items, // 本包裹中的订单项，同一订单项不能重复出现
+}
+
+struct CreateShipmentResponse {
+    1: base.BaseResponse baseResp,
+    2: Shipment shipment,
+}
+
+struct AddTrackingEventRequest {
+    1: string order_id,
+    2: i64 shipment_id,
+    3: i64 resp_user_id,           // 录入人，必须是订单的 resp_user_id
+    4: string description,
+    5: optional string location,
+    6: optional i64 occurred_at,   // 不传时取当前时间
+    7: optional bool delivered,    // true 表示本条轨迹为签收，发货单随之变为已签收
+}
+
+struct AddTrackingEventResponse {
+    1: base.BaseResponse baseResp,
+    2: Shipment shipment,
+}
+
+// 买家确认收货，订单的所有商品都已发出后才能确认，确认后订单变为已完成
+struct ConfirmDeliveryRequest {
+    1: string order_id,
+    2: i64 req_user_id, // 确认人，必须是订单的 req_user_id
+}
+
+struct ConfirmDeliveryResponse {
+    1: base.BaseResponse baseResp,
+}
+
+struct DecodeIdRequest {
+    1: i64 id, // 雪花算法生成的 id（OrderItem.id、Refund.id、Shipment.id）
+}
+
+struct DecodeIdResponse {
+    1: base.BaseResponse baseResp,
+    2: i64 timestamp_ms, // 生成时间（unix 毫秒）
+    3: i64 node,         // 生成该 id 的节点 id
+    4: i64 sequence,     // 同一毫秒内的序号
+}
+
+enum ReportGranularity {
+    DAY = 1,
+    WEEK = 2,  // 周一为一周的第一天
+    MONTH = 3,
+}
+
+// 商户销售报表，按 created_at 统计 [from, to) 内的订单
+struct ReportRequest {
+    1: i64 resp_user_id,                                     // 商户 id
+    2: i64 from,                                             // 起始时间（unix 秒，包含）
+    3: i64 to,                                               // 结束时间（unix 秒，不包含），跨度不超过 2 年
+    4: optional list<i32> statuses,                          // 参与统计的订单状态，默认 2=已支付、5=已成团、4=已完成
+    5: optional ReportGranularity granularity = ReportGranularity.DAY,
+    6: optional string timezone = "UTC",                     // 按该时区划分日/周/月，IANA 名称（如 Asia/Shanghai）或偏移（如 +08:00）
+    7: optional i32 top_n = 10,                              // 热销 SKU 返回的条数，1≤top_n≤100
+}
+
+// 一个统计周期
+struct ReportBucket {
+    1: string period,          // 周期标签：DAY/WEEK 为起始日期 2006-01-02，MONTH 为 2006-01
+    2: i64 period_start,       // 周期起始时间（unix 秒）
+    3: i64 order_count,        // 订单数
+    4: i64 revenue,            // 销售额（分）= 订单 total_amount 之和
+    5: i64 refunded_amount,    // 已到账的退款（分）
+}
+
+struct SkuSales {
+    1: i64 sku_id,
+    2: i64 product_id,
+    3: i64 quantity,           // 销量
+    4: i64 revenue,            // 销售额（分）= 订单项 subtotal 之和
+    5: i64 order_count,        // 包含该 SKU 的订单数
+}
+
+struct ReportResponse {
+    1: base.BaseResponse baseResp,
+    2: list<ReportBucket> buckets,             // 按时间先后排列，没有订单的周期不返回
+    3: list<SkuSales> top_skus_by_quantity,    // 按销量倒序
+    4: list<SkuSales> top_skus_by_revenue,     // 按销售额倒序
+    5: i64 total_order_count,
+    6: i64 total_revenue,
+    7: i64 total_refunded_amount,
+}
+
+enum SearchSortField {
+    CREATED_AT = 1, // 按创建时间排序
+    UPDATED_AT = 2, // 按更新时间排序
+}
+
+// 所有条件之间为“且”的关系，未传的条件不参与过滤
+struct SearchOrdersRequest {
+    1: optional list<i32> statuses,          // 状态集合，命中任意一个即可
+    2: optional i32 type,                    // 订单类型
+    3: optional i64 req_user_id,             // 买家 id
+    4: optional i64 resp_user_id,            // 商户 id
+    5: optional i64 created_from,            // 创建时间 ≥ created_from（unix 秒）
+    6: optional i64 created_to,              // 创建时间 < created_to（unix 秒）
+    7: optional i64 updated_from,            // 更新时间 ≥ updated_from（unix 秒）
+    8: optional i64 updated_to,              // 更新时间 < updated_to（unix 秒）
+    9: optional i64 product_id,              // 包含该商品的订单
+    10: optional i64 sku_id,                 // 包含该 SKU 的订单，与 product_id 同时传时须为同一订单项
+    11: optional map<string, string> ext,    // ext 字段精确匹配，所有 key 都需匹配
+    12: optional SearchSortField sort_by = SearchSortField.CREATED_AT,
+    13: optional bool ascending = false,     // 默认按时间倒序
+    14: optional string cursor,              // 上一页返回的 next_cursor，首页不传
+    15: optional i32 limit = 20,             // 每页条数，1≤limit≤100
+    16: optional bool include_orders = false, // 为 true 时同时返回订单内容
+    17: optional list<string> fields,        // include_orders 时只返回这些字段（Order 的字段名，如 status、items），不传返回完整订单
+    18: optional string parent_id,           // 父订单下的子订单
+}
+
+struct SearchOrdersResponse {
+    1: base.BaseResponse baseResp,
+    2: list<string> order_ids, // 本页订单 id，按排序条件排列
+    3: list<Order> orders,     // include_orders 时返回，与 order_ids 一一对应
+    4: string next_cursor,     // 下一页游标，为空表示没有更多数据
+}
+
+// 跨商户的购物车下单：按 SKU 所属商户拆分为多个子订单，挂在同一个父订单下
+struct CreateFromCartRequest {
+    1: i32 type,
+    2: i32 status,
+    3: i64 req_user_id,
+    4: list<OrderItemForCreate> items,  // 可以包含多个商户的 SKU，商户由商品目录的 sku.merchant_id 决定
+    5: map<string, string> ext,         // 写入每个子订单的 ext
+    6: optional string idempotency_key, // 同 CreateRequest.idempotency_key，作用于整个父订单
+    7: optional i64 address_id,         // 同 CreateRequest.address_id，所有子订单使用同一地址快照
+}
+
+// 父订单只记录子订单的归属，状态和金额由子订单汇总
+struct ParentOrder {
+    1: string id,
+    2: i64 req_user_id,
+    3: i32 status,              // 由子订单推导：有待支付的为待支付；全部取消为已取消；未取消的全部完成为已完成；否则为已支付
+    4: i64 total_amount,        // 子订单 total_amount 之和（分）
+    5: list<string> order_ids,  // 子订单 id，按商户 id 升序
+    6: i64 created_at,
+}
+
+struct CreateFromCartResponse {
+    1: base.BaseResponse baseResp,
+    2: ParentOrder parent_order,
+    3: list<Order> orders,      // 子订单，与 parent_order.order_ids 一一对应
+}
+
+struct QueryParentOrderRequest {
+    1: string parent_id,
+}
+
+struct QueryParentOrderResponse {
+    1: base.BaseResponse baseResp,
+    2: ParentOrder parent_order,
+    3: list<Order> orders,      // 子订单，与 parent_order.order_ids 一一对应
+}
+
 service OrderService {
     CreateResponse Create(1: CreateRequest req) (api.post = "/create"),
     UpdateResponse Update(1: UpdateRequest req) (api.post = "/update"),
     QueryOrderInfoResponse QueryOrderInfo(1: QueryOrderInfoRequest req) (api.post = "/query_order_info"),
+    BatchQueryOrderInfoResponse BatchQueryOrderInfo(1: BatchQueryOrderInfoRequest req) (api.post = "/batch_query_order_info"),
     QueryOrderIdResponse QueryOrderId(1: QueryOrderIdRequest req) (api.post = "/query_order_id"),
+    SearchOrdersResponse SearchOrders(1: SearchOrdersRequest req) (api.post = "/search_orders"),
+    RequestRefundResponse RequestRefund(1: RequestRefundRequest req) (api.post = "/request_refund"),
+    ReviewRefundResponse ReviewRefund(1: ReviewRefundRequest req) (api.post = "/review_refund"),
+    CompleteRefundResponse CompleteRefund(1: CompleteRefundRequest req) (api.post = "/complete_refund"),
+    QueryRefundsResponse QueryRefunds(1: QueryRefundsRequest req) (api.post = "/query_refunds"),
+    CreateShipmentResponse CreateShipment(1: CreateShipmentRequest req) (api.post = "/create_shipment"),
+    AddTrackingEventResponse AddTrackingEvent(1: AddTrackingEventRequest req) (api.post = "/add_tracking_event"),
+    ConfirmDeliveryResponse ConfirmDelivery(1: ConfirmDeliveryRequest req) (api.post = "/confirm_delivery"),
+    DecodeIdResponse DecodeId(1: DecodeIdRequest req) (api.post = "/decode_id"),
+    ReportResponse Report(1: ReportRequest req) (api.post = "/report"),
+    CreateFromCartResponse CreateFromCart(1: CreateFromCartRequest req) (api.post = "/create_from_cart"),
+    QueryParentOrderResponse QueryParentOrder(1: QueryParentOrderRequest req) (api.post = "/query_parent_order"),
 }
\ No newline at end of file
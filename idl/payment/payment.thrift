@@ -0,0 +1,50 @@
+namespace go payment
+
+include "../base/base.thrift"
+
+// 支付单：一次向支付渠道发起的收款，金额取自订单的 total_amount
+struct PaymentIntent {
+    1: string id,              // 支付单 id
+    2: string order_id,        // 订单 id
+    3: i64 amount,             // 支付金额（分）
+    4: string provider,        // 支付渠道，如 mock
+    5: string status,          // pending=待支付 succeeded=支付成功 failed=支付失败
+    6: string provider_txn_id, // 支付渠道的流水号，支付成功后写入
+    7: string pay_url,         // 用户去支付的地址，由支付渠道返回
+    8: i64 created_at,         // 创建时间（unix 秒）
+    9: i64 updated_at,         // 更新时间（unix 秒）
+}
+
+struct CreatePaymentRequest {
+    1: string order_id,                      // 待支付订单 id，必须是当前用户的订单
+    2: optional string provider = "mock",    // 支付渠道，默认本地模拟渠道
+}
+
+struct CreatePaymentResponse {
+    1: base.BaseResponse baseResp,
+    2: PaymentIntent intent,                 // 同一订单、同一渠道已有待支付的支付单时直接返回
+}
+
+struct QueryPaymentRequest {
+    1: string intent_id,
+}
+
+struct QueryPaymentResponse {
+    1: base.BaseResponse baseResp,
+    2: PaymentIntent intent,
+}
+
+// 支付渠道的异步通知，签名放在请求头中，请求体由各渠道自行定义
+struct PaymentCallbackRequest {
+    1: string provider (api.path = "provider"),
+}
+
+struct PaymentCallbackResponse {
+    1: base.BaseResponse baseResp,
+}
+
+service PaymentService {
+    CreatePaymentResponse CreatePayment(1: CreatePaymentRequest req) (api.post = "/create_payment"),
+    QueryPaymentResponse QueryPayment(1: QueryPaymentRequest req) (api.post = "/query_payment"),
+    PaymentCallbackResponse PaymentCallback(1: PaymentCallbackRequest req) (api.post = "/payment_callback/:provider"),
+}
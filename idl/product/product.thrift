@@ -17,7 +17,10 @@ struct Sku {
     2: i64 product_id,  // 商品ID
     3: string sku_code, // 规格编码
     4: i64 price,       // 单价（分）
-    5: i32 stock,       // 库存
+    5: i32 stock,           // 库存（含已预占部分）
+    6: i64 merchant_id,     // 商户ID（订单服务据此校验 SKU 归属）
+    7: i32 reserved_stock,  // 已预占、尚未确认的库存
+    8: i32 available_stock, // 可售库存 = stock - reserved_stock
 }
 
 // ====================== 商户端CRUD请求/响应 ======================
@@ -0,0 +1,77 @@
+namespace go seckill
+
+include "../base/base.thrift"
+
+// 秒杀活动：活动库存在创建时预热到 Redis，抢购在 Redis 中原子扣减，下单由异步队列完成
+struct Campaign {
+    1: i64 id,
+    2: i64 merchant_id,      // 商户 id
+    3: i64 product_id,       // 商品 id
+    4: i64 sku_id,           // 规格 id
+    5: i64 quota,            // 活动库存
+    6: i64 remaining,        // 剩余活动库存，取自 Redis
+    7: i64 per_user_limit,   // 每个用户最多抢购的数量
+    8: i64 start_at,         // 开始时间（unix 秒）
+    9: i64 end_at,           // 结束时间（unix 秒）
+    10: i64 created_at,
+}
+
+struct CreateCampaignRequest {
+    1: i64 sku_id,                          // 必须是当前用户（商户）的 SKU
+    2: i64 quota,                           // 活动库存，≥1
+    3: optional i64 per_user_limit = 1,     // 每人限购，默认 1
+    4: i64 start_at,
+    5: i64 end_at,                          // 必须晚于 start_at 和当前时间
+}
+
+struct CreateCampaignResponse {
+    1: base.BaseResponse baseResp,
+    2: Campaign campaign,
+}
+
+struct GetCampaignRequest {
+    1: i64 campaign_id,
+}
+
+struct GetCampaignResponse {
+    1: base.BaseResponse baseResp,
+    2: Campaign campaign,
+}
+
+struct ListCampaignRequest {
+    1: optional i64 merchant_id,            // 不传则查询所有商户
+}
+
+struct ListCampaignResponse {
+    1: base.BaseResponse baseResp,
+    2: list<Campaign> campaigns,            // 未结束的活动，按开始时间排序
+}
+
+struct BuyRequest {
+    1: i64 campaign_id,
+    2: optional i64 count = 1,              // 抢购数量，默认 1
+}
+
+struct BuyResponse {
+    1: base.BaseResponse baseResp,
+    2: string request_id,                   // 抢购成功后排队下单，用它轮询 /seckill_result
+}
+
+struct QueryResultRequest {
+    1: string request_id,
+}
+
+struct QueryResultResponse {
+    1: base.BaseResponse baseResp,
+    2: string status,                       // queued=排队中 succeeded=下单成功 failed=下单失败（名额已退回）
+    3: string order_id,                     // 下单成功时返回
+    4: string error,                        // 下单失败的原因
+}
+
+service SeckillService {
+    CreateCampaignResponse CreateCampaign(1: CreateCampaignRequest req) (api.post = "/seckill_create"),
+    GetCampaignResponse GetCampaign(1: GetCampaignRequest req) (api.post = "/seckill_get"),
+    ListCampaignResponse ListCampaign(1: ListCampaignRequest req) (api.post = "/seckill_list"),
+    BuyResponse Buy(1: BuyRequest req) (api.post = "/seckill_buy"),
+    QueryResultResponse QueryResult(1: QueryResultRequest req) (api.post = "/seckill_result"),
+}
@@ -56,8 +56,91 @@ struct UpdateResponse {
     1: base.BaseResponse baseResp,
 }
 
+// 收货地址，每个用户最多保存 20 个，其中至多一个为默认地址
+struct Address {
+    1: i64 id,
+    2: i64 user_id,
+    3: string receiver_name,        // 收货人
+    4: string phone,                // 收货人手机号
+    5: string province,
+    6: string city,
+    7: string district,
+    8: string detail,               // 详细地址（街道、门牌号）
+    9: bool is_default,
+    10: i64 created_at,
+    11: i64 updated_at,
+}
+
+// 用户的第一个地址自动成为默认地址
+struct CreateAddressRequest {
+    1: i64 user_id,
+    2: string receiver_name,
+    3: string phone,
+    4: string province,
+    5: string city,
+    6: string district,
+    7: string detail,
+    8: optional bool is_default,    // true 时取消该用户其他地址的默认标记
+}
+
+struct CreateAddressResponse {
+    1: base.BaseResponse baseResp,
+    2: i64 address_id,
+}
+
+// 只修改传入的字段，地址必须属于 user_id
+struct UpdateAddressRequest {
+    1: i64 id,
+    2: i64 user_id,
+    3: optional string receiver_name,
+    4: optional string phone,
+    5: optional string province,
+    6: optional string city,
+    7: optional string district,
+    8: optional string detail,
+    9: optional bool is_default,    // 只能设为 true；要取消默认地址，把另一个地址设为默认
+}
+
+struct UpdateAddressResponse {
+    1: base.BaseResponse baseResp,
+}
+
+// 删除默认地址时，最近更新的另一个地址成为默认地址
+struct DeleteAddressRequest {
+    1: i64 id,
+    2: i64 user_id,
+}
+
+struct DeleteAddressResponse {
+    1: base.BaseResponse baseResp,
+}
+
+struct GetAddressRequest {
+    1: i64 id,
+    2: i64 user_id,                 // 地址不属于该用户时返回 NOT_FOUND
+}
+
+struct GetAddressResponse {
+    1: base.BaseResponse baseResp,
+    2: Address address,
+}
+
+struct ListAddressesRequest {
+    1: i64 user_id,
+}
+
+struct ListAddressesResponse {
+    1: base.BaseResponse baseResp,
+    2: list<Address> addresses,     // 默认地址在前，其余按更新时间倒序
+}
+
 service UserAccountService {
     RegisterResponse Register(1: RegisterRequest req) (api.post = "user/register"),
     LoginResponse Login(1: LoginRequest req) (api.post = "user/login"),
     UpdateResponse Update(1: UpdateRequest req) (api.post = "user/update"),
+    CreateAddressResponse CreateAddress(1: CreateAddressRequest req) (api.post = "user/address_create"),
+    UpdateAddressResponse UpdateAddress(1: UpdateAddressRequest req) (api.post = "user/address_update"),
+    DeleteAddressResponse DeleteAddress(1: DeleteAddressRequest req) (api.post = "user/address_delete"),
+    GetAddressResponse GetAddress(1: GetAddressRequest req) (api.post = "user/address_get"),
+    ListAddressesResponse ListAddresses(1: ListAddressesRequest req) (api.post = "user/address_list"),
 }
\ No newline at end of file
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/retention"
+)
+
+// runAdmin 执行运维子命令，执行完退出而不启动服务：
+//   - migrate-ext [-dry-run]：修复写错位置的 ext 字段
+//   - index-stats：查看订单集合各索引的使用次数
+//   - archive [-dry-run] [-days N]：按保留策略归档订单，-dry-run 只统计将要归档的订单
+func runAdmin(ctx context.Context, args []string) error {
+	switch args[0] {
+	case "migrate-ext":
+		fs := flag.NewFlagSet("migrate-ext", flag.ExitOnError)
+		dryRun := fs.Bool("dry-run", false, "只打印将要修复的文档，不写入")
+		_ = fs.Parse(args[1:])
+		n, err := migrateExt(ctx, *dryRun)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("migrate-ext: %d documents need repair (dry run: %v)\n", n, *dryRun)
+		return nil
+	case "index-stats":
+		extKeys, err := extIndexKeysFromEnv()
+		if err != nil {
+			return err
+		}
+		return printIndexStats(ctx, os.Stdout, extKeys)
+	case "archive":
+		cfg, err := retention.ConfigFromEnv()
+		if err != nil {
+			return err
+		}
+		fs := flag.NewFlagSet("archive", flag.ExitOnError)
+		dryRun := fs.Bool("dry-run", false, "只统计将要归档的订单，不写入")
+		days := fs.Int("days", cfg.Days, "保留天数，默认取 $ORDER_RETENTION_DAYS")
+		_ = fs.Parse(args[1:])
+		cfg.Days = *days
+		if !cfg.Enabled() {
+			return fmt.Errorf("archive: retention days must be greater than 0")
+		}
+		if *dryRun {
+			return printArchiveReport(ctx, os.Stdout, cfg, time.Now())
+		}
+		n, err := archiveOrders(ctx, cfg, time.Now())
+		fmt.Printf("archive: %d orders archived\n", n)
+		return err
+	default:
+		return fmt.Errorf("unknown command %q, available: migrate-ext, index-stats, archive", args[0])
+	}
+}
@@ -0,0 +1,297 @@
+package main
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	order "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/lease"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/retention"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/status"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/trans"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	mongoOfficial "go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	archiveLeaseName = "order_archive"
+	archiveInterval  = time.Hour
+	archiveBatchSize = 500
+)
+
+var ArchiveColl *mongoOfficial.Collection
+
+// errArchiveChanged 订单在读取之后被修改，本轮不归档
+var errArchiveChanged = errors.New("order changed while archiving")
+
+// archiveFilter 早于 cutoff 最后更新的已完成、已取消订单，有进行中退款的订单暂不归档
+func archiveFilter(cutoff int64) bson.M {
+	return bson.M{
+		"order.status":         bson.M{"$in": bson.A{status.Completed, status.Cancelled}},
+		"order.updatedat":      bson.M{"$lt": cutoff},
+		"order.refunds.status": bson.M{"$nin": bson.A{order.RefundStatus_REQUESTED, order.RefundStatus_APPROVED}},
+	}
+}
+
+// findArchived 在归档集合中查找 ids 中不在 found 里的订单
+func findArchived(ctx context.Context, ids []primitive.ObjectID, found []trans.OrderDoc, findOpts *options.FindOptionsBuilder) ([]trans.OrderDoc, error) {
+	seen := make(map[primitive.ObjectID]bool, len(found))
+	for _, doc := range found {
+		seen[doc.ID] = true
+	}
+	var missing []primitive.ObjectID
+	for _, id := range ids {
+		if !seen[id] {
+			missing = append(missing, id)
+		}
+	}
+	cur, err := ArchiveColl.Find(ctx, bson.M{"_id": bson.M{"$in": missing}}, findOpts)
+	if err != nil {
+		return nil, err
+	}
+	var docs []trans.OrderDoc
+	if err := cur.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// archiver 定期按保留策略归档订单，多副本通过租约保证同一时间只有一个在归档
+type archiver struct {
+	cfg   retention.Config
+	lease *lease.Lease
+}
+
+func newArchiver(leases *mongoOfficial.Collection, cfg retention.Config) *archiver {
+	return &archiver{
+		cfg:   cfg,
+		lease: lease.New(leases, archiveLeaseName, 2*archiveInterval),
+	}
+}
+
+func (a *archiver) Run(ctx context.Context) {
+	ticker := time.NewTicker(archiveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			_ = a.lease.Release(context.Background())
+			return
+		case <-ticker.C:
+			held, err := a.lease.Acquire(ctx)
+			if err != nil {
+				klog.Error("archiver: acquire lease failed. ", err.Error())
+				continue
+			}
+			if !held {
+				continue
+			}
+			n, err := archiveOrders(ctx, a.cfg, time.Now())
+			if err != nil {
+				klog.Error("archiver: ", err.Error())
+			}
+			if n > 0 {
+				klog.Info("archiver: archived ", n, " orders")
+			}
+		}
+	}
+}
+
+// archiveOrders 按批归档所有符合条件的订单，返回归档的数量
+func archiveOrders(ctx context.Context, cfg retention.Config, now time.Time) (int, error) {
+	filter := archiveFilter(cfg.Cutoff(now))
+	total := 0
+	for {
+		findOpts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetLimit(archiveBatchSize)
+		cur, err := Coll.Find(ctx, filter, findOpts)
+		if err != nil {
+			return total, err
+		}
+		var docs []bson.Raw
+		if err := cur.All(ctx, &docs); err != nil {
+			return total, err
+		}
+		if len(docs) == 0 {
+			return total, nil
+		}
+
+		var moved int
+		if cfg.Mode == retention.ModeFile {
+			moved, err = archiveToFile(ctx, cfg.Dir, docs)
+		} else {
+			moved, err = archiveToCollection(ctx, docs)
+		}
+		total += moved
+		if err != nil {
+			return total, err
+		}
+		// 整批都被并发修改时下一轮会读到同样的文档，留到下次再处理
+		if len(docs) < archiveBatchSize || moved == 0 {
+			return total, nil
+		}
+	}
+}
+
+// deleteArchived 以读到的版本号为条件删除订单，期间被修改过时返回 errArchiveChanged
+func deleteArchived(ctx context.Context, doc bson.Raw) error {
+	version, _ := doc.Lookup("order", "version").AsInt64OK()
+	result, err := Coll.DeleteOne(ctx, bson.D{
+		{Key: "_id", Value: doc.Lookup("_id")},
+		{Key: "order.version", Value: versionFilter(version)},
+	})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return errArchiveChanged
+	}
+	return nil
+}
+
+// archiveToCollection 在事务中把订单写入归档集合并从订单集合删除
+func archiveToCollection(ctx context.Context, docs []bson.Raw) (int, error) {
+	moved := 0
+	for _, doc := range docs {
+		err := withTx(ctx, func(ctx context.Context) error {
+			_, err := ArchiveColl.ReplaceOne(ctx, bson.M{"_id": doc.Lookup("_id")}, doc, options.Replace().SetUpsert(true))
+			if err != nil {
+				return err
+			}
+			return deleteArchived(ctx, doc)
+		})
+		if errors.Is(err, errArchiveChanged) {
+			continue
+		}
+		if err != nil {
+			return moved, err
+		}
+		moved++
+	}
+	return moved, nil
+}
+
+// archiveToFile 把整批订单写入一个 gzip 压缩的 JSON Lines 文件（Extended JSON，保留 ObjectId 等类型），
+// 文件落盘后再逐个删除。期间被修改的订单不删除，之后会再次导出，按 _id 取 order.version 最大的一条即可。
+func archiveToFile(ctx context.Context, dir string, docs []bson.Raw) (int, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return 0, err
+	}
+	first, _ := docs[0].Lookup("_id").ObjectIDOK()
+	name := fmt.Sprintf("orders-%s-%s.jsonl.gz", time.Now().UTC().Format("20060102T150405Z"), first.Hex())
+	if err := writeArchiveFile(filepath.Join(dir, name), docs); err != nil {
+		return 0, err
+	}
+
+	moved := 0
+	for _, doc := range docs {
+		err := deleteArchived(ctx, doc)
+		if errors.Is(err, errArchiveChanged) {
+			continue
+		}
+		if err != nil {
+			return moved, err
+		}
+		moved++
+	}
+	return moved, nil
+}
+
+// writeArchiveFile 先写临时文件，完整落盘后再改名，不会留下写了一半的归档文件
+func writeArchiveFile(path string, docs []bson.Raw) (err error) {
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			f.Close()
+			os.Remove(tmp)
+		}
+	}()
+
+	gz := gzip.NewWriter(f)
+	w := bufio.NewWriter(gz)
+	for _, doc := range docs {
+		line, err := bson.MarshalExtJSON(doc, true, false)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(append(line, '\n')); err != nil {
+			return err
+		}
+	}
+	if err = w.Flush(); err != nil {
+		return err
+	}
+	if err = gz.Close(); err != nil {
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// printArchiveReport 按状态统计将被归档的订单数量和最后更新时间的范围，不做修改
+func printArchiveReport(ctx context.Context, w io.Writer, cfg retention.Config, now time.Time) error {
+	cutoff := cfg.Cutoff(now)
+	pipeline := mongoOfficial.Pipeline{
+		{{Key: "$match", Value: archiveFilter(cutoff)}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$order.status"},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+			{Key: "oldest", Value: bson.D{{Key: "$min", Value: "$order.updatedat"}}},
+			{Key: "newest", Value: bson.D{{Key: "$max", Value: "$order.updatedat"}}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "_id", Value: 1}}}},
+	}
+	cur, err := Coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	var groups []struct {
+		Status int32 `bson:"_id"`
+		Count  int64 `bson:"count"`
+		Oldest int64 `bson:"oldest"`
+		Newest int64 `bson:"newest"`
+	}
+	if err := cur.All(ctx, &groups); err != nil {
+		return err
+	}
+
+	target := mongoNames.Database + "." + mongoNames.Archive
+	if cfg.Mode == retention.ModeFile {
+		target = cfg.Dir
+	}
+	fmt.Fprintf(w, "retention: %d days, cutoff: %s, mode: %s -> %s\n",
+		cfg.Days, time.Unix(cutoff, 0).Format(time.RFC3339), cfg.Mode, target)
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "STATUS\tORDERS\tOLDEST UPDATE\tNEWEST UPDATE")
+	var total int64
+	for _, g := range groups {
+		total += g.Count
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%s\n", g.Status, g.Count,
+			time.Unix(g.Oldest, 0).Format(time.RFC3339), time.Unix(g.Newest, 0).Format(time.RFC3339))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "%d orders would be archived\n", total)
+	return nil
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	order "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/lease"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/status"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	mongoOfficial "go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	autoConfirmLeaseName   = "order_auto_confirm"
+	autoConfirmBatchSize   = 100
+	defaultAutoConfirmDays = 10
+)
+
+// autoConfirmAfterFromEnv 读取 $ORDER_AUTO_CONFIRM_DAYS：全部发出后多少天自动确认收货，默认 10 天，0 表示不自动确认
+func autoConfirmAfterFromEnv() (time.Duration, error) {
+	v := os.Getenv("ORDER_AUTO_CONFIRM_DAYS")
+	if v == "" {
+		return defaultAutoConfirmDays * 24 * time.Hour, nil
+	}
+	days, err := strconv.Atoi(v)
+	if err != nil || days < 0 {
+		return 0, fmt.Errorf("ORDER_AUTO_CONFIRM_DAYS: %q 不合法，应为非负整数", v)
+	}
+	return time.Duration(days) * 24 * time.Hour, nil
+}
+
+// autoConfirmer 定期把到达自动确认收货时间的订单迁移到已完成，
+// 与 payTimeoutCanceller 一样通过 Mongo 租约保证同一时间只有一个副本在扫描。
+type autoConfirmer struct {
+	lease    *lease.Lease
+	interval time.Duration
+}
+
+func newAutoConfirmer(leases *mongoOfficial.Collection, interval time.Duration) *autoConfirmer {
+	return &autoConfirmer{
+		lease:    lease.New(leases, autoConfirmLeaseName, 2*interval),
+		interval: interval,
+	}
+}
+
+func (c *autoConfirmer) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			_ = c.lease.Release(context.Background())
+			return
+		case <-ticker.C:
+			held, err := c.lease.Acquire(ctx)
+			if err != nil {
+				klog.Error("auto confirmer: acquire lease failed. ", err.Error())
+				continue
+			}
+			if !held {
+				continue
+			}
+			n, err := c.confirmDue(ctx)
+			if err != nil {
+				klog.Error("auto confirmer: ", err.Error())
+			}
+			if n > 0 {
+				klog.Info("auto confirmer: completed ", n, " orders")
+			}
+		}
+	}
+}
+
+// confirmDue 确认一批已到自动确认收货时间的订单，返回确认成功的数量
+func (c *autoConfirmer) confirmDue(ctx context.Context) (int, error) {
+	now := time.Now().Unix()
+	filter := bson.M{
+		"order.status":        bson.M{"$in": bson.A{status.Paid, status.Confirmed}},
+		"order.autoconfirmat": bson.M{"$gt": 0, "$lte": now},
+	}
+	findOpts := options.Find().
+		SetProjection(bson.M{"_id": 1, "order.autoconfirmat": 1}).
+		SetSort(bson.D{{Key: "order.autoconfirmat", Value: 1}}).
+		SetLimit(autoConfirmBatchSize)
+
+	cur, err := Coll.Find(ctx, filter, findOpts)
+	if err != nil {
+		return 0, err
+	}
+	var docs []struct {
+		ID    primitive.ObjectID `bson:"_id"`
+		Order struct {
+			AutoConfirmAt int64 `bson:"autoconfirmat"`
+		} `bson:"order"`
+	}
+	if err := cur.All(ctx, &docs); err != nil {
+		return 0, err
+	}
+
+	confirmed := 0
+	for _, doc := range docs {
+		confirmCtx := withEventAttrs(ctx, map[string]any{
+			"reason":          "auto_confirm",
+			"auto_confirm_at": doc.Order.AutoConfirmAt,
+		})
+		err := completeDelivery(confirmCtx, doc.ID, func(*order.Order) error { return nil })
+		if err != nil {
+			var transitionErr *transitionError
+			var refundErr *refundError
+			if errors.As(err, &transitionErr) || errors.As(err, &refundErr) ||
+				errors.Is(err, errOrderNotFound) || errors.Is(err, errVersionConflict) {
+				// 扫描之后被确认、取消或正在被修改，跳过，仍未完成的下一轮再处理
+				continue
+			}
+			return confirmed, err
+		}
+		confirmed++
+	}
+	return confirmed, nil
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/lease"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/status"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	mongoOfficial "go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	payTimeoutLeaseName = "order_pay_timeout"
+	payTimeoutBatchSize = 100
+)
+
+// payTimeoutCanceller 定期取消超过支付期限的待支付订单。
+// 多个副本同时运行时通过 Mongo 租约保证同一时间只有一个在扫描；
+// 即使租约过期后两个副本短暂重叠，状态流转的 compare-and-set 也能保证每个订单只取消一次。
+type payTimeoutCanceller struct {
+	lease    *lease.Lease
+	interval time.Duration
+}
+
+func newPayTimeoutCanceller(leases *mongoOfficial.Collection, interval time.Duration) *payTimeoutCanceller {
+	return &payTimeoutCanceller{
+		// 租约时长取两个扫描周期，持有者宕机后其他副本最多等待两个周期接手
+		lease:    lease.New(leases, payTimeoutLeaseName, 2*interval),
+		interval: interval,
+	}
+}
+
+func (c *payTimeoutCanceller) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			_ = c.lease.Release(context.Background())
+			return
+		case <-ticker.C:
+			held, err := c.lease.Acquire(ctx)
+			if err != nil {
+				klog.Error("pay timeout canceller: acquire lease failed. ", err.Error())
+				continue
+			}
+			if !held {
+				continue
+			}
+			n, err := c.cancelOverdue(ctx)
+			if err != nil {
+				klog.Error("pay timeout canceller: ", err.Error())
+			}
+			if n > 0 {
+				klog.Info("pay timeout canceller: cancelled ", n, " orders")
+			}
+		}
+	}
+}
+
+// cancelOverdue 取消一批已超过支付期限的订单，返回取消成功的数量
+func (c *payTimeoutCanceller) cancelOverdue(ctx context.Context) (int, error) {
+	now := time.Now().Unix()
+	filter := bson.M{
+		"order.status":      status.PendingPayment,
+		"order.paydeadline": bson.M{"$gt": 0, "$lte": now},
+	}
+	findOpts := options.Find().
+		SetProjection(bson.M{"_id": 1, "order.paydeadline": 1}).
+		SetSort(bson.D{{Key: "order.paydeadline", Value: 1}}).
+		SetLimit(payTimeoutBatchSize)
+
+	cur, err := Coll.Find(ctx, filter, findOpts)
+	if err != nil {
+		return 0, err
+	}
+	var docs []struct {
+		ID    primitive.ObjectID `bson:"_id"`
+		Order struct {
+			PayDeadline int64 `bson:"paydeadline"`
+		} `bson:"order"`
+	}
+	if err := cur.All(ctx, &docs); err != nil {
+		return 0, err
+	}
+
+	cancelled := 0
+	for _, doc := range docs {
+		// 取消原因随 OrderStatusChanged 事件写入发件箱
+		cancelCtx := withEventAttrs(ctx, map[string]any{
+			"reason":       "pay_timeout",
+			"pay_deadline": doc.Order.PayDeadline,
+		})
+		err := transitStatus(cancelCtx, doc.ID, status.Cancelled, nil, nil, nil)
+		if err != nil {
+			var transitionErr *transitionError
+			if errors.As(err, &transitionErr) || errors.Is(err, errOrderNotFound) {
+				// 扫描之后被支付或取消了，跳过
+				continue
+			}
+			return cancelled, err
+		}
+		cancelled++
+	}
+	return cancelled, nil
+}
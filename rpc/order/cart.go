@@ -0,0 +1,291 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/base"
+	order "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/catalog"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/event"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/status"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/trans"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	mongoOfficial "go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const maxCartItems = 100
+
+var ParentColl *mongoOfficial.Collection
+
+var errParentNotFound = errors.New("parent order not found")
+
+// parentDoc 父订单只记录下单人和子订单，状态、金额在读取时由子订单汇总
+type parentDoc struct {
+	ID             primitive.ObjectID `bson:"_id"`
+	ReqUserId      int64              `bson:"requserid"`
+	OrderIds       []string           `bson:"orderids"`
+	CreatedAt      int64              `bson:"createdat"`
+	IdempotencyKey string             `bson:"idempotencykey"`
+	RequestDigest  string             `bson:"requestdigest"`
+}
+
+// parentIndexes 父订单集合的索引
+var parentIndexes = []mongoOfficial.IndexModel{
+	// CreateFromCart 的幂等键，同一买家下唯一
+	{
+		Keys: bson.D{{Key: "requserid", Value: 1}, {Key: "idempotencykey", Value: 1}},
+		Options: options.Index().SetName("requserid_idempotencykey").SetUnique(true).
+			SetPartialFilterExpression(bson.D{{Key: "idempotencykey", Value: bson.D{{Key: "$gt", Value: ""}}}}),
+	},
+}
+
+func validateCreateFromCartReq(req *order.CreateFromCartRequest) error {
+	if req.ReqUserId <= 0 {
+		return errors.New("买家ID必须大于 0.")
+	}
+	if len(req.Items) > maxCartItems {
+		return fmt.Errorf("一次最多下单 %d 个订单项.", maxCartItems)
+	}
+	if req.AddressId != nil && *req.AddressId <= 0 {
+		return errors.New("收货地址ID必须大于 0.")
+	}
+	return validateCreateContent(req.Type, req.Status, req.Items, req.Ext, req.GetIdempotencyKey())
+}
+
+func cartDigest(req *order.CreateFromCartRequest) (string, error) {
+	body := *req
+	body.IdempotencyKey = nil
+	return digestJSON(&body)
+}
+
+// merchantGroup 购物车中属于同一商户的订单项，保持在购物车中的先后顺序
+type merchantGroup struct {
+	merchantId int64
+	items      []*order.OrderItemForCreate
+	skus       []*catalog.Sku
+}
+
+// splitByMerchant 按 SKU 所属商户分组，按商户 id 升序返回
+func splitByMerchant(items []*order.OrderItemForCreate, skus []*catalog.Sku) []*merchantGroup {
+	byMerchant := make(map[int64]*merchantGroup)
+	var groups []*merchantGroup
+	for i, item := range items {
+		m := skus[i].MerchantID
+		g, ok := byMerchant[m]
+		if !ok {
+			g = &merchantGroup{merchantId: m}
+			byMerchant[m] = g
+			groups = append(groups, g)
+		}
+		g.items = append(g.items, item)
+		g.skus = append(g.skus, skus[i])
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].merchantId < groups[j].merchantId
+	})
+	return groups
+}
+
+// buildCartOrders 为每个商户构造一个子订单，子订单的 ext 和收货地址快照都取自请求
+func (s *OrderServiceImpl) buildCartOrders(req *order.CreateFromCartRequest, parentId string, groups []*merchantGroup, shippingAddress *order.ShippingAddress, now int64) ([]*trans.OrderDoc, error) {
+	docs := make([]*trans.OrderDoc, 0, len(groups))
+	for _, g := range groups {
+		total, err := applyPrices(g.merchantId, g.items, g.skus)
+		if err != nil {
+			return nil, err
+		}
+		childReq := &order.CreateRequest{
+			Type:       req.Type,
+			Status:     req.Status,
+			ReqUserId:  req.ReqUserId,
+			RespUserId: g.merchantId,
+			Items:      g.items,
+			Ext:        req.Ext,
+		}
+		doc, err := newOrderDoc(childReq, total, s.PayTimeout, now)
+		if err != nil {
+			return nil, err
+		}
+		doc.Order.ParentId = parentId
+		doc.Order.ShippingAddress = shippingAddress
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
+
+// insertCartOrders 在一个事务中写入父订单、全部子订单和子订单的 OrderCreated 事件
+func insertCartOrders(ctx context.Context, parent *parentDoc, children []*trans.OrderDoc) error {
+	return withTx(ctx, func(ctx context.Context) error {
+		docs := make([]any, 0, len(children))
+		for _, c := range children {
+			docs = append(docs, c)
+		}
+		if _, err := ParentColl.InsertOne(ctx, parent); err != nil {
+			return err
+		}
+		if _, err := Coll.InsertMany(ctx, docs); err != nil {
+			return err
+		}
+		for _, c := range children {
+			if err := appendEvent(ctx, event.OrderCreated, c.Order.Id, c.Order.Version, createdPayload(&c.Order)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// replayCreateFromCart 查找 reqUserId 用 key 创建过的父订单：没有时返回 nil；
+// 摘要不一致时返回 errIdempotencyConflict。
+func replayCreateFromCart(ctx context.Context, reqUserId int64, key, digest string) (*parentDoc, error) {
+	var parent parentDoc
+	err := ParentColl.FindOne(ctx, bson.M{"requserid": reqUserId, "idempotencykey": key}).Decode(&parent)
+	if err != nil {
+		if errors.Is(err, mongoOfficial.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if parent.RequestDigest != digest {
+		return nil, errIdempotencyConflict
+	}
+	return &parent, nil
+}
+
+func loadParent(ctx context.Context, objectId primitive.ObjectID) (*parentDoc, error) {
+	var parent parentDoc
+	if err := ParentColl.FindOne(ctx, bson.M{"_id": objectId}).Decode(&parent); err != nil {
+		if errors.Is(err, mongoOfficial.ErrNoDocuments) {
+			return nil, errParentNotFound
+		}
+		return nil, err
+	}
+	return &parent, nil
+}
+
+// loadChildren 读取父订单的子订单（包括已归档的），按 parent.OrderIds 的顺序返回
+func loadChildren(ctx context.Context, parent *parentDoc) ([]*order.Order, error) {
+	ids := make([]primitive.ObjectID, 0, len(parent.OrderIds))
+	for _, id := range parent.OrderIds {
+		objectId, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, objectId)
+	}
+	cur, err := Coll.Find(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, err
+	}
+	var docs []trans.OrderDoc
+	if err := cur.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	if len(docs) < len(ids) {
+		archived, err := findArchived(ctx, ids, docs, options.Find())
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, archived...)
+	}
+
+	found := make(map[string]*order.Order, len(docs))
+	for i := range docs {
+		found[docs[i].ID.Hex()] = &docs[i].Order
+	}
+	children := make([]*order.Order, 0, len(docs))
+	for _, id := range parent.OrderIds {
+		if o, ok := found[id]; ok {
+			children = append(children, o)
+		}
+	}
+	return children, nil
+}
+
+// parentStatus 由子订单推导父订单状态：
+// 有待支付的子订单时为待支付；全部取消为已取消；未取消的全部完成为已完成；其余（部分已支付）为已支付
+func parentStatus(children []*order.Order) int32 {
+	if len(children) == 0 {
+		return 0
+	}
+	cancelled, completed := 0, 0
+	for _, c := range children {
+		switch c.Status {
+		case status.PendingPayment:
+			return status.PendingPayment
+		case status.Cancelled:
+			cancelled++
+		case status.Completed:
+			completed++
+		}
+	}
+	switch {
+	case cancelled == len(children):
+		return status.Cancelled
+	case cancelled+completed == len(children):
+		return status.Completed
+	default:
+		return status.Paid
+	}
+}
+
+func toParentOrder(parent *parentDoc, children []*order.Order) *order.ParentOrder {
+	p := &order.ParentOrder{
+		Id:        parent.ID.Hex(),
+		ReqUserId: parent.ReqUserId,
+		Status:    parentStatus(children),
+		OrderIds:  parent.OrderIds,
+		CreatedAt: parent.CreatedAt,
+	}
+	for _, c := range children {
+		p.TotalAmount += c.TotalAmount
+	}
+	return p
+}
+
+// replayCartResp 把 replayCreateFromCart 的结果转换为 CreateFromCart 的响应，返回 nil 表示没有创建过，需要正常创建
+func replayCartResp(ctx context.Context, parent *parentDoc, err error, klogErr func(string)) *order.CreateFromCartResponse {
+	switch {
+	case errors.Is(err, errIdempotencyConflict):
+		return &order.CreateFromCartResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_IDEMPOTENCY_CONFLICT,
+				Msg:  "幂等键已被另一个内容不同的请求使用",
+			},
+		}
+	case err != nil:
+		klogErr("fail to replay create from cart. " + err.Error())
+		return &order.CreateFromCartResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_DB_ERR,
+				Msg:  internalErrMsg,
+			},
+		}
+	case parent == nil:
+		return nil
+	}
+
+	children, err := loadChildren(ctx, parent)
+	if err != nil {
+		klogErr("fail to load child orders. " + err.Error())
+		return &order.CreateFromCartResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_DB_ERR,
+				Msg:  internalErrMsg,
+			},
+		}
+	}
+	return &order.CreateFromCartResponse{
+		BaseResp: &base.BaseResponse{
+			Code: base.Code_SUCCESS,
+			Msg:  successMsg,
+		},
+		ParentOrder: toParentOrder(parent, children),
+		Orders:      children,
+	}
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	order "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/event"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// validateExtKey ext 的 key 会拼进 order.ext.<key> 路径，不能为空、不能包含 . 和 $
+func validateExtKey(k string) error {
+	if k == "" || strings.ContainsAny(k, ".$") {
+		return fmt.Errorf("扩展字段 %q 不合法，key 不能为空、不能包含 . 和 $.", k)
+	}
+	return nil
+}
+
+// buildExtUpdate 把 UpdateRequest 中的 ext 操作翻译为作用于 order.ext 的 $set 和 $unset
+func buildExtUpdate(req *order.UpdateRequest) (set bson.D, unset bson.D, err error) {
+	mode := req.ExtMode
+	if mode == 0 {
+		mode = order.ExtUpdateMode_MERGE
+	}
+
+	for k := range req.Ext {
+		if err := validateExtKey(k); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	switch mode {
+	case order.ExtUpdateMode_MERGE:
+		for k, v := range req.Ext {
+			set = append(set, bson.E{Key: "order.ext." + k, Value: v})
+		}
+		for _, k := range req.ExtDeleteKeys {
+			if err := validateExtKey(k); err != nil {
+				return nil, nil, err
+			}
+			if _, ok := req.Ext[k]; ok {
+				return nil, nil, fmt.Errorf("扩展字段 %q 不能同时写入和删除.", k)
+			}
+			unset = append(unset, bson.E{Key: "order.ext." + k, Value: ""})
+		}
+	case order.ExtUpdateMode_REPLACE:
+		if len(req.ExtDeleteKeys) > 0 {
+			return nil, nil, fmt.Errorf("REPLACE 模式不支持 ext_delete_keys.")
+		}
+		ext := req.Ext
+		if ext == nil {
+			ext = map[string]string{}
+		}
+		set = append(set, bson.E{Key: "order.ext", Value: ext})
+	default:
+		return nil, nil, fmt.Errorf("ext_mode %d 不存在.", mode)
+	}
+	return set, unset, nil
+}
+
+// ensureExtObject 早期订单的 order.ext 可能是 null，按 key 写入前先置为空文档。
+// 与其他写入一样递增版本号并在同一事务中写入 OrderUpdated 事件；expectedVersion 不为 nil 时按它过滤，
+// 置空后返回加一的版本号供随后的写入使用，没有置空时原样返回
+func ensureExtObject(ctx context.Context, objectId primitive.ObjectID, expectedVersion *int64) (*int64, error) {
+	filter := bson.D{
+		bson.E{Key: "_id", Value: objectId},
+		bson.E{Key: "order.ext", Value: bson.M{"$not": bson.M{"$type": "object"}}},
+	}
+	if expectedVersion != nil {
+		filter = append(filter, bson.E{Key: "order.version", Value: versionFilter(*expectedVersion)})
+	}
+	update := bson.D{
+		bson.E{Key: "$set", Value: bson.D{
+			bson.E{Key: "order.ext", Value: bson.M{}},
+			bson.E{Key: "order.updatedat", Value: time.Now().Unix()},
+		}},
+		bson.E{Key: "$inc", Value: bson.D{bson.E{Key: "order.version", Value: 1}}},
+	}
+	matched, err := updateWithEvent(ctx, objectId, filter, update, func() (string, map[string]any) {
+		return event.OrderUpdated, map[string]any{"fields": []string{"ext"}}
+	})
+	if err != nil || !matched || expectedVersion == nil {
+		return expectedVersion, err
+	}
+	next := *expectedVersion + 1
+	return &next, nil
+}
@@ -3,13 +3,19 @@ package main
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/cloudwego/kitex/pkg/klog"
 	"github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/base"
 	order "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/address"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/catalog"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/event"
 	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/mongo"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/paytimeout"
 	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/snowflake"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/status"
 	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/trans"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/v2/bson"
@@ -17,7 +23,10 @@ import (
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
-var Coll = mongo.Cli.Database("order_db").Collection("total")
+var (
+	mongoNames = mongo.NamesFromEnv()
+	Coll       *mongoOfficial.Collection
+)
 
 var (
 	internalErrMsg = "Internal Error."
@@ -25,14 +34,105 @@ var (
 )
 
 // OrderServiceImpl implements the last service interface defined in the IDL.
-type OrderServiceImpl struct{}
+type OrderServiceImpl struct {
+	Catalog    catalog.Client
+	Address    address.Client
+	PayTimeout paytimeout.Config
+	// AutoConfirmAfter 全部发出后自动确认收货的时长，0 表示不自动确认
+	AutoConfirmAfter time.Duration
+}
 
 func validateCreateReq(req *order.CreateRequest) error {
-	// 没有什么要校验的
-	_ = req
+	if req.ReqUserId <= 0 || req.RespUserId <= 0 {
+		return errors.New("买家ID和商户ID必须大于 0.")
+	}
+	if req.AddressId != nil && *req.AddressId <= 0 {
+		return errors.New("收货地址ID必须大于 0.")
+	}
+	return validateCreateContent(req.Type, req.Status, req.Items, req.Ext, req.GetIdempotencyKey())
+}
+
+// validateCreateContent 校验 Create 和 CreateFromCart 共有的参数
+func validateCreateContent(typ, st int32, items []*order.OrderItemForCreate, ext map[string]string, idempotencyKey string) error {
+	if len(items) == 0 {
+		return errors.New("订单项不能为空.")
+	}
+	for k := range ext {
+		if err := validateExtKey(k); err != nil {
+			return err
+		}
+	}
+	if err := validateIdempotencyKey(idempotencyKey); err != nil {
+		return err
+	}
+	for i, item := range items {
+		if item == nil {
+			return fmt.Errorf("第 %d 个订单项为空.", i+1)
+		}
+		if item.ProductId <= 0 || item.SkuId <= 0 {
+			return fmt.Errorf("第 %d 个订单项的商品ID和SKU ID必须大于 0.", i+1)
+		}
+		if item.Count <= 0 {
+			return fmt.Errorf("第 %d 个订单项的数量必须大于 0.", i+1)
+		}
+	}
+	machine, ok := status.Lookup(typ)
+	if !ok {
+		return fmt.Errorf("订单类型 %d 不存在.", typ)
+	}
+	if !machine.CanCreateWith(st) {
+		return fmt.Errorf("订单类型 %d 不能以状态 %d 创建.", typ, st)
+	}
 	return nil
 }
 
+// newOrderDoc 用已定价的订单项构造新订单，生成订单 id 和订单项 id
+func newOrderDoc(req *order.CreateRequest, totalAmount int64, payTimeout paytimeout.Config, now int64) (*trans.OrderDoc, error) {
+	objectId := primitive.NewObjectID()
+	orderIdStr := objectId.Hex()
+
+	var items []*order.OrderItem
+	for _, item := range req.Items {
+		itemId, err := snowflake.Generate()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, trans.OrderItem(*item, itemId, orderIdStr))
+	}
+
+	// ext 始终存为文档，之后才能按 order.ext.<key> 写入
+	ext := req.Ext
+	if ext == nil {
+		ext = map[string]string{}
+	}
+
+	var payDeadline int64
+	if req.Status == status.PendingPayment {
+		payDeadline = payTimeout.Deadline(req.Type, now)
+	}
+	return &trans.OrderDoc{
+		ID: objectId,
+		Order: order.Order{
+			Id:         orderIdStr,
+			Type:       req.Type,
+			Status:     req.Status,
+			ReqUserId:  req.ReqUserId,
+			RespUserId: req.RespUserId,
+			Items:      items,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+			Ext:        ext,
+			// 初始状态也记一条（from 为 0），同时保证字段是数组，后续 $push 才能生效
+			StatusHistory: []*order.StatusRecord{
+				{From: 0, To: req.Status, ChangedAt: now},
+			},
+			Version:     1,
+			TotalAmount: totalAmount,
+			PayDeadline: payDeadline,
+		},
+	}, nil
+}
+
 // Create implements the OrderServiceImpl interface.
 func (s *OrderServiceImpl) Create(ctx context.Context, req *order.CreateRequest) (resp *order.CreateResponse, err error) {
 	klogErr := func(msg string) {
@@ -58,31 +158,110 @@ func (s *OrderServiceImpl) Create(ctx context.Context, req *order.CreateRequest)
 		return
 	}
 
-	objectId := primitive.NewObjectID()
-	orderIdStr := objectId.Hex()
+	// 带幂等键的重试直接返回原订单，不再重新定价
+	idempotencyKey := req.GetIdempotencyKey()
+	var digest string
+	if idempotencyKey != "" {
+		if digest, err = createDigest(req); err != nil {
+			klogErr("fail to digest request. " + err.Error())
+			resp = &order.CreateResponse{
+				BaseResp: &base.BaseResponse{
+					Code: base.Code_SERVICE_ERR,
+					Msg:  internalErrMsg,
+				},
+			}
+			return resp, nil
+		}
+		orderId, err := replayCreate(ctx, req.ReqUserId, idempotencyKey, digest)
+		if err != nil {
+			klogErr("fail to replay create. " + err.Error())
+		}
+		if resp = replayCreateResp(orderId, err); resp != nil {
+			return resp, nil
+		}
+	}
 
-	var items []*order.OrderItem
-	for _, item := range req.Items {
-		itemId := snowflake.SfNode.Generate().Int64()
-		items = append(items, trans.OrderItem(*item, itemId, orderIdStr))
+	// 地址簿中的地址之后可能被修改或删除，订单保存下单时的快照
+	var shippingAddress *order.ShippingAddress
+	if req.AddressId != nil {
+		shippingAddress, err = s.Address.Snapshot(ctx, req.ReqUserId, *req.AddressId)
+		if err != nil {
+			if errors.Is(err, address.ErrNotFound) {
+				klogErr("address not found. " + err.Error())
+				resp = &order.CreateResponse{
+					BaseResp: &base.BaseResponse{
+						Code: base.Code_INVALID_PARAM,
+						Msg:  "收货地址不存在或不属于下单用户.",
+					},
+				}
+				return resp, nil
+			}
+			klogErr("query address failed. " + err.Error())
+			resp = &order.CreateResponse{
+				BaseResp: &base.BaseResponse{
+					Code: base.Code_SERVICE_ERR,
+					Msg:  internalErrMsg,
+				},
+			}
+			return resp, nil
+		}
 	}
 
-	doc := trans.OrderDoc{
-		ID: objectId,
-		Order: order.Order{
-			Id:         orderIdStr,
-			Type:       req.Type,
-			Status:     req.Status,
-			ReqUserId:  req.ReqUserId,
-			RespUserId: req.RespUserId,
-			Items:      items,
-			CreatedAt:  time.Now().Unix(),
-			UpdatedAt:  time.Now().Unix(),
-			Ext:        req.Ext,
-		},
+	totalAmount, err := priceItems(ctx, s.Catalog, req.RespUserId, req.Items)
+	if err != nil {
+		var pe *pricingError
+		if errors.As(err, &pe) {
+			klogErr("invalid items. " + err.Error())
+			resp = &order.CreateResponse{
+				BaseResp: &base.BaseResponse{
+					Code: base.Code_INVALID_PARAM,
+					Msg:  pe.Error(),
+				},
+			}
+			return resp, nil
+		}
+		klogErr("query catalog failed. " + err.Error())
+		resp = &order.CreateResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  internalErrMsg,
+			},
+		}
+		return resp, nil
 	}
 
-	_, err = Coll.InsertOne(ctx, doc)
+	doc, err := newOrderDoc(req, totalAmount, s.PayTimeout, time.Now().Unix())
+	if err != nil {
+		klogErr("fail to generate item id. " + err.Error())
+		resp = &order.CreateResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  internalErrMsg,
+			},
+		}
+		return resp, nil
+	}
+	doc.Order.IdempotencyKey = idempotencyKey
+	doc.Order.RequestDigest = digest
+	doc.Order.ShippingAddress = shippingAddress
+	orderIdStr := doc.Order.Id
+
+	err = withTx(ctx, func(ctx context.Context) error {
+		if _, err := Coll.InsertOne(ctx, doc); err != nil {
+			return err
+		}
+		return appendEvent(ctx, event.OrderCreated, orderIdStr, doc.Order.Version, createdPayload(&doc.Order))
+	})
+	if err != nil && idempotencyKey != "" && mongoOfficial.IsDuplicateKeyError(err) {
+		// 同一幂等键的并发请求，唯一索引保证只有一个插入成功，其余按已创建的订单返回
+		orderId, replayErr := replayCreate(ctx, req.ReqUserId, idempotencyKey, digest)
+		if replayErr != nil {
+			klogErr("fail to replay create. " + replayErr.Error())
+		}
+		if resp = replayCreateResp(orderId, replayErr); resp != nil {
+			return resp, nil
+		}
+	}
 	if err != nil {
 		klogErr("mongo insert operator failed. " + err.Error())
 		resp = &order.CreateResponse{
@@ -138,40 +317,6 @@ func (s *OrderServiceImpl) Update(ctx context.Context, req *order.UpdateRequest)
 		return resp, nil
 	}
 
-	val := bson.D{
-		bson.E{
-			Key:   "order.updatedat",
-			Value: time.Now().Unix(),
-		},
-	}
-	if req.Status != nil {
-		val = append(
-			val,
-			bson.E{
-				Key:   "order.status",
-				Value: *req.Status,
-			},
-		)
-	}
-	blackList := map[string]bool{"_id": true, "order.updatedat": true, "order.status": true, "order.requserid": true}
-	for k, v := range req.Ext {
-		if !blackList[k] {
-			val = append(
-				val,
-				bson.E{
-					Key:   k,
-					Value: v,
-				},
-			)
-		}
-	}
-	data := bson.D{
-		bson.E{
-			Key:   "$set",
-			Value: val,
-		},
-	}
-
 	objectId, err := primitive.ObjectIDFromHex(req.Id)
 	if err != nil {
 		klogErr("fail to convert req.Id to objectId: " + err.Error())
@@ -184,9 +329,41 @@ func (s *OrderServiceImpl) Update(ctx context.Context, req *order.UpdateRequest)
 		return
 	}
 
-	_, err = Coll.UpdateByID(ctx, objectId, data)
+	// 调用方只能修改 order.ext，状态、版本号等字段只能由服务端维护
+	set, unset, err := buildExtUpdate(req)
 	if err != nil {
-		if errors.Is(err, mongoOfficial.ErrNoDocuments) {
+		klogErr("invalid params: " + err.Error())
+		resp = &order.UpdateResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  err.Error(),
+			},
+		}
+		return resp, nil
+	}
+	expectedVersion := req.ExpectedVersion
+	if len(req.Ext) > 0 || len(req.ExtDeleteKeys) > 0 {
+		if expectedVersion, err = ensureExtObject(ctx, objectId, expectedVersion); err != nil {
+			klogErr("fail to normalize order.ext. " + err.Error())
+			resp = &order.UpdateResponse{
+				BaseResp: &base.BaseResponse{
+					Code: base.Code_DB_ERR,
+					Msg:  internalErrMsg,
+				},
+			}
+			return resp, nil
+		}
+	}
+
+	if req.Status != nil {
+		err = transitStatus(ctx, objectId, *req.Status, set, unset, expectedVersion)
+	} else {
+		err = updateFields(ctx, objectId, set, unset, expectedVersion)
+	}
+	if err != nil {
+		var transitionErr *transitionError
+		switch {
+		case errors.Is(err, errOrderNotFound):
 			klogErr("fail to update. " + err.Error())
 			resp = &order.UpdateResponse{
 				BaseResp: &base.BaseResponse{
@@ -194,7 +371,23 @@ func (s *OrderServiceImpl) Update(ctx context.Context, req *order.UpdateRequest)
 					Msg:  "订单不存在",
 				},
 			}
-		} else {
+		case errors.Is(err, errVersionConflict):
+			klogErr("version conflict. " + err.Error())
+			resp = &order.UpdateResponse{
+				BaseResp: &base.BaseResponse{
+					Code: base.Code_VERSION_CONFLICT,
+					Msg:  "订单已被修改，请重新查询后再试",
+				},
+			}
+		case errors.As(err, &transitionErr):
+			klogErr("status transition rejected. " + err.Error())
+			resp = &order.UpdateResponse{
+				BaseResp: &base.BaseResponse{
+					Code: base.Code_INVALID_STATUS_TRANSITION,
+					Msg:  transitionErr.Error(),
+				},
+			}
+		default:
 			klogErr("fail to update. " + err.Error())
 			resp = &order.UpdateResponse{
 				BaseResp: &base.BaseResponse{
@@ -203,7 +396,7 @@ func (s *OrderServiceImpl) Update(ctx context.Context, req *order.UpdateRequest)
 				},
 			}
 		}
-		return
+		return resp, nil
 	}
 
 	resp = &order.UpdateResponse{
@@ -223,6 +416,18 @@ func validateQueryOrderInfoReq(req *order.QueryOrderInfoRequest) error {
 	return nil
 }
 
+const maxBatchQueryIds = 100
+
+func validateBatchQueryOrderInfoReq(req *order.BatchQueryOrderInfoRequest) error {
+	if len(req.Ids) == 0 {
+		return errors.New("订单ID列表不能为空")
+	}
+	if len(req.Ids) > maxBatchQueryIds {
+		return fmt.Errorf("一次最多查询 %d 个订单", maxBatchQueryIds)
+	}
+	return validateFields(req.Fields)
+}
+
 // QueryOrderInfo implements the OrderServiceImpl interface.
 func (s *OrderServiceImpl) QueryOrderInfo(ctx context.Context, req *order.QueryOrderInfoRequest) (resp *order.QueryOrderInfoResponse, err error) {
 
@@ -265,6 +470,10 @@ func (s *OrderServiceImpl) QueryOrderInfo(ctx context.Context, req *order.QueryO
 
 	var orderDoc trans.OrderDoc
 	err = Coll.FindOne(ctx, filter).Decode(&orderDoc)
+	if errors.Is(err, mongoOfficial.ErrNoDocuments) {
+		// 超过保留期限的订单已移入归档集合
+		err = ArchiveColl.FindOne(ctx, filter).Decode(&orderDoc)
+	}
 
 	if err != nil {
 		if errors.Is(err, mongoOfficial.ErrNoDocuments) {
@@ -306,6 +515,12 @@ func validateQueryOrderIdReq(req *order.QueryOrderIdRequest) error {
 	if req.Type == order.QueryOrderIdType_EXT_KEY && (req.ExtKey == nil || req.ExtVal == nil) {
 		return errors.New("想要通过扩展字段查询订单，但扩展字段或字段值为空.")
 	}
+	if req.Type == order.QueryOrderIdType_EXT_KEY {
+		// key 会拼进 order.ext.<key> 查询路径，与写入时同样校验
+		if err := validateExtKey(*req.ExtKey); err != nil {
+			return err
+		}
+	}
 	if req.Type != order.QueryOrderIdType_REQ_USER && req.Type != order.QueryOrderIdType_RESP_USER && req.Type != order.QueryOrderIdType_EXT_KEY {
 		return errors.New("查询类型不存在.")
 	}
@@ -354,7 +569,7 @@ func (s *OrderServiceImpl) QueryOrderId(ctx context.Context, req *order.QueryOrd
 	case order.QueryOrderIdType_RESP_USER:
 		filter = bson.M{"order.respuserid": *req.UserId}
 	case order.QueryOrderIdType_EXT_KEY:
-		filter = bson.M{"ext." + *req.ExtKey: *req.ExtVal}
+		filter = bson.M{"order.ext." + *req.ExtKey: *req.ExtVal}
 	}
 
 	skip := (req.Page - 1) * req.PageSize
@@ -438,3 +653,783 @@ func (s *OrderServiceImpl) QueryOrderId(ctx context.Context, req *order.QueryOrd
 
 	return
 }
+
+// SearchOrders implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) SearchOrders(ctx context.Context, req *order.SearchOrdersRequest) (resp *order.SearchOrdersResponse, err error) {
+
+	klogErr := func(msg string) {
+		target := ""
+		if req != nil {
+			target = req.String()
+		}
+		klog.Error(
+			"method: ", "SearchOrders",
+			"target: ", target,
+			"message: ", msg,
+		)
+	}
+
+	invalidParam := func(msg string) *order.SearchOrdersResponse {
+		return &order.SearchOrdersResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  msg,
+			},
+		}
+	}
+	dbErr := &order.SearchOrdersResponse{
+		BaseResp: &base.BaseResponse{
+			Code: base.Code_DB_ERR,
+			Msg:  internalErrMsg,
+		},
+	}
+
+	if err = validateSearchOrdersReq(req); err != nil {
+		klogErr("invalid params: " + err.Error())
+		return invalidParam(err.Error()), nil
+	}
+	filter, err := buildSearchFilter(req)
+	if err != nil {
+		klogErr("invalid params: " + err.Error())
+		return invalidParam(err.Error()), nil
+	}
+
+	// 多取一条判断是否还有下一页
+	findOpts := options.Find().
+		SetSort(searchSort(req)).
+		SetLimit(int64(req.Limit) + 1)
+	if projection := searchProjection(req); projection != nil {
+		findOpts.SetProjection(projection)
+	}
+
+	cur, err := Coll.Find(ctx, filter, findOpts)
+	if err != nil {
+		klogErr("fail to search orders: " + err.Error())
+		return dbErr, nil
+	}
+	var docs []trans.OrderDoc
+	if err = cur.All(ctx, &docs); err != nil {
+		klogErr("fail to decode order docs: " + err.Error())
+		return dbErr, nil
+	}
+
+	resp = &order.SearchOrdersResponse{
+		BaseResp: &base.BaseResponse{
+			Code: base.Code_SUCCESS,
+			Msg:  successMsg,
+		},
+		OrderIds: make([]string, 0, len(docs)),
+	}
+	if len(docs) > int(req.Limit) {
+		docs = docs[:req.Limit]
+		last := docs[len(docs)-1]
+		resp.NextCursor = encodeSearchCursor(searchCursor{
+			SortBy:    req.SortBy,
+			Ascending: req.Ascending,
+			Value:     sortValue(&last.Order, req.SortBy),
+			ID:        last.ID.Hex(),
+		})
+	}
+	for i := range docs {
+		resp.OrderIds = append(resp.OrderIds, docs[i].ID.Hex())
+		if req.IncludeOrders {
+			resp.Orders = append(resp.Orders, &docs[i].Order)
+		}
+	}
+
+	return resp, nil
+}
+
+// BatchQueryOrderInfo implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) BatchQueryOrderInfo(ctx context.Context, req *order.BatchQueryOrderInfoRequest) (resp *order.BatchQueryOrderInfoResponse, err error) {
+
+	klogErr := func(msg string) {
+		target := ""
+		if req != nil {
+			target = req.String()
+		}
+		klog.Error(
+			"method: ", "BatchQueryOrderInfo",
+			"req: ", target,
+			"message: ", msg,
+		)
+	}
+
+	if err = validateBatchQueryOrderInfoReq(req); err != nil {
+		klogErr("invalid params: " + err.Error())
+		resp = &order.BatchQueryOrderInfoResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  err.Error(),
+			},
+		}
+		return resp, nil
+	}
+
+	ids, objectIds := uniqueOrderIds(req.Ids)
+
+	findOpts := options.Find()
+	if projection := fieldsProjection(req.Fields); projection != nil {
+		findOpts.SetProjection(projection)
+	}
+	cur, err := Coll.Find(ctx, bson.M{"_id": bson.M{"$in": objectIds}}, findOpts)
+	if err != nil {
+		klogErr("fail to query orders: " + err.Error())
+		return &order.BatchQueryOrderInfoResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_DB_ERR,
+				Msg:  internalErrMsg,
+			},
+		}, nil
+	}
+	var docs []trans.OrderDoc
+	if err = cur.All(ctx, &docs); err != nil {
+		klogErr("fail to decode orders: " + err.Error())
+		return &order.BatchQueryOrderInfoResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_DB_ERR,
+				Msg:  internalErrMsg,
+			},
+		}, nil
+	}
+
+	// 订单集合中没有的再到归档集合中查
+	if len(docs) < len(objectIds) {
+		var archived []trans.OrderDoc
+		archived, err = findArchived(ctx, objectIds, docs, findOpts)
+		if err != nil {
+			klogErr("fail to query archived orders: " + err.Error())
+			return &order.BatchQueryOrderInfoResponse{
+				BaseResp: &base.BaseResponse{
+					Code: base.Code_DB_ERR,
+					Msg:  internalErrMsg,
+				},
+			}, nil
+		}
+		docs = append(docs, archived...)
+	}
+
+	found := make(map[string]*order.Order, len(docs))
+	for i := range docs {
+		found[docs[i].ID.Hex()] = &docs[i].Order
+	}
+
+	// $in 不保证顺序，按请求中的顺序重新排列
+	resp = &order.BatchQueryOrderInfoResponse{
+		BaseResp: &base.BaseResponse{
+			Code: base.Code_SUCCESS,
+			Msg:  successMsg,
+		},
+		Orders:      make([]*order.Order, 0, len(docs)),
+		NotFoundIds: []string{},
+	}
+	for _, id := range ids {
+		if o, ok := found[id]; ok {
+			resp.Orders = append(resp.Orders, o)
+		} else {
+			resp.NotFoundIds = append(resp.NotFoundIds, id)
+		}
+	}
+
+	return resp, nil
+}
+
+// uniqueOrderIds 把 id 统一为小写十六进制并去重，保持请求中的顺序；
+// 格式错误的 id 不可能存在，原样保留（之后计入 not_found_ids），不参与查询
+func uniqueOrderIds(rawIds []string) ([]string, []primitive.ObjectID) {
+	ids := make([]string, 0, len(rawIds))
+	objectIds := make([]primitive.ObjectID, 0, len(rawIds))
+	seen := make(map[string]bool, len(rawIds))
+	for _, id := range rawIds {
+		objectId, err := primitive.ObjectIDFromHex(id)
+		if err == nil {
+			id = objectId.Hex()
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+		if err == nil {
+			objectIds = append(objectIds, objectId)
+		}
+	}
+	return ids, objectIds
+}
+
+// RequestRefund implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) RequestRefund(ctx context.Context, req *order.RequestRefundRequest) (resp *order.RequestRefundResponse, err error) {
+
+	klogErr := func(msg string) {
+		target := ""
+		if req != nil {
+			target = req.String()
+		}
+		klog.Error(
+			"method: ", "RequestRefund",
+			"req: ", target,
+			"message: ", msg,
+		)
+	}
+
+	if err = validateRequestRefundReq(req); err != nil {
+		klogErr("invalid params: " + err.Error())
+		return &order.RequestRefundResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  err.Error(),
+			},
+		}, nil
+	}
+
+	objectId, err := primitive.ObjectIDFromHex(req.OrderId)
+	if err != nil {
+		klogErr("fail to convert req.OrderId to objectId: " + err.Error())
+		return &order.RequestRefundResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  "订单ID格式错误",
+			},
+		}, nil
+	}
+
+	refundId, err := requestRefund(ctx, objectId, req)
+	if err != nil {
+		klogErr("fail to request refund: " + err.Error())
+		return &order.RequestRefundResponse{BaseResp: refundBaseResp(err)}, nil
+	}
+
+	return &order.RequestRefundResponse{
+		BaseResp: refundBaseResp(nil),
+		RefundId: refundId,
+	}, nil
+}
+
+// ReviewRefund implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) ReviewRefund(ctx context.Context, req *order.ReviewRefundRequest) (resp *order.ReviewRefundResponse, err error) {
+
+	klogErr := func(msg string) {
+		target := ""
+		if req != nil {
+			target = req.String()
+		}
+		klog.Error(
+			"method: ", "ReviewRefund",
+			"req: ", target,
+			"message: ", msg,
+		)
+	}
+
+	objectId, err := primitive.ObjectIDFromHex(req.OrderId)
+	if err != nil {
+		klogErr("fail to convert req.OrderId to objectId: " + err.Error())
+		return &order.ReviewRefundResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  "订单ID格式错误",
+			},
+		}, nil
+	}
+
+	refund, err := reviewRefund(ctx, objectId, req)
+	if err != nil {
+		klogErr("fail to review refund: " + err.Error())
+		return &order.ReviewRefundResponse{BaseResp: refundBaseResp(err)}, nil
+	}
+
+	return &order.ReviewRefundResponse{
+		BaseResp: refundBaseResp(nil),
+		Refund:   refund,
+	}, nil
+}
+
+// CompleteRefund implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) CompleteRefund(ctx context.Context, req *order.CompleteRefundRequest) (resp *order.CompleteRefundResponse, err error) {
+
+	klogErr := func(msg string) {
+		target := ""
+		if req != nil {
+			target = req.String()
+		}
+		klog.Error(
+			"method: ", "CompleteRefund",
+			"req: ", target,
+			"message: ", msg,
+		)
+	}
+
+	objectId, err := primitive.ObjectIDFromHex(req.OrderId)
+	if err != nil {
+		klogErr("fail to convert req.OrderId to objectId: " + err.Error())
+		return &order.CompleteRefundResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  "订单ID格式错误",
+			},
+		}, nil
+	}
+
+	if err = completeRefund(ctx, objectId, req); err != nil {
+		klogErr("fail to complete refund: " + err.Error())
+		return &order.CompleteRefundResponse{BaseResp: refundBaseResp(err)}, nil
+	}
+
+	return &order.CompleteRefundResponse{BaseResp: refundBaseResp(nil)}, nil
+}
+
+// QueryRefunds implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) QueryRefunds(ctx context.Context, req *order.QueryRefundsRequest) (resp *order.QueryRefundsResponse, err error) {
+
+	klogErr := func(msg string) {
+		target := ""
+		if req != nil {
+			target = req.String()
+		}
+		klog.Error(
+			"method: ", "QueryRefunds",
+			"req: ", target,
+			"message: ", msg,
+		)
+	}
+
+	objectId, err := primitive.ObjectIDFromHex(req.OrderId)
+	if err != nil {
+		klogErr("fail to convert req.OrderId to objectId: " + err.Error())
+		return &order.QueryRefundsResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  "订单ID格式错误",
+			},
+		}, nil
+	}
+
+	o, err := loadOrder(ctx, objectId)
+	if err != nil {
+		klogErr("fail to query order: " + err.Error())
+		return &order.QueryRefundsResponse{BaseResp: refundBaseResp(err)}, nil
+	}
+
+	wanted := make(map[order.RefundStatus]bool, len(req.Statuses))
+	for _, st := range req.Statuses {
+		wanted[st] = true
+	}
+	refunds := make([]*order.Refund, 0, len(o.Refunds))
+	for _, r := range o.Refunds {
+		if len(wanted) == 0 || wanted[r.Status] {
+			refunds = append(refunds, r)
+		}
+	}
+
+	return &order.QueryRefundsResponse{
+		BaseResp:         refundBaseResp(nil),
+		Refunds:          refunds,
+		RefundedAmount:   o.RefundedAmount,
+		RefundableAmount: refundableAmount(o),
+	}, nil
+}
+
+// DecodeId implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) DecodeId(ctx context.Context, req *order.DecodeIdRequest) (resp *order.DecodeIdResponse, err error) {
+	if req.Id <= 0 {
+		return &order.DecodeIdResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  "ID必须大于 0.",
+			},
+		}, nil
+	}
+
+	decoded := snowflake.Decode(req.Id)
+	return &order.DecodeIdResponse{
+		BaseResp: &base.BaseResponse{
+			Code: base.Code_SUCCESS,
+			Msg:  successMsg,
+		},
+		TimestampMs: decoded.Time.UnixMilli(),
+		Node:        decoded.Node,
+		Sequence:    decoded.Sequence,
+	}, nil
+}
+
+// Report implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) Report(ctx context.Context, req *order.ReportRequest) (resp *order.ReportResponse, err error) {
+
+	klogErr := func(msg string) {
+		target := ""
+		if req != nil {
+			target = req.String()
+		}
+		klog.Error(
+			"method: ", "Report",
+			"req: ", target,
+			"message: ", msg,
+		)
+	}
+
+	if err = validateReportReq(req); err != nil {
+		klogErr("invalid params: " + err.Error())
+		return &order.ReportResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  err.Error(),
+			},
+		}, nil
+	}
+
+	resp, err = buildReport(ctx, req)
+	if err != nil {
+		klogErr("fail to aggregate report: " + err.Error())
+		return &order.ReportResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_DB_ERR,
+				Msg:  internalErrMsg,
+			},
+		}, nil
+	}
+	resp.BaseResp = &base.BaseResponse{
+		Code: base.Code_SUCCESS,
+		Msg:  successMsg,
+	}
+
+	return resp, nil
+}
+
+// CreateFromCart implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) CreateFromCart(ctx context.Context, req *order.CreateFromCartRequest) (resp *order.CreateFromCartResponse, err error) {
+
+	klogErr := func(msg string) {
+		target := ""
+		if req != nil {
+			target = req.String()
+		}
+		klog.Error(
+			"method: ", "CreateFromCart",
+			"req: ", target,
+			"message: ", msg,
+		)
+	}
+
+	if err = validateCreateFromCartReq(req); err != nil {
+		klogErr("invalid params: " + err.Error())
+		return &order.CreateFromCartResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  err.Error(),
+			},
+		}, nil
+	}
+
+	// 带幂等键的重试直接返回原父订单
+	idempotencyKey := req.GetIdempotencyKey()
+	var digest string
+	if idempotencyKey != "" {
+		if digest, err = cartDigest(req); err != nil {
+			klogErr("fail to digest request. " + err.Error())
+			return &order.CreateFromCartResponse{
+				BaseResp: &base.BaseResponse{
+					Code: base.Code_SERVICE_ERR,
+					Msg:  internalErrMsg,
+				},
+			}, nil
+		}
+		parent, err := replayCreateFromCart(ctx, req.ReqUserId, idempotencyKey, digest)
+		if resp = replayCartResp(ctx, parent, err, klogErr); resp != nil {
+			return resp, nil
+		}
+	}
+
+	var shippingAddress *order.ShippingAddress
+	if req.AddressId != nil {
+		shippingAddress, err = s.Address.Snapshot(ctx, req.ReqUserId, *req.AddressId)
+		if err != nil {
+			if errors.Is(err, address.ErrNotFound) {
+				klogErr("address not found. " + err.Error())
+				return &order.CreateFromCartResponse{
+					BaseResp: &base.BaseResponse{
+						Code: base.Code_INVALID_PARAM,
+						Msg:  "收货地址不存在或不属于下单用户.",
+					},
+				}, nil
+			}
+			klogErr("query address failed. " + err.Error())
+			return &order.CreateFromCartResponse{
+				BaseResp: &base.BaseResponse{
+					Code: base.Code_SERVICE_ERR,
+					Msg:  internalErrMsg,
+				},
+			}, nil
+		}
+	}
+
+	skus, err := lookupSkus(ctx, s.Catalog, req.Items)
+	if err != nil {
+		var pe *pricingError
+		if errors.As(err, &pe) {
+			klogErr("invalid items. " + err.Error())
+			return &order.CreateFromCartResponse{
+				BaseResp: &base.BaseResponse{
+					Code: base.Code_INVALID_PARAM,
+					Msg:  pe.Error(),
+				},
+			}, nil
+		}
+		klogErr("query catalog failed. " + err.Error())
+		return &order.CreateFromCartResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  internalErrMsg,
+			},
+		}, nil
+	}
+
+	now := time.Now().Unix()
+	parentId := primitive.NewObjectID()
+	children, err := s.buildCartOrders(req, parentId.Hex(), splitByMerchant(req.Items, skus), shippingAddress, now)
+	if err != nil {
+		klogErr("fail to build child orders. " + err.Error())
+		return &order.CreateFromCartResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SERVICE_ERR,
+				Msg:  internalErrMsg,
+			},
+		}, nil
+	}
+	parent := &parentDoc{
+		ID:             parentId,
+		ReqUserId:      req.ReqUserId,
+		CreatedAt:      now,
+		IdempotencyKey: idempotencyKey,
+		RequestDigest:  digest,
+	}
+	orders := make([]*order.Order, 0, len(children))
+	for _, c := range children {
+		parent.OrderIds = append(parent.OrderIds, c.Order.Id)
+		orders = append(orders, &c.Order)
+	}
+
+	err = insertCartOrders(ctx, parent, children)
+	if err != nil && idempotencyKey != "" && mongoOfficial.IsDuplicateKeyError(err) {
+		// 同一幂等键的并发请求，唯一索引保证只有一个父订单写入成功
+		replayed, replayErr := replayCreateFromCart(ctx, req.ReqUserId, idempotencyKey, digest)
+		if resp = replayCartResp(ctx, replayed, replayErr, klogErr); resp != nil {
+			return resp, nil
+		}
+	}
+	if err != nil {
+		klogErr("fail to insert orders. " + err.Error())
+		return &order.CreateFromCartResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_DB_ERR,
+				Msg:  internalErrMsg,
+			},
+		}, nil
+	}
+
+	return &order.CreateFromCartResponse{
+		BaseResp: &base.BaseResponse{
+			Code: base.Code_SUCCESS,
+			Msg:  successMsg,
+		},
+		ParentOrder: toParentOrder(parent, orders),
+		Orders:      orders,
+	}, nil
+}
+
+// QueryParentOrder implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) QueryParentOrder(ctx context.Context, req *order.QueryParentOrderRequest) (resp *order.QueryParentOrderResponse, err error) {
+
+	klogErr := func(msg string) {
+		target := ""
+		if req != nil {
+			target = req.String()
+		}
+		klog.Error(
+			"method: ", "QueryParentOrder",
+			"req: ", target,
+			"message: ", msg,
+		)
+	}
+
+	objectId, err := primitive.ObjectIDFromHex(req.ParentId)
+	if err != nil {
+		klogErr("fail to convert req.ParentId to objectId: " + err.Error())
+		return &order.QueryParentOrderResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  "父订单ID格式错误",
+			},
+		}, nil
+	}
+
+	parent, err := loadParent(ctx, objectId)
+	if errors.Is(err, errParentNotFound) {
+		return &order.QueryParentOrderResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_NOT_FOUND,
+				Msg:  "父订单不存在",
+			},
+		}, nil
+	}
+	var children []*order.Order
+	if err == nil {
+		children, err = loadChildren(ctx, parent)
+	}
+	if err != nil {
+		klogErr("fail to query parent order: " + err.Error())
+		return &order.QueryParentOrderResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_DB_ERR,
+				Msg:  internalErrMsg,
+			},
+		}, nil
+	}
+
+	return &order.QueryParentOrderResponse{
+		BaseResp: &base.BaseResponse{
+			Code: base.Code_SUCCESS,
+			Msg:  successMsg,
+		},
+		ParentOrder: toParentOrder(parent, children),
+		Orders:      children,
+	}, nil
+}
+
+// CreateShipment implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) CreateShipment(ctx context.Context, req *order.CreateShipmentRequest) (resp *order.CreateShipmentResponse, err error) {
+
+	klogErr := func(msg string) {
+		target := ""
+		if req != nil {
+			target = req.String()
+		}
+		klog.Error(
+			"method: ", "CreateShipment",
+			"req: ", target,
+			"message: ", msg,
+		)
+	}
+
+	if err = validateCreateShipmentReq(req); err != nil {
+		klogErr("invalid params: " + err.Error())
+		return &order.CreateShipmentResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  err.Error(),
+			},
+		}, nil
+	}
+
+	objectId, err := primitive.ObjectIDFromHex(req.OrderId)
+	if err != nil {
+		klogErr("fail to convert req.OrderId to objectId: " + err.Error())
+		return &order.CreateShipmentResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  "订单ID格式错误",
+			},
+		}, nil
+	}
+
+	shipment, err := createShipment(ctx, objectId, req, s.AutoConfirmAfter)
+	if err != nil {
+		klogErr("fail to create shipment: " + err.Error())
+		return &order.CreateShipmentResponse{BaseResp: shipmentBaseResp(err)}, nil
+	}
+
+	return &order.CreateShipmentResponse{
+		BaseResp: shipmentBaseResp(nil),
+		Shipment: shipment,
+	}, nil
+}
+
+// AddTrackingEvent implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) AddTrackingEvent(ctx context.Context, req *order.AddTrackingEventRequest) (resp *order.AddTrackingEventResponse, err error) {
+
+	klogErr := func(msg string) {
+		target := ""
+		if req != nil {
+			target = req.String()
+		}
+		klog.Error(
+			"method: ", "AddTrackingEvent",
+			"req: ", target,
+			"message: ", msg,
+		)
+	}
+
+	if err = validateAddTrackingEventReq(req); err != nil {
+		klogErr("invalid params: " + err.Error())
+		return &order.AddTrackingEventResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  err.Error(),
+			},
+		}, nil
+	}
+
+	objectId, err := primitive.ObjectIDFromHex(req.OrderId)
+	if err != nil {
+		klogErr("fail to convert req.OrderId to objectId: " + err.Error())
+		return &order.AddTrackingEventResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  "订单ID格式错误",
+			},
+		}, nil
+	}
+
+	shipment, err := addTrackingEvent(ctx, objectId, req)
+	if err != nil {
+		klogErr("fail to add tracking event: " + err.Error())
+		return &order.AddTrackingEventResponse{BaseResp: shipmentBaseResp(err)}, nil
+	}
+
+	return &order.AddTrackingEventResponse{
+		BaseResp: shipmentBaseResp(nil),
+		Shipment: shipment,
+	}, nil
+}
+
+// ConfirmDelivery implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) ConfirmDelivery(ctx context.Context, req *order.ConfirmDeliveryRequest) (resp *order.ConfirmDeliveryResponse, err error) {
+
+	klogErr := func(msg string) {
+		target := ""
+		if req != nil {
+			target = req.String()
+		}
+		klog.Error(
+			"method: ", "ConfirmDelivery",
+			"req: ", target,
+			"message: ", msg,
+		)
+	}
+
+	if err = validateConfirmDeliveryReq(req); err != nil {
+		klogErr("invalid params: " + err.Error())
+		return &order.ConfirmDeliveryResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  err.Error(),
+			},
+		}, nil
+	}
+
+	objectId, err := primitive.ObjectIDFromHex(req.OrderId)
+	if err != nil {
+		klogErr("fail to convert req.OrderId to objectId: " + err.Error())
+		return &order.ConfirmDeliveryResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  "订单ID格式错误",
+			},
+		}, nil
+	}
+
+	if err = confirmDelivery(ctx, objectId, req); err != nil {
+		klogErr("fail to confirm delivery: " + err.Error())
+		return &order.ConfirmDeliveryResponse{BaseResp: shipmentBaseResp(err)}, nil
+	}
+
+	return &order.ConfirmDeliveryResponse{BaseResp: shipmentBaseResp(nil)}, nil
+}
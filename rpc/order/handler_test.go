@@ -0,0 +1,54 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	order "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order"
+)
+
+func TestUniqueOrderIds(t *testing.T) {
+	const id = "65a1b2c3d4e5f60718293a4b"
+	const other = "65a1b2c3d4e5f60718293a4c"
+
+	ids, objectIds := uniqueOrderIds([]string{
+		"65A1B2C3D4E5F60718293A4B", // 大写与小写视为同一个 id
+		other,
+		id,
+		"bad-id",
+		"bad-id",
+	})
+
+	want := []string{id, other, "bad-id"}
+	if !reflect.DeepEqual(ids, want) {
+		t.Fatalf("ids = %v, want %v", ids, want)
+	}
+	if len(objectIds) != 2 || objectIds[0].Hex() != id || objectIds[1].Hex() != other {
+		t.Fatalf("objectIds = %v", objectIds)
+	}
+}
+
+func TestValidateQueryOrderIdReqExtKey(t *testing.T) {
+	val := "1"
+	for _, c := range []struct {
+		key string
+		ok  bool
+	}{
+		{"seckill_id", true},
+		{"", false},
+		{"a.b", false},
+		{"$where", false},
+	} {
+		key := c.key
+		err := validateQueryOrderIdReq(&order.QueryOrderIdRequest{
+			Type:     order.QueryOrderIdType_EXT_KEY,
+			ExtKey:   &key,
+			ExtVal:   &val,
+			Page:     1,
+			PageSize: 10,
+		})
+		if (err == nil) != c.ok {
+			t.Errorf("key %q: err = %v", c.key, err)
+		}
+	}
+}
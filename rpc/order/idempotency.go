@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/base"
+	order "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	mongoOfficial "go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const maxIdempotencyKeyLen = 64
+
+var errIdempotencyConflict = errors.New("idempotency key reused with a different request")
+
+func validateIdempotencyKey(key string) error {
+	if len(key) > maxIdempotencyKeyLen {
+		return fmt.Errorf("幂等键长度不能超过 %d.", maxIdempotencyKeyLen)
+	}
+	return nil
+}
+
+// createDigest 计算创建请求除幂等键以外内容的摘要。
+// json.Marshal 对 map 按 key 排序，同样的请求总是得到同样的摘要。
+func createDigest(req *order.CreateRequest) (string, error) {
+	body := *req
+	body.IdempotencyKey = nil
+	return digestJSON(&body)
+}
+
+func digestJSON(v any) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// replayCreate 查找 reqUserId 用 key 创建过的订单：没有时返回空字符串；
+// 摘要一致时返回原订单 id，不一致时返回 errIdempotencyConflict。
+func replayCreate(ctx context.Context, reqUserId int64, key, digest string) (string, error) {
+	var doc struct {
+		Order struct {
+			Id            string `bson:"id"`
+			RequestDigest string `bson:"requestdigest"`
+		} `bson:"order"`
+	}
+	filter := bson.M{"order.requserid": reqUserId, "order.idempotencykey": key}
+	findOpts := options.FindOne().SetProjection(bson.M{"order.id": 1, "order.requestdigest": 1})
+	err := Coll.FindOne(ctx, filter, findOpts).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongoOfficial.ErrNoDocuments) {
+			return "", nil
+		}
+		return "", err
+	}
+	if doc.Order.RequestDigest != digest {
+		return "", errIdempotencyConflict
+	}
+	return doc.Order.Id, nil
+}
+
+// replayCreateResp 把 replayCreate 的结果转换为 Create 的响应，返回 nil 表示没有创建过，需要正常创建
+func replayCreateResp(orderId string, err error) *order.CreateResponse {
+	switch {
+	case errors.Is(err, errIdempotencyConflict):
+		return &order.CreateResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_IDEMPOTENCY_CONFLICT,
+				Msg:  "幂等键已被另一个内容不同的请求使用",
+			},
+		}
+	case err != nil:
+		return &order.CreateResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_DB_ERR,
+				Msg:  internalErrMsg,
+			},
+		}
+	case orderId != "":
+		return &order.CreateResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_SUCCESS,
+				Msg:  successMsg,
+			},
+			OrderId: orderId,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	mongoOfficial "go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// indexSpec 一个声明的索引
+type indexSpec struct {
+	name   string
+	keys   bson.D
+	unique bool
+	// partial 不为空时只索引满足该条件的文档（partialFilterExpression）
+	partial bson.D
+}
+
+func (s indexSpec) model() mongoOfficial.IndexModel {
+	opts := options.Index().SetName(s.name)
+	if s.unique {
+		opts.SetUnique(true)
+	}
+	if len(s.partial) > 0 {
+		opts.SetPartialFilterExpression(s.partial)
+	}
+	return mongoOfficial.IndexModel{
+		Keys:    s.keys,
+		Options: opts,
+	}
+}
+
+// orderIndexes 订单集合声明的索引，服务启动时创建；新增查询条件时在这里登记
+var orderIndexes = []indexSpec{
+	// 买家订单列表：QueryOrderId(REQ_USER)、SearchOrders(req_user_id) 按创建时间排序
+	{name: "requserid_createdat", keys: bson.D{{Key: "order.requserid", Value: 1}, {Key: "order.createdat", Value: -1}}},
+	// 商户订单列表
+	{name: "respuserid_createdat", keys: bson.D{{Key: "order.respuserid", Value: 1}, {Key: "order.createdat", Value: -1}}},
+	// 超时取消扫描
+	{name: "status_paydeadline", keys: bson.D{{Key: "order.status", Value: 1}, {Key: "order.paydeadline", Value: 1}}},
+	// 自动确认收货扫描，只索引已全部发出的订单
+	{
+		name:    "status_autoconfirmat",
+		keys:    bson.D{{Key: "order.status", Value: 1}, {Key: "order.autoconfirmat", Value: 1}},
+		partial: bson.D{{Key: "order.autoconfirmat", Value: bson.D{{Key: "$gt", Value: 0}}}},
+	},
+	// 按保留策略归档
+	{name: "status_updatedat", keys: bson.D{{Key: "order.status", Value: 1}, {Key: "order.updatedat", Value: 1}}},
+	// 按 SKU 查订单（多键索引）
+	{name: "items_skuid", keys: bson.D{{Key: "order.items.skuid", Value: 1}}},
+	// 父订单下的子订单，只索引有父订单的订单
+	{
+		name:    "parentid",
+		keys:    bson.D{{Key: "order.parentid", Value: 1}},
+		partial: bson.D{{Key: "order.parentid", Value: bson.D{{Key: "$gt", Value: ""}}}},
+	},
+	// Create 的幂等键，同一买家下唯一；没有幂等键的订单该字段为空字符串，不参与索引
+	{
+		name:    "requserid_idempotencykey",
+		keys:    bson.D{{Key: "order.requserid", Value: 1}, {Key: "order.idempotencykey", Value: 1}},
+		unique:  true,
+		partial: bson.D{{Key: "order.idempotencykey", Value: bson.D{{Key: "$gt", Value: ""}}}},
+	},
+}
+
+// extIndexKeysFromEnv 读取 $ORDER_EXT_INDEXES，逗号分隔的 ext key，如 "seckill_id,group_id"，
+// 这些 key 会在 order.ext.<key> 上建索引，供 QueryOrderId/SearchOrders 按 ext 查询
+func extIndexKeysFromEnv() ([]string, error) {
+	v := os.Getenv("ORDER_EXT_INDEXES")
+	if v == "" {
+		return nil, nil
+	}
+	var keys []string
+	for _, k := range strings.Split(v, ",") {
+		k = strings.TrimSpace(k)
+		if k == "" {
+			continue
+		}
+		if err := validateExtKey(k); err != nil {
+			return nil, fmt.Errorf("ORDER_EXT_INDEXES: %w", err)
+		}
+		keys = append(keys, k)
+	}
+	return keys, nil
+}
+
+// declaredIndexes 返回声明的索引，包括配置的 ext 索引
+func declaredIndexes(extKeys []string) []indexSpec {
+	specs := append([]indexSpec{}, orderIndexes...)
+	for _, k := range extKeys {
+		specs = append(specs, indexSpec{name: "ext_" + k, keys: bson.D{{Key: "order.ext." + k, Value: 1}}})
+	}
+	return specs
+}
+
+// ensureIndexes 创建声明的索引，以及发件箱、父订单集合的索引。同名同定义的索引已存在时 MongoDB 直接返回成功，多副本同时启动也是安全的
+func ensureIndexes(ctx context.Context, extKeys []string) error {
+	var models []mongoOfficial.IndexModel
+	for _, spec := range declaredIndexes(extKeys) {
+		models = append(models, spec.model())
+	}
+	if _, err := Coll.Indexes().CreateMany(ctx, models); err != nil {
+		return err
+	}
+	if _, err := OutboxColl.Indexes().CreateMany(ctx, outboxIndexes); err != nil {
+		return err
+	}
+	_, err := ParentColl.Indexes().CreateMany(ctx, parentIndexes)
+	return err
+}
+
+// printIndexStats 输出订单集合每个索引的使用次数（$indexStats），以及声明了但不存在、存在但未声明的索引
+func printIndexStats(ctx context.Context, w io.Writer, extKeys []string) error {
+	declared := map[string]bool{"_id_": true}
+	for _, spec := range declaredIndexes(extKeys) {
+		declared[spec.name] = true
+	}
+
+	cur, err := Coll.Aggregate(ctx, mongoOfficial.Pipeline{{{Key: "$indexStats", Value: bson.D{}}}})
+	if err != nil {
+		return err
+	}
+	var stats []struct {
+		Name     string `bson:"name"`
+		Key      bson.D `bson:"key"`
+		Accesses struct {
+			Ops   int64     `bson:"ops"`
+			Since time.Time `bson:"since"`
+		} `bson:"accesses"`
+	}
+	if err := cur.All(ctx, &stats); err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tKEY\tOPS\tSINCE\tDECLARED")
+	existing := make(map[string]bool, len(stats))
+	for _, s := range stats {
+		existing[s.Name] = true
+		fmt.Fprintf(tw, "%s\t%v\t%d\t%s\t%v\n", s.Name, s.Key, s.Accesses.Ops, s.Accesses.Since.Format(time.RFC3339), declared[s.Name])
+	}
+	for name := range declared {
+		if !existing[name] {
+			fmt.Fprintf(tw, "%s\t-\t-\t-\tmissing\n", name)
+		}
+	}
+	return tw.Flush()
+}
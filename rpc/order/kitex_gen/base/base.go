@@ -11,11 +11,14 @@ import (
 type Code int64
 
 const (
-	Code_SUCCESS       Code = 0
-	Code_INVALID_PARAM Code = 1
-	Code_DB_ERR        Code = 2
-	Code_SERVICE_ERR   Code = 3
-	Code_NOT_FOUND     Code = 4
+	Code_SUCCESS                   Code = 0
+	Code_INVALID_PARAM             Code = 1
+	Code_DB_ERR                    Code = 2
+	Code_SERVICE_ERR               Code = 3
+	Code_NOT_FOUND                 Code = 4
+	Code_INVALID_STATUS_TRANSITION Code = 5
+	Code_VERSION_CONFLICT          Code = 6
+	Code_IDEMPOTENCY_CONFLICT      Code = 7
 )
 
 func (p Code) String() string {
@@ -30,6 +33,12 @@ func (p Code) String() string {
 		return "SERVICE_ERR"
 	case Code_NOT_FOUND:
 		return "NOT_FOUND"
+	case Code_INVALID_STATUS_TRANSITION:
+		return "INVALID_STATUS_TRANSITION"
+	case Code_VERSION_CONFLICT:
+		return "VERSION_CONFLICT"
+	case Code_IDEMPOTENCY_CONFLICT:
+		return "IDEMPOTENCY_CONFLICT"
 	}
 	return "<UNSET>"
 }
@@ -46,6 +55,12 @@ func CodeFromString(s string) (Code, error) {
 		return Code_SERVICE_ERR, nil
 	case "NOT_FOUND":
 		return Code_NOT_FOUND, nil
+	case "INVALID_STATUS_TRANSITION":
+		return Code_INVALID_STATUS_TRANSITION, nil
+	case "VERSION_CONFLICT":
+		return Code_VERSION_CONFLICT, nil
+	case "IDEMPOTENCY_CONFLICT":
+		return Code_IDEMPOTENCY_CONFLICT, nil
 	}
 	return Code(0), fmt.Errorf("not a valid Code string")
 }
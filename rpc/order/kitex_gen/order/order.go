@@ -10,6 +10,142 @@ import (
 	"github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/base"
 )
 
+type RefundStatus int64
+
+const (
+	RefundStatus_REQUESTED RefundStatus = 1
+	RefundStatus_APPROVED  RefundStatus = 2
+	RefundStatus_REJECTED  RefundStatus = 3
+	RefundStatus_COMPLETED RefundStatus = 4
+)
+
+func (p RefundStatus) String() string {
+	switch p {
+	case RefundStatus_REQUESTED:
+		return "REQUESTED"
+	case RefundStatus_APPROVED:
+		return "APPROVED"
+	case RefundStatus_REJECTED:
+		return "REJECTED"
+	case RefundStatus_COMPLETED:
+		return "COMPLETED"
+	}
+	return "<UNSET>"
+}
+
+func RefundStatusFromString(s string) (RefundStatus, error) {
+	switch s {
+	case "REQUESTED":
+		return RefundStatus_REQUESTED, nil
+	case "APPROVED":
+		return RefundStatus_APPROVED, nil
+	case "REJECTED":
+		return RefundStatus_REJECTED, nil
+	case "COMPLETED":
+		return RefundStatus_COMPLETED, nil
+	}
+	return RefundStatus(0), fmt.Errorf("not a valid RefundStatus string")
+}
+
+func RefundStatusPtr(v RefundStatus) *RefundStatus { return &v }
+func (p *RefundStatus) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = RefundStatus(result.Int64)
+	return
+}
+
+func (p *RefundStatus) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type ShipmentStatus int64
+
+const (
+	ShipmentStatus_SHIPPED   ShipmentStatus = 1
+	ShipmentStatus_DELIVERED ShipmentStatus = 2
+)
+
+func (p ShipmentStatus) String() string {
+	switch p {
+	case ShipmentStatus_SHIPPED:
+		return "SHIPPED"
+	case ShipmentStatus_DELIVERED:
+		return "DELIVERED"
+	}
+	return "<UNSET>"
+}
+
+func ShipmentStatusFromString(s string) (ShipmentStatus, error) {
+	switch s {
+	case "SHIPPED":
+		return ShipmentStatus_SHIPPED, nil
+	case "DELIVERED":
+		return ShipmentStatus_DELIVERED, nil
+	}
+	return ShipmentStatus(0), fmt.Errorf("not a valid ShipmentStatus string")
+}
+
+func ShipmentStatusPtr(v ShipmentStatus) *ShipmentStatus { return &v }
+func (p *ShipmentStatus) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = ShipmentStatus(result.Int64)
+	return
+}
+
+func (p *ShipmentStatus) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type ExtUpdateMode int64
+
+const (
+	ExtUpdateMode_MERGE   ExtUpdateMode = 1
+	ExtUpdateMode_REPLACE ExtUpdateMode = 2
+)
+
+func (p ExtUpdateMode) String() string {
+	switch p {
+	case ExtUpdateMode_MERGE:
+		return "MERGE"
+	case ExtUpdateMode_REPLACE:
+		return "REPLACE"
+	}
+	return "<UNSET>"
+}
+
+func ExtUpdateModeFromString(s string) (ExtUpdateMode, error) {
+	switch s {
+	case "MERGE":
+		return ExtUpdateMode_MERGE, nil
+	case "REPLACE":
+		return ExtUpdateMode_REPLACE, nil
+	}
+	return ExtUpdateMode(0), fmt.Errorf("not a valid ExtUpdateMode string")
+}
+
+func ExtUpdateModePtr(v ExtUpdateMode) *ExtUpdateMode { return &v }
+func (p *ExtUpdateMode) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = ExtUpdateMode(result.Int64)
+	return
+}
+
+func (p *ExtUpdateMode) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
 type QueryOrderIdType int64
 
 const (
@@ -57,6 +193,95 @@ func (p *QueryOrderIdType) Value() (driver.Value, error) {
 	return int64(*p), nil
 }
 
+type ReportGranularity int64
+
+const (
+	ReportGranularity_DAY   ReportGranularity = 1
+	ReportGranularity_WEEK  ReportGranularity = 2
+	ReportGranularity_MONTH ReportGranularity = 3
+)
+
+func (p ReportGranularity) String() string {
+	switch p {
+	case ReportGranularity_DAY:
+		return "DAY"
+	case ReportGranularity_WEEK:
+		return "WEEK"
+	case ReportGranularity_MONTH:
+		return "MONTH"
+	}
+	return "<UNSET>"
+}
+
+func ReportGranularityFromString(s string) (ReportGranularity, error) {
+	switch s {
+	case "DAY":
+		return ReportGranularity_DAY, nil
+	case "WEEK":
+		return ReportGranularity_WEEK, nil
+	case "MONTH":
+		return ReportGranularity_MONTH, nil
+	}
+	return ReportGranularity(0), fmt.Errorf("not a valid ReportGranularity string")
+}
+
+func ReportGranularityPtr(v ReportGranularity) *ReportGranularity { return &v }
+func (p *ReportGranularity) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = ReportGranularity(result.Int64)
+	return
+}
+
+func (p *ReportGranularity) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
+type SearchSortField int64
+
+const (
+	SearchSortField_CREATED_AT SearchSortField = 1
+	SearchSortField_UPDATED_AT SearchSortField = 2
+)
+
+func (p SearchSortField) String() string {
+	switch p {
+	case SearchSortField_CREATED_AT:
+		return "CREATED_AT"
+	case SearchSortField_UPDATED_AT:
+		return "UPDATED_AT"
+	}
+	return "<UNSET>"
+}
+
+func SearchSortFieldFromString(s string) (SearchSortField, error) {
+	switch s {
+	case "CREATED_AT":
+		return SearchSortField_CREATED_AT, nil
+	case "UPDATED_AT":
+		return SearchSortField_UPDATED_AT, nil
+	}
+	return SearchSortField(0), fmt.Errorf("not a valid SearchSortField string")
+}
+
+func SearchSortFieldPtr(v SearchSortField) *SearchSortField { return &v }
+func (p *SearchSortField) Scan(value interface{}) (err error) {
+	var result sql.NullInt64
+	err = result.Scan(value)
+	*p = SearchSortField(result.Int64)
+	return
+}
+
+func (p *SearchSortField) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+	return int64(*p), nil
+}
+
 type OrderItem struct {
 	Id        int64             `thrift:"id,1" frugal:"1,default,i64" json:"id"`
 	OrderId   string            `thrift:"order_id,2" frugal:"2,default,string" json:"order_id"`
@@ -65,6 +290,7 @@ type OrderItem struct {
 	Count     int64             `thrift:"count,5" frugal:"5,default,i64" json:"count"`
 	Price     int64             `thrift:"price,6" frugal:"6,default,i64" json:"price"`
 	Ext       map[string]string `thrift:"ext,7" frugal:"7,default,map<string:string>" json:"ext"`
+	Subtotal  int64             `thrift:"subtotal,8" frugal:"8,default,i64" json:"subtotal"`
 }
 
 func NewOrderItem() *OrderItem {
@@ -101,6 +327,10 @@ func (p *OrderItem) GetPrice() (v int64) {
 func (p *OrderItem) GetExt() (v map[string]string) {
 	return p.Ext
 }
+
+func (p *OrderItem) GetSubtotal() (v int64) {
+	return p.Subtotal
+}
 func (p *OrderItem) SetId(val int64) {
 	p.Id = val
 }
@@ -122,6 +352,9 @@ func (p *OrderItem) SetPrice(val int64) {
 func (p *OrderItem) SetExt(val map[string]string) {
 	p.Ext = val
 }
+func (p *OrderItem) SetSubtotal(val int64) {
+	p.Subtotal = val
+}
 
 func (p *OrderItem) String() string {
 	if p == nil {
@@ -138,991 +371,4891 @@ var fieldIDToName_OrderItem = map[int16]string{
 	5: "count",
 	6: "price",
 	7: "ext",
+	8: "subtotal",
 }
 
-type Order struct {
-	Id         string            `thrift:"id,1" frugal:"1,default,string" json:"id"`
-	Type       int32             `thrift:"type,2" frugal:"2,default,i32" json:"type"`
-	Status     int32             `thrift:"status,3" frugal:"3,default,i32" json:"status"`
-	ReqUserId  int64             `thrift:"req_user_id,4" frugal:"4,default,i64" json:"req_user_id"`
-	RespUserId int64             `thrift:"resp_user_id,5" frugal:"5,default,i64" json:"resp_user_id"`
-	Items      []*OrderItem      `thrift:"items,6" frugal:"6,default,list<OrderItem>" json:"items"`
-	CreatedAt  int64             `thrift:"created_at,7" frugal:"7,default,i64" json:"created_at"`
-	UpdatedAt  int64             `thrift:"updated_at,8" frugal:"8,default,i64" json:"updated_at"`
-	Ext        map[string]string `thrift:"ext,9" frugal:"9,default,map<string:string>" json:"ext"`
+type StatusRecord struct {
+	From      int32 `thrift:"from,1" frugal:"1,default,i32" json:"from"`
+	To        int32 `thrift:"to,2" frugal:"2,default,i32" json:"to"`
+	ChangedAt int64 `thrift:"changed_at,3" frugal:"3,default,i64" json:"changed_at"`
 }
 
-func NewOrder() *Order {
-	return &Order{}
+func NewStatusRecord() *StatusRecord {
+	return &StatusRecord{}
 }
 
-func (p *Order) InitDefault() {
+func (p *StatusRecord) InitDefault() {
 }
 
-func (p *Order) GetId() (v string) {
-	return p.Id
+func (p *StatusRecord) GetFrom() (v int32) {
+	return p.From
 }
 
-func (p *Order) GetType() (v int32) {
-	return p.Type
+func (p *StatusRecord) GetTo() (v int32) {
+	return p.To
 }
 
-func (p *Order) GetStatus() (v int32) {
-	return p.Status
+func (p *StatusRecord) GetChangedAt() (v int64) {
+	return p.ChangedAt
 }
-
-func (p *Order) GetReqUserId() (v int64) {
-	return p.ReqUserId
+func (p *StatusRecord) SetFrom(val int32) {
+	p.From = val
 }
-
-func (p *Order) GetRespUserId() (v int64) {
-	return p.RespUserId
+func (p *StatusRecord) SetTo(val int32) {
+	p.To = val
+}
+func (p *StatusRecord) SetChangedAt(val int64) {
+	p.ChangedAt = val
 }
 
-func (p *Order) GetItems() (v []*OrderItem) {
-	return p.Items
+func (p *StatusRecord) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("StatusRecord(%+v)", *p)
 }
 
-func (p *Order) GetCreatedAt() (v int64) {
-	return p.CreatedAt
+var fieldIDToName_StatusRecord = map[int16]string{
+	1: "from",
+	2: "to",
+	3: "changed_at",
 }
 
-func (p *Order) GetUpdatedAt() (v int64) {
-	return p.UpdatedAt
+type RefundItem struct {
+	ItemId    int64 `thrift:"item_id,1" frugal:"1,default,i64" json:"item_id"`
+	ProductId int64 `thrift:"product_id,2" frugal:"2,default,i64" json:"product_id"`
+	SkuId     int64 `thrift:"sku_id,3" frugal:"3,default,i64" json:"sku_id"`
+	Count     int64 `thrift:"count,4" frugal:"4,default,i64" json:"count"`
 }
 
-func (p *Order) GetExt() (v map[string]string) {
-	return p.Ext
+func NewRefundItem() *RefundItem {
+	return &RefundItem{}
 }
-func (p *Order) SetId(val string) {
-	p.Id = val
+
+func (p *RefundItem) InitDefault() {
 }
-func (p *Order) SetType(val int32) {
-	p.Type = val
+
+func (p *RefundItem) GetItemId() (v int64) {
+	return p.ItemId
 }
-func (p *Order) SetStatus(val int32) {
-	p.Status = val
+
+func (p *RefundItem) GetProductId() (v int64) {
+	return p.ProductId
 }
-func (p *Order) SetReqUserId(val int64) {
-	p.ReqUserId = val
+
+func (p *RefundItem) GetSkuId() (v int64) {
+	return p.SkuId
 }
-func (p *Order) SetRespUserId(val int64) {
-	p.RespUserId = val
+
+func (p *RefundItem) GetCount() (v int64) {
+	return p.Count
 }
-func (p *Order) SetItems(val []*OrderItem) {
-	p.Items = val
+func (p *RefundItem) SetItemId(val int64) {
+	p.ItemId = val
 }
-func (p *Order) SetCreatedAt(val int64) {
-	p.CreatedAt = val
+func (p *RefundItem) SetProductId(val int64) {
+	p.ProductId = val
 }
-func (p *Order) SetUpdatedAt(val int64) {
-	p.UpdatedAt = val
+func (p *RefundItem) SetSkuId(val int64) {
+	p.SkuId = val
 }
-func (p *Order) SetExt(val map[string]string) {
-	p.Ext = val
+func (p *RefundItem) SetCount(val int64) {
+	p.Count = val
 }
 
-func (p *Order) String() string {
+func (p *RefundItem) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("Order(%+v)", *p)
+	return fmt.Sprintf("RefundItem(%+v)", *p)
 }
 
-var fieldIDToName_Order = map[int16]string{
-	1: "id",
-	2: "type",
-	3: "status",
-	4: "req_user_id",
-	5: "resp_user_id",
-	6: "items",
-	7: "created_at",
-	8: "updated_at",
-	9: "ext",
+var fieldIDToName_RefundItem = map[int16]string{
+	1: "item_id",
+	2: "product_id",
+	3: "sku_id",
+	4: "count",
 }
 
-type OrderItemForCreate struct {
-	ProductId int64             `thrift:"product_id,1" frugal:"1,default,i64" json:"product_id"`
-	SkuId     int64             `thrift:"sku_id,2" frugal:"2,default,i64" json:"sku_id"`
-	Count     int64             `thrift:"count,3" frugal:"3,default,i64" json:"count"`
-	Price     int64             `thrift:"price,4" frugal:"4,default,i64" json:"price"`
-	Ext       map[string]string `thrift:"ext,5" frugal:"5,default,map<string:string>" json:"ext"`
+type Refund struct {
+	Id           int64         `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	Items        []*RefundItem `thrift:"items,2" frugal:"2,default,list<RefundItem>" json:"items"`
+	Amount       int64         `thrift:"amount,3" frugal:"3,default,i64" json:"amount"`
+	Status       RefundStatus  `thrift:"status,4" frugal:"4,default,RefundStatus" json:"status"`
+	Reason       string        `thrift:"reason,5" frugal:"5,default,string" json:"reason"`
+	RejectReason string        `thrift:"reject_reason,6" frugal:"6,default,string" json:"reject_reason"`
+	CreatedAt    int64         `thrift:"created_at,7" frugal:"7,default,i64" json:"created_at"`
+	ReviewedAt   int64         `thrift:"reviewed_at,8" frugal:"8,default,i64" json:"reviewed_at"`
+	CompletedAt  int64         `thrift:"completed_at,9" frugal:"9,default,i64" json:"completed_at"`
 }
 
-func NewOrderItemForCreate() *OrderItemForCreate {
-	return &OrderItemForCreate{}
+func NewRefund() *Refund {
+	return &Refund{}
 }
 
-func (p *OrderItemForCreate) InitDefault() {
+func (p *Refund) InitDefault() {
 }
 
-func (p *OrderItemForCreate) GetProductId() (v int64) {
-	return p.ProductId
+func (p *Refund) GetId() (v int64) {
+	return p.Id
 }
 
-func (p *OrderItemForCreate) GetSkuId() (v int64) {
-	return p.SkuId
+func (p *Refund) GetItems() (v []*RefundItem) {
+	return p.Items
 }
 
-func (p *OrderItemForCreate) GetCount() (v int64) {
-	return p.Count
+func (p *Refund) GetAmount() (v int64) {
+	return p.Amount
 }
 
-func (p *OrderItemForCreate) GetPrice() (v int64) {
-	return p.Price
+func (p *Refund) GetStatus() (v RefundStatus) {
+	return p.Status
 }
 
-func (p *OrderItemForCreate) GetExt() (v map[string]string) {
-	return p.Ext
+func (p *Refund) GetReason() (v string) {
+	return p.Reason
 }
-func (p *OrderItemForCreate) SetProductId(val int64) {
-	p.ProductId = val
+
+func (p *Refund) GetRejectReason() (v string) {
+	return p.RejectReason
 }
-func (p *OrderItemForCreate) SetSkuId(val int64) {
-	p.SkuId = val
+
+func (p *Refund) GetCreatedAt() (v int64) {
+	return p.CreatedAt
 }
-func (p *OrderItemForCreate) SetCount(val int64) {
-	p.Count = val
+
+func (p *Refund) GetReviewedAt() (v int64) {
+	return p.ReviewedAt
 }
-func (p *OrderItemForCreate) SetPrice(val int64) {
-	p.Price = val
+
+func (p *Refund) GetCompletedAt() (v int64) {
+	return p.CompletedAt
 }
-func (p *OrderItemForCreate) SetExt(val map[string]string) {
-	p.Ext = val
+func (p *Refund) SetId(val int64) {
+	p.Id = val
+}
+func (p *Refund) SetItems(val []*RefundItem) {
+	p.Items = val
+}
+func (p *Refund) SetAmount(val int64) {
+	p.Amount = val
+}
+func (p *Refund) SetStatus(val RefundStatus) {
+	p.Status = val
+}
+func (p *Refund) SetReason(val string) {
+	p.Reason = val
+}
+func (p *Refund) SetRejectReason(val string) {
+	p.RejectReason = val
+}
+func (p *Refund) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+func (p *Refund) SetReviewedAt(val int64) {
+	p.ReviewedAt = val
+}
+func (p *Refund) SetCompletedAt(val int64) {
+	p.CompletedAt = val
 }
 
-func (p *OrderItemForCreate) String() string {
+func (p *Refund) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderItemForCreate(%+v)", *p)
+	return fmt.Sprintf("Refund(%+v)", *p)
 }
 
-var fieldIDToName_OrderItemForCreate = map[int16]string{
-	1: "product_id",
-	2: "sku_id",
-	3: "count",
-	4: "price",
-	5: "ext",
+var fieldIDToName_Refund = map[int16]string{
+	1: "id",
+	2: "items",
+	3: "amount",
+	4: "status",
+	5: "reason",
+	6: "reject_reason",
+	7: "created_at",
+	8: "reviewed_at",
+	9: "completed_at",
 }
 
-type CreateRequest struct {
-	Type       int32                 `thrift:"type,1" frugal:"1,default,i32" json:"type"`
-	Status     int32                 `thrift:"status,2" frugal:"2,default,i32" json:"status"`
-	ReqUserId  int64                 `thrift:"req_user_id,3" frugal:"3,default,i64" json:"req_user_id"`
-	RespUserId int64                 `thrift:"resp_user_id,4" frugal:"4,default,i64" json:"resp_user_id"`
-	Items      []*OrderItemForCreate `thrift:"items,5" frugal:"5,default,list<OrderItemForCreate>" json:"items"`
-	Ext        map[string]string     `thrift:"ext,6" frugal:"6,default,map<string:string>" json:"ext"`
+type ShipmentItem struct {
+	ItemId    int64 `thrift:"item_id,1" frugal:"1,default,i64" json:"item_id"`
+	ProductId int64 `thrift:"product_id,2" frugal:"2,default,i64" json:"product_id"`
+	SkuId     int64 `thrift:"sku_id,3" frugal:"3,default,i64" json:"sku_id"`
+	Count     int64 `thrift:"count,4" frugal:"4,default,i64" json:"count"`
 }
 
-func NewCreateRequest() *CreateRequest {
-	return &CreateRequest{}
+func NewShipmentItem() *ShipmentItem {
+	return &ShipmentItem{}
 }
 
-func (p *CreateRequest) InitDefault() {
+func (p *ShipmentItem) InitDefault() {
 }
 
-func (p *CreateRequest) GetType() (v int32) {
-	return p.Type
+func (p *ShipmentItem) GetItemId() (v int64) {
+	return p.ItemId
 }
 
-func (p *CreateRequest) GetStatus() (v int32) {
-	return p.Status
+func (p *ShipmentItem) GetProductId() (v int64) {
+	return p.ProductId
 }
 
-func (p *CreateRequest) GetReqUserId() (v int64) {
-	return p.ReqUserId
+func (p *ShipmentItem) GetSkuId() (v int64) {
+	return p.SkuId
 }
 
-func (p *CreateRequest) GetRespUserId() (v int64) {
+func (p *ShipmentItem) GetCount() (v int64) {
+	return p.Count
+}
+func (p *ShipmentItem) SetItemId(val int64) {
+	p.ItemId = val
+}
+func (p *ShipmentItem) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *ShipmentItem) SetSkuId(val int64) {
+	p.SkuId = val
+}
+func (p *ShipmentItem) SetCount(val int64) {
+	p.Count = val
+}
+
+func (p *ShipmentItem) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ShipmentItem(%+v)", *p)
+}
+
+var fieldIDToName_ShipmentItem = map[int16]string{
+	1: "item_id",
+	2: "product_id",
+	3: "sku_id",
+	4: "count",
+}
+
+type TrackingEvent struct {
+	OccurredAt  int64  `thrift:"occurred_at,1" frugal:"1,default,i64" json:"occurred_at"`
+	Description string `thrift:"description,2" frugal:"2,default,string" json:"description"`
+	Location    string `thrift:"location,3" frugal:"3,default,string" json:"location"`
+	CreatedAt   int64  `thrift:"created_at,4" frugal:"4,default,i64" json:"created_at"`
+}
+
+func NewTrackingEvent() *TrackingEvent {
+	return &TrackingEvent{}
+}
+
+func (p *TrackingEvent) InitDefault() {
+}
+
+func (p *TrackingEvent) GetOccurredAt() (v int64) {
+	return p.OccurredAt
+}
+
+func (p *TrackingEvent) GetDescription() (v string) {
+	return p.Description
+}
+
+func (p *TrackingEvent) GetLocation() (v string) {
+	return p.Location
+}
+
+func (p *TrackingEvent) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+func (p *TrackingEvent) SetOccurredAt(val int64) {
+	p.OccurredAt = val
+}
+func (p *TrackingEvent) SetDescription(val string) {
+	p.Description = val
+}
+func (p *TrackingEvent) SetLocation(val string) {
+	p.Location = val
+}
+func (p *TrackingEvent) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+
+func (p *TrackingEvent) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TrackingEvent(%+v)", *p)
+}
+
+var fieldIDToName_TrackingEvent = map[int16]string{
+	1: "occurred_at",
+	2: "description",
+	3: "location",
+	4: "created_at",
+}
+
+type Shipment struct {
+	Id          int64            `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	Carrier     string           `thrift:"carrier,2" frugal:"2,default,string" json:"carrier"`
+	TrackingNo  string           `thrift:"tracking_no,3" frugal:"3,default,string" json:"tracking_no"`
+	Items       []*ShipmentItem  `thrift:"items,4" frugal:"4,default,list<ShipmentItem>" json:"items"`
+	Status      ShipmentStatus   `thrift:"status,5" frugal:"5,default,ShipmentStatus" json:"status"`
+	Events      []*TrackingEvent `thrift:"events,6" frugal:"6,default,list<TrackingEvent>" json:"events"`
+	ShippedAt   int64            `thrift:"shipped_at,7" frugal:"7,default,i64" json:"shipped_at"`
+	DeliveredAt int64            `thrift:"delivered_at,8" frugal:"8,default,i64" json:"delivered_at"`
+}
+
+func NewShipment() *Shipment {
+	return &Shipment{}
+}
+
+func (p *Shipment) InitDefault() {
+}
+
+func (p *Shipment) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *Shipment) GetCarrier() (v string) {
+	return p.Carrier
+}
+
+func (p *Shipment) GetTrackingNo() (v string) {
+	return p.TrackingNo
+}
+
+func (p *Shipment) GetItems() (v []*ShipmentItem) {
+	return p.Items
+}
+
+func (p *Shipment) GetStatus() (v ShipmentStatus) {
+	return p.Status
+}
+
+func (p *Shipment) GetEvents() (v []*TrackingEvent) {
+	return p.Events
+}
+
+func (p *Shipment) GetShippedAt() (v int64) {
+	return p.ShippedAt
+}
+
+func (p *Shipment) GetDeliveredAt() (v int64) {
+	return p.DeliveredAt
+}
+func (p *Shipment) SetId(val int64) {
+	p.Id = val
+}
+func (p *Shipment) SetCarrier(val string) {
+	p.Carrier = val
+}
+func (p *Shipment) SetTrackingNo(val string) {
+	p.TrackingNo = val
+}
+func (p *Shipment) SetItems(val []*ShipmentItem) {
+	p.Items = val
+}
+func (p *Shipment) SetStatus(val ShipmentStatus) {
+	p.Status = val
+}
+func (p *Shipment) SetEvents(val []*TrackingEvent) {
+	p.Events = val
+}
+func (p *Shipment) SetShippedAt(val int64) {
+	p.ShippedAt = val
+}
+func (p *Shipment) SetDeliveredAt(val int64) {
+	p.DeliveredAt = val
+}
+
+func (p *Shipment) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Shipment(%+v)", *p)
+}
+
+var fieldIDToName_Shipment = map[int16]string{
+	1: "id",
+	2: "carrier",
+	3: "tracking_no",
+	4: "items",
+	5: "status",
+	6: "events",
+	7: "shipped_at",
+	8: "delivered_at",
+}
+
+type ShippingAddress struct {
+	AddressId    int64  `thrift:"address_id,1" frugal:"1,default,i64" json:"address_id"`
+	ReceiverName string `thrift:"receiver_name,2" frugal:"2,default,string" json:"receiver_name"`
+	Phone        string `thrift:"phone,3" frugal:"3,default,string" json:"phone"`
+	Province     string `thrift:"province,4" frugal:"4,default,string" json:"province"`
+	City         string `thrift:"city,5" frugal:"5,default,string" json:"city"`
+	District     string `thrift:"district,6" frugal:"6,default,string" json:"district"`
+	Detail       string `thrift:"detail,7" frugal:"7,default,string" json:"detail"`
+}
+
+func NewShippingAddress() *ShippingAddress {
+	return &ShippingAddress{}
+}
+
+func (p *ShippingAddress) InitDefault() {
+}
+
+func (p *ShippingAddress) GetAddressId() (v int64) {
+	return p.AddressId
+}
+
+func (p *ShippingAddress) GetReceiverName() (v string) {
+	return p.ReceiverName
+}
+
+func (p *ShippingAddress) GetPhone() (v string) {
+	return p.Phone
+}
+
+func (p *ShippingAddress) GetProvince() (v string) {
+	return p.Province
+}
+
+func (p *ShippingAddress) GetCity() (v string) {
+	return p.City
+}
+
+func (p *ShippingAddress) GetDistrict() (v string) {
+	return p.District
+}
+
+func (p *ShippingAddress) GetDetail() (v string) {
+	return p.Detail
+}
+func (p *ShippingAddress) SetAddressId(val int64) {
+	p.AddressId = val
+}
+func (p *ShippingAddress) SetReceiverName(val string) {
+	p.ReceiverName = val
+}
+func (p *ShippingAddress) SetPhone(val string) {
+	p.Phone = val
+}
+func (p *ShippingAddress) SetProvince(val string) {
+	p.Province = val
+}
+func (p *ShippingAddress) SetCity(val string) {
+	p.City = val
+}
+func (p *ShippingAddress) SetDistrict(val string) {
+	p.District = val
+}
+func (p *ShippingAddress) SetDetail(val string) {
+	p.Detail = val
+}
+
+func (p *ShippingAddress) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ShippingAddress(%+v)", *p)
+}
+
+var fieldIDToName_ShippingAddress = map[int16]string{
+	1: "address_id",
+	2: "receiver_name",
+	3: "phone",
+	4: "province",
+	5: "city",
+	6: "district",
+	7: "detail",
+}
+
+type Order struct {
+	Id              string            `thrift:"id,1" frugal:"1,default,string" json:"id"`
+	Type            int32             `thrift:"type,2" frugal:"2,default,i32" json:"type"`
+	Status          int32             `thrift:"status,3" frugal:"3,default,i32" json:"status"`
+	ReqUserId       int64             `thrift:"req_user_id,4" frugal:"4,default,i64" json:"req_user_id"`
+	RespUserId      int64             `thrift:"resp_user_id,5" frugal:"5,default,i64" json:"resp_user_id"`
+	Items           []*OrderItem      `thrift:"items,6" frugal:"6,default,list<OrderItem>" json:"items"`
+	CreatedAt       int64             `thrift:"created_at,7" frugal:"7,default,i64" json:"created_at"`
+	UpdatedAt       int64             `thrift:"updated_at,8" frugal:"8,default,i64" json:"updated_at"`
+	Ext             map[string]string `thrift:"ext,9" frugal:"9,default,map<string:string>" json:"ext"`
+	StatusHistory   []*StatusRecord   `thrift:"status_history,10" frugal:"10,default,list<StatusRecord>" json:"status_history"`
+	Version         int64             `thrift:"version,11" frugal:"11,default,i64" json:"version"`
+	TotalAmount     int64             `thrift:"total_amount,12" frugal:"12,default,i64" json:"total_amount"`
+	PayDeadline     int64             `thrift:"pay_deadline,13" frugal:"13,default,i64" json:"pay_deadline"`
+	Refunds         []*Refund         `thrift:"refunds,14" frugal:"14,default,list<Refund>" json:"refunds"`
+	RefundedAmount  int64             `thrift:"refunded_amount,15" frugal:"15,default,i64" json:"refunded_amount"`
+	IdempotencyKey  string            `thrift:"idempotency_key,16" frugal:"16,default,string" json:"idempotency_key"`
+	RequestDigest   string            `thrift:"request_digest,17" frugal:"17,default,string" json:"request_digest"`
+	ParentId        string            `thrift:"parent_id,18" frugal:"18,default,string" json:"parent_id"`
+	Shipments       []*Shipment       `thrift:"shipments,19" frugal:"19,default,list<Shipment>" json:"shipments"`
+	AutoConfirmAt   int64             `thrift:"auto_confirm_at,20" frugal:"20,default,i64" json:"auto_confirm_at"`
+	ShippingAddress *ShippingAddress  `thrift:"shipping_address,21" frugal:"21,default,ShippingAddress" json:"shipping_address"`
+}
+
+func NewOrder() *Order {
+	return &Order{}
+}
+
+func (p *Order) InitDefault() {
+}
+
+func (p *Order) GetId() (v string) {
+	return p.Id
+}
+
+func (p *Order) GetType() (v int32) {
+	return p.Type
+}
+
+func (p *Order) GetStatus() (v int32) {
+	return p.Status
+}
+
+func (p *Order) GetReqUserId() (v int64) {
+	return p.ReqUserId
+}
+
+func (p *Order) GetRespUserId() (v int64) {
 	return p.RespUserId
 }
 
-func (p *CreateRequest) GetItems() (v []*OrderItemForCreate) {
+func (p *Order) GetItems() (v []*OrderItem) {
 	return p.Items
 }
 
-func (p *CreateRequest) GetExt() (v map[string]string) {
+func (p *Order) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *Order) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+
+func (p *Order) GetExt() (v map[string]string) {
 	return p.Ext
 }
-func (p *CreateRequest) SetType(val int32) {
+
+func (p *Order) GetStatusHistory() (v []*StatusRecord) {
+	return p.StatusHistory
+}
+
+func (p *Order) GetVersion() (v int64) {
+	return p.Version
+}
+
+func (p *Order) GetTotalAmount() (v int64) {
+	return p.TotalAmount
+}
+
+func (p *Order) GetPayDeadline() (v int64) {
+	return p.PayDeadline
+}
+
+func (p *Order) GetRefunds() (v []*Refund) {
+	return p.Refunds
+}
+
+func (p *Order) GetRefundedAmount() (v int64) {
+	return p.RefundedAmount
+}
+
+func (p *Order) GetIdempotencyKey() (v string) {
+	return p.IdempotencyKey
+}
+
+func (p *Order) GetRequestDigest() (v string) {
+	return p.RequestDigest
+}
+
+func (p *Order) GetParentId() (v string) {
+	return p.ParentId
+}
+
+func (p *Order) GetShipments() (v []*Shipment) {
+	return p.Shipments
+}
+
+func (p *Order) GetAutoConfirmAt() (v int64) {
+	return p.AutoConfirmAt
+}
+
+var Order_ShippingAddress_DEFAULT *ShippingAddress
+
+func (p *Order) GetShippingAddress() (v *ShippingAddress) {
+	if !p.IsSetShippingAddress() {
+		return Order_ShippingAddress_DEFAULT
+	}
+	return p.ShippingAddress
+}
+func (p *Order) SetId(val string) {
+	p.Id = val
+}
+func (p *Order) SetType(val int32) {
 	p.Type = val
 }
-func (p *CreateRequest) SetStatus(val int32) {
-	p.Status = val
+func (p *Order) SetStatus(val int32) {
+	p.Status = val
+}
+func (p *Order) SetReqUserId(val int64) {
+	p.ReqUserId = val
+}
+func (p *Order) SetRespUserId(val int64) {
+	p.RespUserId = val
+}
+func (p *Order) SetItems(val []*OrderItem) {
+	p.Items = val
+}
+func (p *Order) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+func (p *Order) SetUpdatedAt(val int64) {
+	p.UpdatedAt = val
+}
+func (p *Order) SetExt(val map[string]string) {
+	p.Ext = val
+}
+func (p *Order) SetStatusHistory(val []*StatusRecord) {
+	p.StatusHistory = val
+}
+func (p *Order) SetVersion(val int64) {
+	p.Version = val
+}
+func (p *Order) SetTotalAmount(val int64) {
+	p.TotalAmount = val
+}
+func (p *Order) SetPayDeadline(val int64) {
+	p.PayDeadline = val
+}
+func (p *Order) SetRefunds(val []*Refund) {
+	p.Refunds = val
+}
+func (p *Order) SetRefundedAmount(val int64) {
+	p.RefundedAmount = val
+}
+func (p *Order) SetIdempotencyKey(val string) {
+	p.IdempotencyKey = val
+}
+func (p *Order) SetRequestDigest(val string) {
+	p.RequestDigest = val
+}
+func (p *Order) SetParentId(val string) {
+	p.ParentId = val
+}
+func (p *Order) SetShipments(val []*Shipment) {
+	p.Shipments = val
+}
+func (p *Order) SetAutoConfirmAt(val int64) {
+	p.AutoConfirmAt = val
+}
+func (p *Order) SetShippingAddress(val *ShippingAddress) {
+	p.ShippingAddress = val
+}
+
+func (p *Order) IsSetShippingAddress() bool {
+	return p.ShippingAddress != nil
+}
+
+func (p *Order) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Order(%+v)", *p)
+}
+
+var fieldIDToName_Order = map[int16]string{
+	1:  "id",
+	2:  "type",
+	3:  "status",
+	4:  "req_user_id",
+	5:  "resp_user_id",
+	6:  "items",
+	7:  "created_at",
+	8:  "updated_at",
+	9:  "ext",
+	10: "status_history",
+	11: "version",
+	12: "total_amount",
+	13: "pay_deadline",
+	14: "refunds",
+	15: "refunded_amount",
+	16: "idempotency_key",
+	17: "request_digest",
+	18: "parent_id",
+	19: "shipments",
+	20: "auto_confirm_at",
+	21: "shipping_address",
+}
+
+type OrderItemForCreate struct {
+	ProductId int64             `thrift:"product_id,1" frugal:"1,default,i64" json:"product_id"`
+	SkuId     int64             `thrift:"sku_id,2" frugal:"2,default,i64" json:"sku_id"`
+	Count     int64             `thrift:"count,3" frugal:"3,default,i64" json:"count"`
+	Price     int64             `thrift:"price,4" frugal:"4,default,i64" json:"price"`
+	Ext       map[string]string `thrift:"ext,5" frugal:"5,default,map<string:string>" json:"ext"`
+}
+
+func NewOrderItemForCreate() *OrderItemForCreate {
+	return &OrderItemForCreate{}
+}
+
+func (p *OrderItemForCreate) InitDefault() {
+}
+
+func (p *OrderItemForCreate) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *OrderItemForCreate) GetSkuId() (v int64) {
+	return p.SkuId
+}
+
+func (p *OrderItemForCreate) GetCount() (v int64) {
+	return p.Count
+}
+
+func (p *OrderItemForCreate) GetPrice() (v int64) {
+	return p.Price
+}
+
+func (p *OrderItemForCreate) GetExt() (v map[string]string) {
+	return p.Ext
+}
+func (p *OrderItemForCreate) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *OrderItemForCreate) SetSkuId(val int64) {
+	p.SkuId = val
+}
+func (p *OrderItemForCreate) SetCount(val int64) {
+	p.Count = val
+}
+func (p *OrderItemForCreate) SetPrice(val int64) {
+	p.Price = val
+}
+func (p *OrderItemForCreate) SetExt(val map[string]string) {
+	p.Ext = val
+}
+
+func (p *OrderItemForCreate) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderItemForCreate(%+v)", *p)
+}
+
+var fieldIDToName_OrderItemForCreate = map[int16]string{
+	1: "product_id",
+	2: "sku_id",
+	3: "count",
+	4: "price",
+	5: "ext",
+}
+
+type CreateRequest struct {
+	Type           int32                 `thrift:"type,1" frugal:"1,default,i32" json:"type"`
+	Status         int32                 `thrift:"status,2" frugal:"2,default,i32" json:"status"`
+	ReqUserId      int64                 `thrift:"req_user_id,3" frugal:"3,default,i64" json:"req_user_id"`
+	RespUserId     int64                 `thrift:"resp_user_id,4" frugal:"4,default,i64" json:"resp_user_id"`
+	Items          []*OrderItemForCreate `thrift:"items,5" frugal:"5,default,list<OrderItemForCreate>" json:"items"`
+	Ext            map[string]string     `thrift:"ext,6" frugal:"6,default,map<string:string>" json:"ext"`
+	IdempotencyKey *string               `thrift:"idempotency_key,7,optional" frugal:"7,optional,string" json:"idempotency_key,omitempty"`
+	AddressId      *int64                `thrift:"address_id,8,optional" frugal:"8,optional,i64" json:"address_id,omitempty"`
+}
+
+func NewCreateRequest() *CreateRequest {
+	return &CreateRequest{}
+}
+
+func (p *CreateRequest) InitDefault() {
+}
+
+func (p *CreateRequest) GetType() (v int32) {
+	return p.Type
+}
+
+func (p *CreateRequest) GetStatus() (v int32) {
+	return p.Status
+}
+
+func (p *CreateRequest) GetReqUserId() (v int64) {
+	return p.ReqUserId
+}
+
+func (p *CreateRequest) GetRespUserId() (v int64) {
+	return p.RespUserId
+}
+
+func (p *CreateRequest) GetItems() (v []*OrderItemForCreate) {
+	return p.Items
+}
+
+func (p *CreateRequest) GetExt() (v map[string]string) {
+	return p.Ext
+}
+
+var CreateRequest_IdempotencyKey_DEFAULT string
+
+func (p *CreateRequest) GetIdempotencyKey() (v string) {
+	if !p.IsSetIdempotencyKey() {
+		return CreateRequest_IdempotencyKey_DEFAULT
+	}
+	return *p.IdempotencyKey
+}
+
+var CreateRequest_AddressId_DEFAULT int64
+
+func (p *CreateRequest) GetAddressId() (v int64) {
+	if !p.IsSetAddressId() {
+		return CreateRequest_AddressId_DEFAULT
+	}
+	return *p.AddressId
+}
+func (p *CreateRequest) SetType(val int32) {
+	p.Type = val
+}
+func (p *CreateRequest) SetStatus(val int32) {
+	p.Status = val
+}
+func (p *CreateRequest) SetReqUserId(val int64) {
+	p.ReqUserId = val
+}
+func (p *CreateRequest) SetRespUserId(val int64) {
+	p.RespUserId = val
+}
+func (p *CreateRequest) SetItems(val []*OrderItemForCreate) {
+	p.Items = val
+}
+func (p *CreateRequest) SetExt(val map[string]string) {
+	p.Ext = val
+}
+func (p *CreateRequest) SetIdempotencyKey(val *string) {
+	p.IdempotencyKey = val
+}
+func (p *CreateRequest) SetAddressId(val *int64) {
+	p.AddressId = val
+}
+
+func (p *CreateRequest) IsSetIdempotencyKey() bool {
+	return p.IdempotencyKey != nil
+}
+
+func (p *CreateRequest) IsSetAddressId() bool {
+	return p.AddressId != nil
+}
+
+func (p *CreateRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateRequest(%+v)", *p)
+}
+
+var fieldIDToName_CreateRequest = map[int16]string{
+	1: "type",
+	2: "status",
+	3: "req_user_id",
+	4: "resp_user_id",
+	5: "items",
+	6: "ext",
+	7: "idempotency_key",
+	8: "address_id",
+}
+
+type CreateResponse struct {
+	BaseResp *base.BaseResponse `thrift:"baseResp,1" frugal:"1,default,base.BaseResponse" json:"baseResp"`
+	OrderId  string             `thrift:"order_id,2" frugal:"2,default,string" json:"order_id"`
+}
+
+func NewCreateResponse() *CreateResponse {
+	return &CreateResponse{}
+}
+
+func (p *CreateResponse) InitDefault() {
+}
+
+var CreateResponse_BaseResp_DEFAULT *base.BaseResponse
+
+func (p *CreateResponse) GetBaseResp() (v *base.BaseResponse) {
+	if !p.IsSetBaseResp() {
+		return CreateResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *CreateResponse) GetOrderId() (v string) {
+	return p.OrderId
+}
+func (p *CreateResponse) SetBaseResp(val *base.BaseResponse) {
+	p.BaseResp = val
+}
+func (p *CreateResponse) SetOrderId(val string) {
+	p.OrderId = val
+}
+
+func (p *CreateResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CreateResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateResponse(%+v)", *p)
+}
+
+var fieldIDToName_CreateResponse = map[int16]string{
+	1: "baseResp",
+	2: "order_id",
+}
+
+type UpdateRequest struct {
+	Id              string            `thrift:"id,1" frugal:"1,default,string" json:"id"`
+	Status          *int32            `thrift:"status,2,optional" frugal:"2,optional,i32" json:"status,omitempty"`
+	Ext             map[string]string `thrift:"ext,3,optional" frugal:"3,optional,map<string:string>" json:"ext,omitempty"`
+	ExpectedVersion *int64            `thrift:"expected_version,4,optional" frugal:"4,optional,i64" json:"expected_version,omitempty"`
+	ExtMode         ExtUpdateMode     `thrift:"ext_mode,5,optional" frugal:"5,optional,ExtUpdateMode" json:"ext_mode,omitempty"`
+	ExtDeleteKeys   []string          `thrift:"ext_delete_keys,6,optional" frugal:"6,optional,list<string>" json:"ext_delete_keys,omitempty"`
+}
+
+func NewUpdateRequest() *UpdateRequest {
+	return &UpdateRequest{
+		ExtMode: ExtUpdateMode_MERGE,
+	}
+}
+
+func (p *UpdateRequest) InitDefault() {
+	p.ExtMode = ExtUpdateMode_MERGE
+}
+
+func (p *UpdateRequest) GetId() (v string) {
+	return p.Id
+}
+
+var UpdateRequest_Status_DEFAULT int32
+
+func (p *UpdateRequest) GetStatus() (v int32) {
+	if !p.IsSetStatus() {
+		return UpdateRequest_Status_DEFAULT
+	}
+	return *p.Status
+}
+
+var UpdateRequest_Ext_DEFAULT map[string]string
+
+func (p *UpdateRequest) GetExt() (v map[string]string) {
+	if !p.IsSetExt() {
+		return UpdateRequest_Ext_DEFAULT
+	}
+	return p.Ext
+}
+
+var UpdateRequest_ExpectedVersion_DEFAULT int64
+
+func (p *UpdateRequest) GetExpectedVersion() (v int64) {
+	if !p.IsSetExpectedVersion() {
+		return UpdateRequest_ExpectedVersion_DEFAULT
+	}
+	return *p.ExpectedVersion
+}
+
+var UpdateRequest_ExtMode_DEFAULT ExtUpdateMode = ExtUpdateMode_MERGE
+
+func (p *UpdateRequest) GetExtMode() (v ExtUpdateMode) {
+	if !p.IsSetExtMode() {
+		return UpdateRequest_ExtMode_DEFAULT
+	}
+	return p.ExtMode
+}
+
+var UpdateRequest_ExtDeleteKeys_DEFAULT []string
+
+func (p *UpdateRequest) GetExtDeleteKeys() (v []string) {
+	if !p.IsSetExtDeleteKeys() {
+		return UpdateRequest_ExtDeleteKeys_DEFAULT
+	}
+	return p.ExtDeleteKeys
+}
+func (p *UpdateRequest) SetId(val string) {
+	p.Id = val
+}
+func (p *UpdateRequest) SetStatus(val *int32) {
+	p.Status = val
+}
+func (p *UpdateRequest) SetExt(val map[string]string) {
+	p.Ext = val
+}
+func (p *UpdateRequest) SetExpectedVersion(val *int64) {
+	p.ExpectedVersion = val
+}
+func (p *UpdateRequest) SetExtMode(val ExtUpdateMode) {
+	p.ExtMode = val
+}
+func (p *UpdateRequest) SetExtDeleteKeys(val []string) {
+	p.ExtDeleteKeys = val
+}
+
+func (p *UpdateRequest) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *UpdateRequest) IsSetExt() bool {
+	return p.Ext != nil
+}
+
+func (p *UpdateRequest) IsSetExpectedVersion() bool {
+	return p.ExpectedVersion != nil
+}
+
+func (p *UpdateRequest) IsSetExtMode() bool {
+	return p.ExtMode != UpdateRequest_ExtMode_DEFAULT
+}
+
+func (p *UpdateRequest) IsSetExtDeleteKeys() bool {
+	return p.ExtDeleteKeys != nil
+}
+
+func (p *UpdateRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateRequest(%+v)", *p)
+}
+
+var fieldIDToName_UpdateRequest = map[int16]string{
+	1: "id",
+	2: "status",
+	3: "ext",
+	4: "expected_version",
+	5: "ext_mode",
+	6: "ext_delete_keys",
+}
+
+type UpdateResponse struct {
+	BaseResp *base.BaseResponse `thrift:"baseResp,1" frugal:"1,default,base.BaseResponse" json:"baseResp"`
+}
+
+func NewUpdateResponse() *UpdateResponse {
+	return &UpdateResponse{}
+}
+
+func (p *UpdateResponse) InitDefault() {
+}
+
+var UpdateResponse_BaseResp_DEFAULT *base.BaseResponse
+
+func (p *UpdateResponse) GetBaseResp() (v *base.BaseResponse) {
+	if !p.IsSetBaseResp() {
+		return UpdateResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *UpdateResponse) SetBaseResp(val *base.BaseResponse) {
+	p.BaseResp = val
+}
+
+func (p *UpdateResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *UpdateResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateResponse(%+v)", *p)
+}
+
+var fieldIDToName_UpdateResponse = map[int16]string{
+	1: "baseResp",
+}
+
+type QueryOrderInfoRequest struct {
+	Id string `thrift:"id,1" frugal:"1,default,string" json:"id"`
+}
+
+func NewQueryOrderInfoRequest() *QueryOrderInfoRequest {
+	return &QueryOrderInfoRequest{}
+}
+
+func (p *QueryOrderInfoRequest) InitDefault() {
+}
+
+func (p *QueryOrderInfoRequest) GetId() (v string) {
+	return p.Id
+}
+func (p *QueryOrderInfoRequest) SetId(val string) {
+	p.Id = val
+}
+
+func (p *QueryOrderInfoRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryOrderInfoRequest(%+v)", *p)
+}
+
+var fieldIDToName_QueryOrderInfoRequest = map[int16]string{
+	1: "id",
+}
+
+type QueryOrderInfoResponse struct {
+	BaseResp *base.BaseResponse `thrift:"baseResp,1" frugal:"1,default,base.BaseResponse" json:"baseResp"`
+	Order    *Order             `thrift:"order,2" frugal:"2,default,Order" json:"order"`
+}
+
+func NewQueryOrderInfoResponse() *QueryOrderInfoResponse {
+	return &QueryOrderInfoResponse{}
+}
+
+func (p *QueryOrderInfoResponse) InitDefault() {
+}
+
+var QueryOrderInfoResponse_BaseResp_DEFAULT *base.BaseResponse
+
+func (p *QueryOrderInfoResponse) GetBaseResp() (v *base.BaseResponse) {
+	if !p.IsSetBaseResp() {
+		return QueryOrderInfoResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var QueryOrderInfoResponse_Order_DEFAULT *Order
+
+func (p *QueryOrderInfoResponse) GetOrder() (v *Order) {
+	if !p.IsSetOrder() {
+		return QueryOrderInfoResponse_Order_DEFAULT
+	}
+	return p.Order
+}
+func (p *QueryOrderInfoResponse) SetBaseResp(val *base.BaseResponse) {
+	p.BaseResp = val
+}
+func (p *QueryOrderInfoResponse) SetOrder(val *Order) {
+	p.Order = val
+}
+
+func (p *QueryOrderInfoResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *QueryOrderInfoResponse) IsSetOrder() bool {
+	return p.Order != nil
+}
+
+func (p *QueryOrderInfoResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryOrderInfoResponse(%+v)", *p)
+}
+
+var fieldIDToName_QueryOrderInfoResponse = map[int16]string{
+	1: "baseResp",
+	2: "order",
+}
+
+type QueryOrderIdRequest struct {
+	Type     QueryOrderIdType `thrift:"type,1" frugal:"1,default,QueryOrderIdType" json:"type"`
+	UserId   *int64           `thrift:"user_id,2,optional" frugal:"2,optional,i64" json:"user_id,omitempty"`
+	ExtKey   *string          `thrift:"ext_key,3,optional" frugal:"3,optional,string" json:"ext_key,omitempty"`
+	ExtVal   *string          `thrift:"ext_val,4,optional" frugal:"4,optional,string" json:"ext_val,omitempty"`
+	Page     int32            `thrift:"page,5,optional" frugal:"5,optional,i32" json:"page,omitempty"`
+	PageSize int32            `thrift:"page_size,6,optional" frugal:"6,optional,i32" json:"page_size,omitempty"`
+}
+
+func NewQueryOrderIdRequest() *QueryOrderIdRequest {
+	return &QueryOrderIdRequest{
+		Page:     1,
+		PageSize: 20,
+	}
+}
+
+func (p *QueryOrderIdRequest) InitDefault() {
+	p.Page = 1
+	p.PageSize = 20
+}
+
+func (p *QueryOrderIdRequest) GetType() (v QueryOrderIdType) {
+	return p.Type
+}
+
+var QueryOrderIdRequest_UserId_DEFAULT int64
+
+func (p *QueryOrderIdRequest) GetUserId() (v int64) {
+	if !p.IsSetUserId() {
+		return QueryOrderIdRequest_UserId_DEFAULT
+	}
+	return *p.UserId
+}
+
+var QueryOrderIdRequest_ExtKey_DEFAULT string
+
+func (p *QueryOrderIdRequest) GetExtKey() (v string) {
+	if !p.IsSetExtKey() {
+		return QueryOrderIdRequest_ExtKey_DEFAULT
+	}
+	return *p.ExtKey
+}
+
+var QueryOrderIdRequest_ExtVal_DEFAULT string
+
+func (p *QueryOrderIdRequest) GetExtVal() (v string) {
+	if !p.IsSetExtVal() {
+		return QueryOrderIdRequest_ExtVal_DEFAULT
+	}
+	return *p.ExtVal
+}
+
+var QueryOrderIdRequest_Page_DEFAULT int32 = 1
+
+func (p *QueryOrderIdRequest) GetPage() (v int32) {
+	if !p.IsSetPage() {
+		return QueryOrderIdRequest_Page_DEFAULT
+	}
+	return p.Page
+}
+
+var QueryOrderIdRequest_PageSize_DEFAULT int32 = 20
+
+func (p *QueryOrderIdRequest) GetPageSize() (v int32) {
+	if !p.IsSetPageSize() {
+		return QueryOrderIdRequest_PageSize_DEFAULT
+	}
+	return p.PageSize
+}
+func (p *QueryOrderIdRequest) SetType(val QueryOrderIdType) {
+	p.Type = val
+}
+func (p *QueryOrderIdRequest) SetUserId(val *int64) {
+	p.UserId = val
+}
+func (p *QueryOrderIdRequest) SetExtKey(val *string) {
+	p.ExtKey = val
+}
+func (p *QueryOrderIdRequest) SetExtVal(val *string) {
+	p.ExtVal = val
+}
+func (p *QueryOrderIdRequest) SetPage(val int32) {
+	p.Page = val
+}
+func (p *QueryOrderIdRequest) SetPageSize(val int32) {
+	p.PageSize = val
+}
+
+func (p *QueryOrderIdRequest) IsSetUserId() bool {
+	return p.UserId != nil
+}
+
+func (p *QueryOrderIdRequest) IsSetExtKey() bool {
+	return p.ExtKey != nil
+}
+
+func (p *QueryOrderIdRequest) IsSetExtVal() bool {
+	return p.ExtVal != nil
+}
+
+func (p *QueryOrderIdRequest) IsSetPage() bool {
+	return p.Page != QueryOrderIdRequest_Page_DEFAULT
+}
+
+func (p *QueryOrderIdRequest) IsSetPageSize() bool {
+	return p.PageSize != QueryOrderIdRequest_PageSize_DEFAULT
+}
+
+func (p *QueryOrderIdRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryOrderIdRequest(%+v)", *p)
+}
+
+var fieldIDToName_QueryOrderIdRequest = map[int16]string{
+	1: "type",
+	2: "user_id",
+	3: "ext_key",
+	4: "ext_val",
+	5: "page",
+	6: "page_size",
+}
+
+type QueryOrderIdResponse struct {
+	BaseResp *base.BaseResponse `thrift:"baseResp,1" frugal:"1,default,base.BaseResponse" json:"baseResp"`
+	OrderId  []string           `thrift:"order_id,2" frugal:"2,default,list<string>" json:"order_id"`
+	Total    int32              `thrift:"total,3" frugal:"3,default,i32" json:"total"`
+	Page     int32              `thrift:"page,4" frugal:"4,default,i32" json:"page"`
+	PageSize int32              `thrift:"page_size,5" frugal:"5,default,i32" json:"page_size"`
+}
+
+func NewQueryOrderIdResponse() *QueryOrderIdResponse {
+	return &QueryOrderIdResponse{}
+}
+
+func (p *QueryOrderIdResponse) InitDefault() {
+}
+
+var QueryOrderIdResponse_BaseResp_DEFAULT *base.BaseResponse
+
+func (p *QueryOrderIdResponse) GetBaseResp() (v *base.BaseResponse) {
+	if !p.IsSetBaseResp() {
+		return QueryOrderIdResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *QueryOrderIdResponse) GetOrderId() (v []string) {
+	return p.OrderId
+}
+
+func (p *QueryOrderIdResponse) GetTotal() (v int32) {
+	return p.Total
+}
+
+func (p *QueryOrderIdResponse) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *QueryOrderIdResponse) GetPageSize() (v int32) {
+	return p.PageSize
+}
+func (p *QueryOrderIdResponse) SetBaseResp(val *base.BaseResponse) {
+	p.BaseResp = val
+}
+func (p *QueryOrderIdResponse) SetOrderId(val []string) {
+	p.OrderId = val
+}
+func (p *QueryOrderIdResponse) SetTotal(val int32) {
+	p.Total = val
+}
+func (p *QueryOrderIdResponse) SetPage(val int32) {
+	p.Page = val
+}
+func (p *QueryOrderIdResponse) SetPageSize(val int32) {
+	p.PageSize = val
+}
+
+func (p *QueryOrderIdResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *QueryOrderIdResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryOrderIdResponse(%+v)", *p)
+}
+
+var fieldIDToName_QueryOrderIdResponse = map[int16]string{
+	1: "baseResp",
+	2: "order_id",
+	3: "total",
+	4: "page",
+	5: "page_size",
+}
+
+type BatchQueryOrderInfoRequest struct {
+	Ids    []string `thrift:"ids,1" frugal:"1,default,list<string>" json:"ids"`
+	Fields []string `thrift:"fields,2,optional" frugal:"2,optional,list<string>" json:"fields,omitempty"`
+}
+
+func NewBatchQueryOrderInfoRequest() *BatchQueryOrderInfoRequest {
+	return &BatchQueryOrderInfoRequest{}
+}
+
+func (p *BatchQueryOrderInfoRequest) InitDefault() {
+}
+
+func (p *BatchQueryOrderInfoRequest) GetIds() (v []string) {
+	return p.Ids
+}
+
+var BatchQueryOrderInfoRequest_Fields_DEFAULT []string
+
+func (p *BatchQueryOrderInfoRequest) GetFields() (v []string) {
+	if !p.IsSetFields() {
+		return BatchQueryOrderInfoRequest_Fields_DEFAULT
+	}
+	return p.Fields
+}
+func (p *BatchQueryOrderInfoRequest) SetIds(val []string) {
+	p.Ids = val
+}
+func (p *BatchQueryOrderInfoRequest) SetFields(val []string) {
+	p.Fields = val
+}
+
+func (p *BatchQueryOrderInfoRequest) IsSetFields() bool {
+	return p.Fields != nil
+}
+
+func (p *BatchQueryOrderInfoRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchQueryOrderInfoRequest(%+v)", *p)
+}
+
+var fieldIDToName_BatchQueryOrderInfoRequest = map[int16]string{
+	1: "ids",
+	2: "fields",
+}
+
+type BatchQueryOrderInfoResponse struct {
+	BaseResp    *base.BaseResponse `thrift:"baseResp,1" frugal:"1,default,base.BaseResponse" json:"baseResp"`
+	Orders      []*Order           `thrift:"orders,2" frugal:"2,default,list<Order>" json:"orders"`
+	NotFoundIds []string           `thrift:"not_found_ids,3" frugal:"3,default,list<string>" json:"not_found_ids"`
+}
+
+func NewBatchQueryOrderInfoResponse() *BatchQueryOrderInfoResponse {
+	return &BatchQueryOrderInfoResponse{}
+}
+
+func (p *BatchQueryOrderInfoResponse) InitDefault() {
+}
+
+var BatchQueryOrderInfoResponse_BaseResp_DEFAULT *base.BaseResponse
+
+func (p *BatchQueryOrderInfoResponse) GetBaseResp() (v *base.BaseResponse) {
+	if !p.IsSetBaseResp() {
+		return BatchQueryOrderInfoResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *BatchQueryOrderInfoResponse) GetOrders() (v []*Order) {
+	return p.Orders
+}
+
+func (p *BatchQueryOrderInfoResponse) GetNotFoundIds() (v []string) {
+	return p.NotFoundIds
+}
+func (p *BatchQueryOrderInfoResponse) SetBaseResp(val *base.BaseResponse) {
+	p.BaseResp = val
+}
+func (p *BatchQueryOrderInfoResponse) SetOrders(val []*Order) {
+	p.Orders = val
+}
+func (p *BatchQueryOrderInfoResponse) SetNotFoundIds(val []string) {
+	p.NotFoundIds = val
+}
+
+func (p *BatchQueryOrderInfoResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *BatchQueryOrderInfoResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchQueryOrderInfoResponse(%+v)", *p)
+}
+
+var fieldIDToName_BatchQueryOrderInfoResponse = map[int16]string{
+	1: "baseResp",
+	2: "orders",
+	3: "not_found_ids",
+}
+
+type RefundItemForRequest struct {
+	ItemId int64 `thrift:"item_id,1" frugal:"1,default,i64" json:"item_id"`
+	Count  int64 `thrift:"count,2" frugal:"2,default,i64" json:"count"`
+}
+
+func NewRefundItemForRequest() *RefundItemForRequest {
+	return &RefundItemForRequest{}
+}
+
+func (p *RefundItemForRequest) InitDefault() {
+}
+
+func (p *RefundItemForRequest) GetItemId() (v int64) {
+	return p.ItemId
+}
+
+func (p *RefundItemForRequest) GetCount() (v int64) {
+	return p.Count
+}
+func (p *RefundItemForRequest) SetItemId(val int64) {
+	p.ItemId = val
+}
+func (p *RefundItemForRequest) SetCount(val int64) {
+	p.Count = val
+}
+
+func (p *RefundItemForRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RefundItemForRequest(%+v)", *p)
+}
+
+var fieldIDToName_RefundItemForRequest = map[int16]string{
+	1: "item_id",
+	2: "count",
+}
+
+type RequestRefundRequest struct {
+	OrderId   string                  `thrift:"order_id,1" frugal:"1,default,string" json:"order_id"`
+	ReqUserId int64                   `thrift:"req_user_id,2" frugal:"2,default,i64" json:"req_user_id"`
+	Items     []*RefundItemForRequest `thrift:"items,3" frugal:"3,default,list<RefundItemForRequest>" json:"items"`
+	Amount    *int64                  `thrift:"amount,4,optional" frugal:"4,optional,i64" json:"amount,omitempty"`
+	Reason    string                  `thrift:"reason,5" frugal:"5,default,string" json:"reason"`
+}
+
+func NewRequestRefundRequest() *RequestRefundRequest {
+	return &RequestRefundRequest{}
+}
+
+func (p *RequestRefundRequest) InitDefault() {
+}
+
+func (p *RequestRefundRequest) GetOrderId() (v string) {
+	return p.OrderId
+}
+
+func (p *RequestRefundRequest) GetReqUserId() (v int64) {
+	return p.ReqUserId
+}
+
+func (p *RequestRefundRequest) GetItems() (v []*RefundItemForRequest) {
+	return p.Items
+}
+
+var RequestRefundRequest_Amount_DEFAULT int64
+
+func (p *RequestRefundRequest) GetAmount() (v int64) {
+	if !p.IsSetAmount() {
+		return RequestRefundRequest_Amount_DEFAULT
+	}
+	return *p.Amount
+}
+
+func (p *RequestRefundRequest) GetReason() (v string) {
+	return p.Reason
+}
+func (p *RequestRefundRequest) SetOrderId(val string) {
+	p.OrderId = val
+}
+func (p *RequestRefundRequest) SetReqUserId(val int64) {
+	p.ReqUserId = val
+}
+func (p *RequestRefundRequest) SetItems(val []*RefundItemForRequest) {
+	p.Items = val
+}
+func (p *RequestRefundRequest) SetAmount(val *int64) {
+	p.Amount = val
+}
+func (p *RequestRefundRequest) SetReason(val string) {
+	p.Reason = val
+}
+
+func (p *RequestRefundRequest) IsSetAmount() bool {
+	return p.Amount != nil
+}
+
+func (p *RequestRefundRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RequestRefundRequest(%+v)", *p)
+}
+
+var fieldIDToName_RequestRefundRequest = map[int16]string{
+	1: "order_id",
+	2: "req_user_id",
+	3: "items",
+	4: "amount",
+	5: "reason",
+}
+
+type RequestRefundResponse struct {
+	BaseResp *base.BaseResponse `thrift:"baseResp,1" frugal:"1,default,base.BaseResponse" json:"baseResp"`
+	RefundId int64              `thrift:"refund_id,2" frugal:"2,default,i64" json:"refund_id"`
+}
+
+func NewRequestRefundResponse() *RequestRefundResponse {
+	return &RequestRefundResponse{}
+}
+
+func (p *RequestRefundResponse) InitDefault() {
+}
+
+var RequestRefundResponse_BaseResp_DEFAULT *base.BaseResponse
+
+func (p *RequestRefundResponse) GetBaseResp() (v *base.BaseResponse) {
+	if !p.IsSetBaseResp() {
+		return RequestRefundResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *RequestRefundResponse) GetRefundId() (v int64) {
+	return p.RefundId
+}
+func (p *RequestRefundResponse) SetBaseResp(val *base.BaseResponse) {
+	p.BaseResp = val
+}
+func (p *RequestRefundResponse) SetRefundId(val int64) {
+	p.RefundId = val
+}
+
+func (p *RequestRefundResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *RequestRefundResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RequestRefundResponse(%+v)", *p)
+}
+
+var fieldIDToName_RequestRefundResponse = map[int16]string{
+	1: "baseResp",
+	2: "refund_id",
+}
+
+type ReviewRefundRequest struct {
+	OrderId      string  `thrift:"order_id,1" frugal:"1,default,string" json:"order_id"`
+	RefundId     int64   `thrift:"refund_id,2" frugal:"2,default,i64" json:"refund_id"`
+	RespUserId   int64   `thrift:"resp_user_id,3" frugal:"3,default,i64" json:"resp_user_id"`
+	Approve      bool    `thrift:"approve,4" frugal:"4,default,bool" json:"approve"`
+	RejectReason *string `thrift:"reject_reason,5,optional" frugal:"5,optional,string" json:"reject_reason,omitempty"`
+}
+
+func NewReviewRefundRequest() *ReviewRefundRequest {
+	return &ReviewRefundRequest{}
+}
+
+func (p *ReviewRefundRequest) InitDefault() {
+}
+
+func (p *ReviewRefundRequest) GetOrderId() (v string) {
+	return p.OrderId
+}
+
+func (p *ReviewRefundRequest) GetRefundId() (v int64) {
+	return p.RefundId
+}
+
+func (p *ReviewRefundRequest) GetRespUserId() (v int64) {
+	return p.RespUserId
+}
+
+func (p *ReviewRefundRequest) GetApprove() (v bool) {
+	return p.Approve
+}
+
+var ReviewRefundRequest_RejectReason_DEFAULT string
+
+func (p *ReviewRefundRequest) GetRejectReason() (v string) {
+	if !p.IsSetRejectReason() {
+		return ReviewRefundRequest_RejectReason_DEFAULT
+	}
+	return *p.RejectReason
+}
+func (p *ReviewRefundRequest) SetOrderId(val string) {
+	p.OrderId = val
+}
+func (p *ReviewRefundRequest) SetRefundId(val int64) {
+	p.RefundId = val
+}
+func (p *ReviewRefundRequest) SetRespUserId(val int64) {
+	p.RespUserId = val
+}
+func (p *ReviewRefundRequest) SetApprove(val bool) {
+	p.Approve = val
+}
+func (p *ReviewRefundRequest) SetRejectReason(val *string) {
+	p.RejectReason = val
+}
+
+func (p *ReviewRefundRequest) IsSetRejectReason() bool {
+	return p.RejectReason != nil
+}
+
+func (p *ReviewRefundRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewRefundRequest(%+v)", *p)
+}
+
+var fieldIDToName_ReviewRefundRequest = map[int16]string{
+	1: "order_id",
+	2: "refund_id",
+	3: "resp_user_id",
+	4: "approve",
+	5: "reject_reason",
+}
+
+type ReviewRefundResponse struct {
+	BaseResp *base.BaseResponse `thrift:"baseResp,1" frugal:"1,default,base.BaseResponse" json:"baseResp"`
+	Refund   *Refund            `thrift:"refund,2" frugal:"2,default,Refund" json:"refund"`
+}
+
+func NewReviewRefundResponse() *ReviewRefundResponse {
+	return &ReviewRefundResponse{}
+}
+
+func (p *ReviewRefundResponse) InitDefault() {
+}
+
+var ReviewRefundResponse_BaseResp_DEFAULT *base.BaseResponse
+
+func (p *ReviewRefundResponse) GetBaseResp() (v *base.BaseResponse) {
+	if !p.IsSetBaseResp() {
+		return ReviewRefundResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var ReviewRefundResponse_Refund_DEFAULT *Refund
+
+func (p *ReviewRefundResponse) GetRefund() (v *Refund) {
+	if !p.IsSetRefund() {
+		return ReviewRefundResponse_Refund_DEFAULT
+	}
+	return p.Refund
+}
+func (p *ReviewRefundResponse) SetBaseResp(val *base.BaseResponse) {
+	p.BaseResp = val
+}
+func (p *ReviewRefundResponse) SetRefund(val *Refund) {
+	p.Refund = val
+}
+
+func (p *ReviewRefundResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ReviewRefundResponse) IsSetRefund() bool {
+	return p.Refund != nil
+}
+
+func (p *ReviewRefundResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewRefundResponse(%+v)", *p)
+}
+
+var fieldIDToName_ReviewRefundResponse = map[int16]string{
+	1: "baseResp",
+	2: "refund",
+}
+
+type CompleteRefundRequest struct {
+	OrderId    string `thrift:"order_id,1" frugal:"1,default,string" json:"order_id"`
+	RefundId   int64  `thrift:"refund_id,2" frugal:"2,default,i64" json:"refund_id"`
+	RespUserId int64  `thrift:"resp_user_id,3" frugal:"3,default,i64" json:"resp_user_id"`
+}
+
+func NewCompleteRefundRequest() *CompleteRefundRequest {
+	return &CompleteRefundRequest{}
+}
+
+func (p *CompleteRefundRequest) InitDefault() {
+}
+
+func (p *CompleteRefundRequest) GetOrderId() (v string) {
+	return p.OrderId
+}
+
+func (p *CompleteRefundRequest) GetRefundId() (v int64) {
+	return p.RefundId
+}
+
+func (p *CompleteRefundRequest) GetRespUserId() (v int64) {
+	return p.RespUserId
+}
+func (p *CompleteRefundRequest) SetOrderId(val string) {
+	p.OrderId = val
+}
+func (p *CompleteRefundRequest) SetRefundId(val int64) {
+	p.RefundId = val
+}
+func (p *CompleteRefundRequest) SetRespUserId(val int64) {
+	p.RespUserId = val
+}
+
+func (p *CompleteRefundRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CompleteRefundRequest(%+v)", *p)
+}
+
+var fieldIDToName_CompleteRefundRequest = map[int16]string{
+	1: "order_id",
+	2: "refund_id",
+	3: "resp_user_id",
+}
+
+type CompleteRefundResponse struct {
+	BaseResp *base.BaseResponse `thrift:"baseResp,1" frugal:"1,default,base.BaseResponse" json:"baseResp"`
+}
+
+func NewCompleteRefundResponse() *CompleteRefundResponse {
+	return &CompleteRefundResponse{}
+}
+
+func (p *CompleteRefundResponse) InitDefault() {
+}
+
+var CompleteRefundResponse_BaseResp_DEFAULT *base.BaseResponse
+
+func (p *CompleteRefundResponse) GetBaseResp() (v *base.BaseResponse) {
+	if !p.IsSetBaseResp() {
+		return CompleteRefundResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *CompleteRefundResponse) SetBaseResp(val *base.BaseResponse) {
+	p.BaseResp = val
+}
+
+func (p *CompleteRefundResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CompleteRefundResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CompleteRefundResponse(%+v)", *p)
+}
+
+var fieldIDToName_CompleteRefundResponse = map[int16]string{
+	1: "baseResp",
+}
+
+type QueryRefundsRequest struct {
+	OrderId  string         `thrift:"order_id,1" frugal:"1,default,string" json:"order_id"`
+	Statuses []RefundStatus `thrift:"statuses,2,optional" frugal:"2,optional,list<RefundStatus>" json:"statuses,omitempty"`
+}
+
+func NewQueryRefundsRequest() *QueryRefundsRequest {
+	return &QueryRefundsRequest{}
+}
+
+func (p *QueryRefundsRequest) InitDefault() {
+}
+
+func (p *QueryRefundsRequest) GetOrderId() (v string) {
+	return p.OrderId
+}
+
+var QueryRefundsRequest_Statuses_DEFAULT []RefundStatus
+
+func (p *QueryRefundsRequest) GetStatuses() (v []RefundStatus) {
+	if !p.IsSetStatuses() {
+		return QueryRefundsRequest_Statuses_DEFAULT
+	}
+	return p.Statuses
+}
+func (p *QueryRefundsRequest) SetOrderId(val string) {
+	p.OrderId = val
+}
+func (p *QueryRefundsRequest) SetStatuses(val []RefundStatus) {
+	p.Statuses = val
+}
+
+func (p *QueryRefundsRequest) IsSetStatuses() bool {
+	return p.Statuses != nil
+}
+
+func (p *QueryRefundsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryRefundsRequest(%+v)", *p)
+}
+
+var fieldIDToName_QueryRefundsRequest = map[int16]string{
+	1: "order_id",
+	2: "statuses",
+}
+
+type QueryRefundsResponse struct {
+	BaseResp         *base.BaseResponse `thrift:"baseResp,1" frugal:"1,default,base.BaseResponse" json:"baseResp"`
+	Refunds          []*Refund          `thrift:"refunds,2" frugal:"2,default,list<Refund>" json:"refunds"`
+	RefundedAmount   int64              `thrift:"refunded_amount,3" frugal:"3,default,i64" json:"refunded_amount"`
+	RefundableAmount int64              `thrift:"refundable_amount,4" frugal:"4,default,i64" json:"refundable_amount"`
+}
+
+func NewQueryRefundsResponse() *QueryRefundsResponse {
+	return &QueryRefundsResponse{}
+}
+
+func (p *QueryRefundsResponse) InitDefault() {
+}
+
+var QueryRefundsResponse_BaseResp_DEFAULT *base.BaseResponse
+
+func (p *QueryRefundsResponse) GetBaseResp() (v *base.BaseResponse) {
+	if !p.IsSetBaseResp() {
+		return QueryRefundsResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *QueryRefundsResponse) GetRefunds() (v []*Refund) {
+	return p.Refunds
+}
+
+func (p *QueryRefundsResponse) GetRefundedAmount() (v int64) {
+	return p.RefundedAmount
+}
+
+func (p *QueryRefundsResponse) GetRefundableAmount() (v int64) {
+	return p.RefundableAmount
+}
+func (p *QueryRefundsResponse) SetBaseResp(val *base.BaseResponse) {
+	p.BaseResp = val
+}
+func (p *QueryRefundsResponse) SetRefunds(val []*Refund) {
+	p.Refunds = val
+}
+func (p *QueryRefundsResponse) SetRefundedAmount(val int64) {
+	p.RefundedAmount = val
+}
+func (p *QueryRefundsResponse) SetRefundableAmount(val int64) {
+	p.RefundableAmount = val
+}
+
+func (p *QueryRefundsResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *QueryRefundsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryRefundsResponse(%+v)", *p)
+}
+
+var fieldIDToName_QueryRefundsResponse = map[int16]string{
+	1: "baseResp",
+	2: "refunds",
+	3: "refunded_amount",
+	4: "refundable_amount",
+}
+
+type ShipmentItemForRequest struct {
+	ItemId int64 `thrift:"item_id,1" frugal:"1,default,i64" json:"item_id"`
+	Count  int64 `thrift:"count,2" frugal:"2,default,i64" json:"count"`
+}
+
+func NewShipmentItemForRequest() *ShipmentItemForRequest {
+	return &ShipmentItemForRequest{}
+}
+
+func (p *ShipmentItemForRequest) InitDefault() {
+}
+
+func (p *ShipmentItemForRequest) GetItemId() (v int64) {
+	return p.ItemId
+}
+
+func (p *ShipmentItemForRequest) GetCount() (v int64) {
+	return p.Count
+}
+func (p *ShipmentItemForRequest) SetItemId(val int64) {
+	p.ItemId = val
+}
+func (p *ShipmentItemForRequest) SetCount(val int64) {
+	p.Count = val
+}
+
+func (p *ShipmentItemForRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ShipmentItemForRequest(%+v)", *p)
+}
+
+var fieldIDToName_ShipmentItemForRequest = map[int16]string{
+	1: "item_id",
+	2: "count",
+}
+
+type CreateShipmentRequest struct {
+	OrderId    string                    `thrift:"order_id,1" frugal:"1,default,string" json:"order_id"`
+	RespUserId int64                     `thrift:"resp_user_id,2" frugal:"2,default,i64" json:"resp_user_id"`
+	Carrier    string                    `thrift:"carrier,3" frugal:"3,default,string" json:"carrier"`
+	TrackingNo string                    `thrift:"tracking_no,4" frugal:"4,default,string" json:"tracking_no"`
+	Items      []*ShipmentItemForRequest `thrift:"items,5" frugal:"5,default,list<ShipmentItemForRequest>" json:"items"`
+}
+
+func NewCreateShipmentRequest() *CreateShipmentRequest {
+	return &CreateShipmentRequest{}
+}
+
+func (p *CreateShipmentRequest) InitDefault() {
+}
+
+func (p *CreateShipmentRequest) GetOrderId() (v string) {
+	return p.OrderId
+}
+
+func (p *CreateShipmentRequest) GetRespUserId() (v int64) {
+	return p.RespUserId
+}
+
+func (p *CreateShipmentRequest) GetCarrier() (v string) {
+	return p.Carrier
+}
+
+func (p *CreateShipmentRequest) GetTrackingNo() (v string) {
+	return p.TrackingNo
+}
+
+func (p *CreateShipmentRequest) GetItems() (v []*ShipmentItemForRequest) {
+	return p.Items
+}
+func (p *CreateShipmentRequest) SetOrderId(val string) {
+	p.OrderId = val
+}
+func (p *CreateShipmentRequest) SetRespUserId(val int64) {
+	p.RespUserId = val
+}
+func (p *CreateShipmentRequest) SetCarrier(val string) {
+	p.Carrier = val
+}
+func (p *CreateShipmentRequest) SetTrackingNo(val string) {
+	p.TrackingNo = val
+}
+func (p *CreateShipmentRequest) SetItems(val []*ShipmentItemForRequest) {
+	p.Items = val
+}
+
+func (p *CreateShipmentRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateShipmentRequest(%+v)", *p)
+}
+
+var fieldIDToName_CreateShipmentRequest = map[int16]string{
+	1: "order_id",
+	2: "resp_user_id",
+	3: "carrier",
+	4: "tracking_no",
+	5: "items",
+}
+
+type CreateShipmentResponse struct {
+	BaseResp *base.BaseResponse `thrift:"baseResp,1" frugal:"1,default,base.BaseResponse" json:"baseResp"`
+	Shipment *Shipment          `thrift:"shipment,2" frugal:"2,default,Shipment" json:"shipment"`
+}
+
+func NewCreateShipmentResponse() *CreateShipmentResponse {
+	return &CreateShipmentResponse{}
+}
+
+func (p *CreateShipmentResponse) InitDefault() {
+}
+
+var CreateShipmentResponse_BaseResp_DEFAULT *base.BaseResponse
+
+func (p *CreateShipmentResponse) GetBaseResp() (v *base.BaseResponse) {
+	if !p.IsSetBaseResp() {
+		return CreateShipmentResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var CreateShipmentResponse_Shipment_DEFAULT *Shipment
+
+func (p *CreateShipmentResponse) GetShipment() (v *Shipment) {
+	if !p.IsSetShipment() {
+		return CreateShipmentResponse_Shipment_DEFAULT
+	}
+	return p.Shipment
+}
+func (p *CreateShipmentResponse) SetBaseResp(val *base.BaseResponse) {
+	p.BaseResp = val
+}
+func (p *CreateShipmentResponse) SetShipment(val *Shipment) {
+	p.Shipment = val
+}
+
+func (p *CreateShipmentResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CreateShipmentResponse) IsSetShipment() bool {
+	return p.Shipment != nil
+}
+
+func (p *CreateShipmentResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateShipmentResponse(%+v)", *p)
+}
+
+var fieldIDToName_CreateShipmentResponse = map[int16]string{
+	1: "baseResp",
+	2: "shipment",
+}
+
+type AddTrackingEventRequest struct {
+	OrderId     string  `thrift:"order_id,1" frugal:"1,default,string" json:"order_id"`
+	ShipmentId  int64   `thrift:"shipment_id,2" frugal:"2,default,i64" json:"shipment_id"`
+	RespUserId  int64   `thrift:"resp_user_id,3" frugal:"3,default,i64" json:"resp_user_id"`
+	Description string  `thrift:"description,4" frugal:"4,default,string" json:"description"`
+	Location    *string `thrift:"location,5,optional" frugal:"5,optional,string" json:"location,omitempty"`
+	OccurredAt  *int64  `thrift:"occurred_at,6,optional" frugal:"6,optional,i64" json:"occurred_at,omitempty"`
+	Delivered   *bool   `thrift:"delivered,7,optional" frugal:"7,optional,bool" json:"delivered,omitempty"`
+}
+
+func NewAddTrackingEventRequest() *AddTrackingEventRequest {
+	return &AddTrackingEventRequest{}
+}
+
+func (p *AddTrackingEventRequest) InitDefault() {
+}
+
+func (p *AddTrackingEventRequest) GetOrderId() (v string) {
+	return p.OrderId
+}
+
+func (p *AddTrackingEventRequest) GetShipmentId() (v int64) {
+	return p.ShipmentId
+}
+
+func (p *AddTrackingEventRequest) GetRespUserId() (v int64) {
+	return p.RespUserId
+}
+
+func (p *AddTrackingEventRequest) GetDescription() (v string) {
+	return p.Description
+}
+
+var AddTrackingEventRequest_Location_DEFAULT string
+
+func (p *AddTrackingEventRequest) GetLocation() (v string) {
+	if !p.IsSetLocation() {
+		return AddTrackingEventRequest_Location_DEFAULT
+	}
+	return *p.Location
+}
+
+var AddTrackingEventRequest_OccurredAt_DEFAULT int64
+
+func (p *AddTrackingEventRequest) GetOccurredAt() (v int64) {
+	if !p.IsSetOccurredAt() {
+		return AddTrackingEventRequest_OccurredAt_DEFAULT
+	}
+	return *p.OccurredAt
+}
+
+var AddTrackingEventRequest_Delivered_DEFAULT bool
+
+func (p *AddTrackingEventRequest) GetDelivered() (v bool) {
+	if !p.IsSetDelivered() {
+		return AddTrackingEventRequest_Delivered_DEFAULT
+	}
+	return *p.Delivered
+}
+func (p *AddTrackingEventRequest) SetOrderId(val string) {
+	p.OrderId = val
+}
+func (p *AddTrackingEventRequest) SetShipmentId(val int64) {
+	p.ShipmentId = val
+}
+func (p *AddTrackingEventRequest) SetRespUserId(val int64) {
+	p.RespUserId = val
+}
+func (p *AddTrackingEventRequest) SetDescription(val string) {
+	p.Description = val
+}
+func (p *AddTrackingEventRequest) SetLocation(val *string) {
+	p.Location = val
+}
+func (p *AddTrackingEventRequest) SetOccurredAt(val *int64) {
+	p.OccurredAt = val
+}
+func (p *AddTrackingEventRequest) SetDelivered(val *bool) {
+	p.Delivered = val
+}
+
+func (p *AddTrackingEventRequest) IsSetLocation() bool {
+	return p.Location != nil
+}
+
+func (p *AddTrackingEventRequest) IsSetOccurredAt() bool {
+	return p.OccurredAt != nil
+}
+
+func (p *AddTrackingEventRequest) IsSetDelivered() bool {
+	return p.Delivered != nil
+}
+
+func (p *AddTrackingEventRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AddTrackingEventRequest(%+v)", *p)
+}
+
+var fieldIDToName_AddTrackingEventRequest = map[int16]string{
+	1: "order_id",
+	2: "shipment_id",
+	3: "resp_user_id",
+	4: "description",
+	5: "location",
+	6: "occurred_at",
+	7: "delivered",
+}
+
+type AddTrackingEventResponse struct {
+	BaseResp *base.BaseResponse `thrift:"baseResp,1" frugal:"1,default,base.BaseResponse" json:"baseResp"`
+	Shipment *Shipment          `thrift:"shipment,2" frugal:"2,default,Shipment" json:"shipment"`
+}
+
+func NewAddTrackingEventResponse() *AddTrackingEventResponse {
+	return &AddTrackingEventResponse{}
+}
+
+func (p *AddTrackingEventResponse) InitDefault() {
+}
+
+var AddTrackingEventResponse_BaseResp_DEFAULT *base.BaseResponse
+
+func (p *AddTrackingEventResponse) GetBaseResp() (v *base.BaseResponse) {
+	if !p.IsSetBaseResp() {
+		return AddTrackingEventResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var AddTrackingEventResponse_Shipment_DEFAULT *Shipment
+
+func (p *AddTrackingEventResponse) GetShipment() (v *Shipment) {
+	if !p.IsSetShipment() {
+		return AddTrackingEventResponse_Shipment_DEFAULT
+	}
+	return p.Shipment
+}
+func (p *AddTrackingEventResponse) SetBaseResp(val *base.BaseResponse) {
+	p.BaseResp = val
+}
+func (p *AddTrackingEventResponse) SetShipment(val *Shipment) {
+	p.Shipment = val
+}
+
+func (p *AddTrackingEventResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *AddTrackingEventResponse) IsSetShipment() bool {
+	return p.Shipment != nil
+}
+
+func (p *AddTrackingEventResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AddTrackingEventResponse(%+v)", *p)
+}
+
+var fieldIDToName_AddTrackingEventResponse = map[int16]string{
+	1: "baseResp",
+	2: "shipment",
+}
+
+type ConfirmDeliveryRequest struct {
+	OrderId   string `thrift:"order_id,1" frugal:"1,default,string" json:"order_id"`
+	ReqUserId int64  `thrift:"req_user_id,2" frugal:"2,default,i64" json:"req_user_id"`
+}
+
+func NewConfirmDeliveryRequest() *ConfirmDeliveryRequest {
+	return &ConfirmDeliveryRequest{}
+}
+
+func (p *ConfirmDeliveryRequest) InitDefault() {
+}
+
+func (p *ConfirmDeliveryRequest) GetOrderId() (v string) {
+	return p.OrderId
+}
+
+func (p *ConfirmDeliveryRequest) GetReqUserId() (v int64) {
+	return p.ReqUserId
+}
+func (p *ConfirmDeliveryRequest) SetOrderId(val string) {
+	p.OrderId = val
+}
+func (p *ConfirmDeliveryRequest) SetReqUserId(val int64) {
+	p.ReqUserId = val
+}
+
+func (p *ConfirmDeliveryRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ConfirmDeliveryRequest(%+v)", *p)
+}
+
+var fieldIDToName_ConfirmDeliveryRequest = map[int16]string{
+	1: "order_id",
+	2: "req_user_id",
+}
+
+type ConfirmDeliveryResponse struct {
+	BaseResp *base.BaseResponse `thrift:"baseResp,1" frugal:"1,default,base.BaseResponse" json:"baseResp"`
+}
+
+func NewConfirmDeliveryResponse() *ConfirmDeliveryResponse {
+	return &ConfirmDeliveryResponse{}
+}
+
+func (p *ConfirmDeliveryResponse) InitDefault() {
+}
+
+var ConfirmDeliveryResponse_BaseResp_DEFAULT *base.BaseResponse
+
+func (p *ConfirmDeliveryResponse) GetBaseResp() (v *base.BaseResponse) {
+	if !p.IsSetBaseResp() {
+		return ConfirmDeliveryResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *ConfirmDeliveryResponse) SetBaseResp(val *base.BaseResponse) {
+	p.BaseResp = val
+}
+
+func (p *ConfirmDeliveryResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ConfirmDeliveryResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ConfirmDeliveryResponse(%+v)", *p)
+}
+
+var fieldIDToName_ConfirmDeliveryResponse = map[int16]string{
+	1: "baseResp",
+}
+
+type DecodeIdRequest struct {
+	Id int64 `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+}
+
+func NewDecodeIdRequest() *DecodeIdRequest {
+	return &DecodeIdRequest{}
+}
+
+func (p *DecodeIdRequest) InitDefault() {
+}
+
+func (p *DecodeIdRequest) GetId() (v int64) {
+	return p.Id
+}
+func (p *DecodeIdRequest) SetId(val int64) {
+	p.Id = val
+}
+
+func (p *DecodeIdRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DecodeIdRequest(%+v)", *p)
+}
+
+var fieldIDToName_DecodeIdRequest = map[int16]string{
+	1: "id",
+}
+
+type DecodeIdResponse struct {
+	BaseResp    *base.BaseResponse `thrift:"baseResp,1" frugal:"1,default,base.BaseResponse" json:"baseResp"`
+	TimestampMs int64              `thrift:"timestamp_ms,2" frugal:"2,default,i64" json:"timestamp_ms"`
+	Node        int64              `thrift:"node,3" frugal:"3,default,i64" json:"node"`
+	Sequence    int64              `thrift:"sequence,4" frugal:"4,default,i64" json:"sequence"`
+}
+
+func NewDecodeIdResponse() *DecodeIdResponse {
+	return &DecodeIdResponse{}
+}
+
+func (p *DecodeIdResponse) InitDefault() {
+}
+
+var DecodeIdResponse_BaseResp_DEFAULT *base.BaseResponse
+
+func (p *DecodeIdResponse) GetBaseResp() (v *base.BaseResponse) {
+	if !p.IsSetBaseResp() {
+		return DecodeIdResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *DecodeIdResponse) GetTimestampMs() (v int64) {
+	return p.TimestampMs
+}
+
+func (p *DecodeIdResponse) GetNode() (v int64) {
+	return p.Node
+}
+
+func (p *DecodeIdResponse) GetSequence() (v int64) {
+	return p.Sequence
+}
+func (p *DecodeIdResponse) SetBaseResp(val *base.BaseResponse) {
+	p.BaseResp = val
+}
+func (p *DecodeIdResponse) SetTimestampMs(val int64) {
+	p.TimestampMs = val
+}
+func (p *DecodeIdResponse) SetNode(val int64) {
+	p.Node = val
+}
+func (p *DecodeIdResponse) SetSequence(val int64) {
+	p.Sequence = val
+}
+
+func (p *DecodeIdResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *DecodeIdResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DecodeIdResponse(%+v)", *p)
+}
+
+var fieldIDToName_DecodeIdResponse = map[int16]string{
+	1: "baseResp",
+	2: "timestamp_ms",
+	3: "node",
+	4: "sequence",
+}
+
+type ReportRequest struct {
+	RespUserId  int64             `thrift:"resp_user_id,1" frugal:"1,default,i64" json:"resp_user_id"`
+	From        int64             `thrift:"from,2" frugal:"2,default,i64" json:"from"`
+	To          int64             `thrift:"to,3" frugal:"3,default,i64" json:"to"`
+	Statuses    []int32           `thrift:"statuses,4,optional" frugal:"4,optional,list<i32>" json:"statuses,omitempty"`
+	Granularity ReportGranularity `thrift:"granularity,5,optional" frugal:"5,optional,ReportGranularity" json:"granularity,omitempty"`
+	Timezone    string            `thrift:"timezone,6,optional" frugal:"6,optional,string" json:"timezone,omitempty"`
+	TopN        int32             `thrift:"top_n,7,optional" frugal:"7,optional,i32" json:"top_n,omitempty"`
+}
+
+func NewReportRequest() *ReportRequest {
+	return &ReportRequest{
+		Granularity: ReportGranularity_DAY,
+		Timezone:    "UTC",
+		TopN:        10,
+	}
+}
+
+func (p *ReportRequest) InitDefault() {
+	p.Granularity = ReportGranularity_DAY
+	p.Timezone = "UTC"
+	p.TopN = 10
+}
+
+func (p *ReportRequest) GetRespUserId() (v int64) {
+	return p.RespUserId
+}
+
+func (p *ReportRequest) GetFrom() (v int64) {
+	return p.From
+}
+
+func (p *ReportRequest) GetTo() (v int64) {
+	return p.To
+}
+
+var ReportRequest_Statuses_DEFAULT []int32
+
+func (p *ReportRequest) GetStatuses() (v []int32) {
+	if !p.IsSetStatuses() {
+		return ReportRequest_Statuses_DEFAULT
+	}
+	return p.Statuses
+}
+
+var ReportRequest_Granularity_DEFAULT ReportGranularity = ReportGranularity_DAY
+
+func (p *ReportRequest) GetGranularity() (v ReportGranularity) {
+	if !p.IsSetGranularity() {
+		return ReportRequest_Granularity_DEFAULT
+	}
+	return p.Granularity
+}
+
+var ReportRequest_Timezone_DEFAULT string = "UTC"
+
+func (p *ReportRequest) GetTimezone() (v string) {
+	if !p.IsSetTimezone() {
+		return ReportRequest_Timezone_DEFAULT
+	}
+	return p.Timezone
+}
+
+var ReportRequest_TopN_DEFAULT int32 = 10
+
+func (p *ReportRequest) GetTopN() (v int32) {
+	if !p.IsSetTopN() {
+		return ReportRequest_TopN_DEFAULT
+	}
+	return p.TopN
+}
+func (p *ReportRequest) SetRespUserId(val int64) {
+	p.RespUserId = val
+}
+func (p *ReportRequest) SetFrom(val int64) {
+	p.From = val
+}
+func (p *ReportRequest) SetTo(val int64) {
+	p.To = val
+}
+func (p *ReportRequest) SetStatuses(val []int32) {
+	p.Statuses = val
+}
+func (p *ReportRequest) SetGranularity(val ReportGranularity) {
+	p.Granularity = val
+}
+func (p *ReportRequest) SetTimezone(val string) {
+	p.Timezone = val
+}
+func (p *ReportRequest) SetTopN(val int32) {
+	p.TopN = val
+}
+
+func (p *ReportRequest) IsSetStatuses() bool {
+	return p.Statuses != nil
+}
+
+func (p *ReportRequest) IsSetGranularity() bool {
+	return p.Granularity != ReportRequest_Granularity_DEFAULT
+}
+
+func (p *ReportRequest) IsSetTimezone() bool {
+	return p.Timezone != ReportRequest_Timezone_DEFAULT
+}
+
+func (p *ReportRequest) IsSetTopN() bool {
+	return p.TopN != ReportRequest_TopN_DEFAULT
+}
+
+func (p *ReportRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReportRequest(%+v)", *p)
+}
+
+var fieldIDToName_ReportRequest = map[int16]string{
+	1: "resp_user_id",
+	2: "from",
+	3: "to",
+	4: "statuses",
+	5: "granularity",
+	6: "timezone",
+	7: "top_n",
+}
+
+type ReportBucket struct {
+	Period         string `thrift:"period,1" frugal:"1,default,string" json:"period"`
+	PeriodStart    int64  `thrift:"period_start,2" frugal:"2,default,i64" json:"period_start"`
+	OrderCount     int64  `thrift:"order_count,3" frugal:"3,default,i64" json:"order_count"`
+	Revenue        int64  `thrift:"revenue,4" frugal:"4,default,i64" json:"revenue"`
+	RefundedAmount int64  `thrift:"refunded_amount,5" frugal:"5,default,i64" json:"refunded_amount"`
+}
+
+func NewReportBucket() *ReportBucket {
+	return &ReportBucket{}
+}
+
+func (p *ReportBucket) InitDefault() {
+}
+
+func (p *ReportBucket) GetPeriod() (v string) {
+	return p.Period
+}
+
+func (p *ReportBucket) GetPeriodStart() (v int64) {
+	return p.PeriodStart
+}
+
+func (p *ReportBucket) GetOrderCount() (v int64) {
+	return p.OrderCount
+}
+
+func (p *ReportBucket) GetRevenue() (v int64) {
+	return p.Revenue
+}
+
+func (p *ReportBucket) GetRefundedAmount() (v int64) {
+	return p.RefundedAmount
+}
+func (p *ReportBucket) SetPeriod(val string) {
+	p.Period = val
+}
+func (p *ReportBucket) SetPeriodStart(val int64) {
+	p.PeriodStart = val
+}
+func (p *ReportBucket) SetOrderCount(val int64) {
+	p.OrderCount = val
+}
+func (p *ReportBucket) SetRevenue(val int64) {
+	p.Revenue = val
+}
+func (p *ReportBucket) SetRefundedAmount(val int64) {
+	p.RefundedAmount = val
+}
+
+func (p *ReportBucket) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReportBucket(%+v)", *p)
+}
+
+var fieldIDToName_ReportBucket = map[int16]string{
+	1: "period",
+	2: "period_start",
+	3: "order_count",
+	4: "revenue",
+	5: "refunded_amount",
+}
+
+type SkuSales struct {
+	SkuId      int64 `thrift:"sku_id,1" frugal:"1,default,i64" json:"sku_id"`
+	ProductId  int64 `thrift:"product_id,2" frugal:"2,default,i64" json:"product_id"`
+	Quantity   int64 `thrift:"quantity,3" frugal:"3,default,i64" json:"quantity"`
+	Revenue    int64 `thrift:"revenue,4" frugal:"4,default,i64" json:"revenue"`
+	OrderCount int64 `thrift:"order_count,5" frugal:"5,default,i64" json:"order_count"`
+}
+
+func NewSkuSales() *SkuSales {
+	return &SkuSales{}
+}
+
+func (p *SkuSales) InitDefault() {
+}
+
+func (p *SkuSales) GetSkuId() (v int64) {
+	return p.SkuId
+}
+
+func (p *SkuSales) GetProductId() (v int64) {
+	return p.ProductId
+}
+
+func (p *SkuSales) GetQuantity() (v int64) {
+	return p.Quantity
+}
+
+func (p *SkuSales) GetRevenue() (v int64) {
+	return p.Revenue
+}
+
+func (p *SkuSales) GetOrderCount() (v int64) {
+	return p.OrderCount
+}
+func (p *SkuSales) SetSkuId(val int64) {
+	p.SkuId = val
+}
+func (p *SkuSales) SetProductId(val int64) {
+	p.ProductId = val
+}
+func (p *SkuSales) SetQuantity(val int64) {
+	p.Quantity = val
+}
+func (p *SkuSales) SetRevenue(val int64) {
+	p.Revenue = val
+}
+func (p *SkuSales) SetOrderCount(val int64) {
+	p.OrderCount = val
+}
+
+func (p *SkuSales) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SkuSales(%+v)", *p)
+}
+
+var fieldIDToName_SkuSales = map[int16]string{
+	1: "sku_id",
+	2: "product_id",
+	3: "quantity",
+	4: "revenue",
+	5: "order_count",
+}
+
+type ReportResponse struct {
+	BaseResp            *base.BaseResponse `thrift:"baseResp,1" frugal:"1,default,base.BaseResponse" json:"baseResp"`
+	Buckets             []*ReportBucket    `thrift:"buckets,2" frugal:"2,default,list<ReportBucket>" json:"buckets"`
+	TopSkusByQuantity   []*SkuSales        `thrift:"top_skus_by_quantity,3" frugal:"3,default,list<SkuSales>" json:"top_skus_by_quantity"`
+	TopSkusByRevenue    []*SkuSales        `thrift:"top_skus_by_revenue,4" frugal:"4,default,list<SkuSales>" json:"top_skus_by_revenue"`
+	TotalOrderCount     int64              `thrift:"total_order_count,5" frugal:"5,default,i64" json:"total_order_count"`
+	TotalRevenue        int64              `thrift:"total_revenue,6" frugal:"6,default,i64" json:"total_revenue"`
+	TotalRefundedAmount int64              `thrift:"total_refunded_amount,7" frugal:"7,default,i64" json:"total_refunded_amount"`
+}
+
+func NewReportResponse() *ReportResponse {
+	return &ReportResponse{}
+}
+
+func (p *ReportResponse) InitDefault() {
+}
+
+var ReportResponse_BaseResp_DEFAULT *base.BaseResponse
+
+func (p *ReportResponse) GetBaseResp() (v *base.BaseResponse) {
+	if !p.IsSetBaseResp() {
+		return ReportResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *ReportResponse) GetBuckets() (v []*ReportBucket) {
+	return p.Buckets
+}
+
+func (p *ReportResponse) GetTopSkusByQuantity() (v []*SkuSales) {
+	return p.TopSkusByQuantity
+}
+
+func (p *ReportResponse) GetTopSkusByRevenue() (v []*SkuSales) {
+	return p.TopSkusByRevenue
+}
+
+func (p *ReportResponse) GetTotalOrderCount() (v int64) {
+	return p.TotalOrderCount
+}
+
+func (p *ReportResponse) GetTotalRevenue() (v int64) {
+	return p.TotalRevenue
+}
+
+func (p *ReportResponse) GetTotalRefundedAmount() (v int64) {
+	return p.TotalRefundedAmount
+}
+func (p *ReportResponse) SetBaseResp(val *base.BaseResponse) {
+	p.BaseResp = val
+}
+func (p *ReportResponse) SetBuckets(val []*ReportBucket) {
+	p.Buckets = val
+}
+func (p *ReportResponse) SetTopSkusByQuantity(val []*SkuSales) {
+	p.TopSkusByQuantity = val
+}
+func (p *ReportResponse) SetTopSkusByRevenue(val []*SkuSales) {
+	p.TopSkusByRevenue = val
+}
+func (p *ReportResponse) SetTotalOrderCount(val int64) {
+	p.TotalOrderCount = val
+}
+func (p *ReportResponse) SetTotalRevenue(val int64) {
+	p.TotalRevenue = val
+}
+func (p *ReportResponse) SetTotalRefundedAmount(val int64) {
+	p.TotalRefundedAmount = val
+}
+
+func (p *ReportResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ReportResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReportResponse(%+v)", *p)
+}
+
+var fieldIDToName_ReportResponse = map[int16]string{
+	1: "baseResp",
+	2: "buckets",
+	3: "top_skus_by_quantity",
+	4: "top_skus_by_revenue",
+	5: "total_order_count",
+	6: "total_revenue",
+	7: "total_refunded_amount",
+}
+
+type SearchOrdersRequest struct {
+	Statuses      []int32           `thrift:"statuses,1,optional" frugal:"1,optional,list<i32>" json:"statuses,omitempty"`
+	Type          *int32            `thrift:"type,2,optional" frugal:"2,optional,i32" json:"type,omitempty"`
+	ReqUserId     *int64            `thrift:"req_user_id,3,optional" frugal:"3,optional,i64" json:"req_user_id,omitempty"`
+	RespUserId    *int64            `thrift:"resp_user_id,4,optional" frugal:"4,optional,i64" json:"resp_user_id,omitempty"`
+	CreatedFrom   *int64            `thrift:"created_from,5,optional" frugal:"5,optional,i64" json:"created_from,omitempty"`
+	CreatedTo     *int64            `thrift:"created_to,6,optional" frugal:"6,optional,i64" json:"created_to,omitempty"`
+	UpdatedFrom   *int64            `thrift:"updated_from,7,optional" frugal:"7,optional,i64" json:"updated_from,omitempty"`
+	UpdatedTo     *int64            `thrift:"updated_to,8,optional" frugal:"8,optional,i64" json:"updated_to,omitempty"`
+	ProductId     *int64            `thrift:"product_id,9,optional" frugal:"9,optional,i64" json:"product_id,omitempty"`
+	SkuId         *int64            `thrift:"sku_id,10,optional" frugal:"10,optional,i64" json:"sku_id,omitempty"`
+	Ext           map[string]string `thrift:"ext,11,optional" frugal:"11,optional,map<string:string>" json:"ext,omitempty"`
+	SortBy        SearchSortField   `thrift:"sort_by,12,optional" frugal:"12,optional,SearchSortField" json:"sort_by,omitempty"`
+	Ascending     bool              `thrift:"ascending,13,optional" frugal:"13,optional,bool" json:"ascending,omitempty"`
+	Cursor        *string           `thrift:"cursor,14,optional" frugal:"14,optional,string" json:"cursor,omitempty"`
+	Limit         int32             `thrift:"limit,15,optional" frugal:"15,optional,i32" json:"limit,omitempty"`
+	IncludeOrders bool              `thrift:"include_orders,16,optional" frugal:"16,optional,bool" json:"include_orders,omitempty"`
+	Fields        []string          `thrift:"fields,17,optional" frugal:"17,optional,list<string>" json:"fields,omitempty"`
+	ParentId      *string           `thrift:"parent_id,18,optional" frugal:"18,optional,string" json:"parent_id,omitempty"`
+}
+
+func NewSearchOrdersRequest() *SearchOrdersRequest {
+	return &SearchOrdersRequest{
+		SortBy:        SearchSortField_CREATED_AT,
+		Ascending:     false,
+		Limit:         20,
+		IncludeOrders: false,
+	}
+}
+
+func (p *SearchOrdersRequest) InitDefault() {
+	p.SortBy = SearchSortField_CREATED_AT
+	p.Ascending = false
+	p.Limit = 20
+	p.IncludeOrders = false
+}
+
+var SearchOrdersRequest_Statuses_DEFAULT []int32
+
+func (p *SearchOrdersRequest) GetStatuses() (v []int32) {
+	if !p.IsSetStatuses() {
+		return SearchOrdersRequest_Statuses_DEFAULT
+	}
+	return p.Statuses
+}
+
+var SearchOrdersRequest_Type_DEFAULT int32
+
+func (p *SearchOrdersRequest) GetType() (v int32) {
+	if !p.IsSetType() {
+		return SearchOrdersRequest_Type_DEFAULT
+	}
+	return *p.Type
+}
+
+var SearchOrdersRequest_ReqUserId_DEFAULT int64
+
+func (p *SearchOrdersRequest) GetReqUserId() (v int64) {
+	if !p.IsSetReqUserId() {
+		return SearchOrdersRequest_ReqUserId_DEFAULT
+	}
+	return *p.ReqUserId
+}
+
+var SearchOrdersRequest_RespUserId_DEFAULT int64
+
+func (p *SearchOrdersRequest) GetRespUserId() (v int64) {
+	if !p.IsSetRespUserId() {
+		return SearchOrdersRequest_RespUserId_DEFAULT
+	}
+	return *p.RespUserId
+}
+
+var SearchOrdersRequest_CreatedFrom_DEFAULT int64
+
+func (p *SearchOrdersRequest) GetCreatedFrom() (v int64) {
+	if !p.IsSetCreatedFrom() {
+		return SearchOrdersRequest_CreatedFrom_DEFAULT
+	}
+	return *p.CreatedFrom
+}
+
+var SearchOrdersRequest_CreatedTo_DEFAULT int64
+
+func (p *SearchOrdersRequest) GetCreatedTo() (v int64) {
+	if !p.IsSetCreatedTo() {
+		return SearchOrdersRequest_CreatedTo_DEFAULT
+	}
+	return *p.CreatedTo
+}
+
+var SearchOrdersRequest_UpdatedFrom_DEFAULT int64
+
+func (p *SearchOrdersRequest) GetUpdatedFrom() (v int64) {
+	if !p.IsSetUpdatedFrom() {
+		return SearchOrdersRequest_UpdatedFrom_DEFAULT
+	}
+	return *p.UpdatedFrom
+}
+
+var SearchOrdersRequest_UpdatedTo_DEFAULT int64
+
+func (p *SearchOrdersRequest) GetUpdatedTo() (v int64) {
+	if !p.IsSetUpdatedTo() {
+		return SearchOrdersRequest_UpdatedTo_DEFAULT
+	}
+	return *p.UpdatedTo
+}
+
+var SearchOrdersRequest_ProductId_DEFAULT int64
+
+func (p *SearchOrdersRequest) GetProductId() (v int64) {
+	if !p.IsSetProductId() {
+		return SearchOrdersRequest_ProductId_DEFAULT
+	}
+	return *p.ProductId
+}
+
+var SearchOrdersRequest_SkuId_DEFAULT int64
+
+func (p *SearchOrdersRequest) GetSkuId() (v int64) {
+	if !p.IsSetSkuId() {
+		return SearchOrdersRequest_SkuId_DEFAULT
+	}
+	return *p.SkuId
+}
+
+var SearchOrdersRequest_Ext_DEFAULT map[string]string
+
+func (p *SearchOrdersRequest) GetExt() (v map[string]string) {
+	if !p.IsSetExt() {
+		return SearchOrdersRequest_Ext_DEFAULT
+	}
+	return p.Ext
+}
+
+var SearchOrdersRequest_SortBy_DEFAULT SearchSortField = SearchSortField_CREATED_AT
+
+func (p *SearchOrdersRequest) GetSortBy() (v SearchSortField) {
+	if !p.IsSetSortBy() {
+		return SearchOrdersRequest_SortBy_DEFAULT
+	}
+	return p.SortBy
+}
+
+var SearchOrdersRequest_Ascending_DEFAULT bool = false
+
+func (p *SearchOrdersRequest) GetAscending() (v bool) {
+	if !p.IsSetAscending() {
+		return SearchOrdersRequest_Ascending_DEFAULT
+	}
+	return p.Ascending
+}
+
+var SearchOrdersRequest_Cursor_DEFAULT string
+
+func (p *SearchOrdersRequest) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return SearchOrdersRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+var SearchOrdersRequest_Limit_DEFAULT int32 = 20
+
+func (p *SearchOrdersRequest) GetLimit() (v int32) {
+	if !p.IsSetLimit() {
+		return SearchOrdersRequest_Limit_DEFAULT
+	}
+	return p.Limit
+}
+
+var SearchOrdersRequest_IncludeOrders_DEFAULT bool = false
+
+func (p *SearchOrdersRequest) GetIncludeOrders() (v bool) {
+	if !p.IsSetIncludeOrders() {
+		return SearchOrdersRequest_IncludeOrders_DEFAULT
+	}
+	return p.IncludeOrders
+}
+
+var SearchOrdersRequest_Fields_DEFAULT []string
+
+func (p *SearchOrdersRequest) GetFields() (v []string) {
+	if !p.IsSetFields() {
+		return SearchOrdersRequest_Fields_DEFAULT
+	}
+	return p.Fields
+}
+
+var SearchOrdersRequest_ParentId_DEFAULT string
+
+func (p *SearchOrdersRequest) GetParentId() (v string) {
+	if !p.IsSetParentId() {
+		return SearchOrdersRequest_ParentId_DEFAULT
+	}
+	return *p.ParentId
+}
+func (p *SearchOrdersRequest) SetStatuses(val []int32) {
+	p.Statuses = val
+}
+func (p *SearchOrdersRequest) SetType(val *int32) {
+	p.Type = val
+}
+func (p *SearchOrdersRequest) SetReqUserId(val *int64) {
+	p.ReqUserId = val
+}
+func (p *SearchOrdersRequest) SetRespUserId(val *int64) {
+	p.RespUserId = val
+}
+func (p *SearchOrdersRequest) SetCreatedFrom(val *int64) {
+	p.CreatedFrom = val
+}
+func (p *SearchOrdersRequest) SetCreatedTo(val *int64) {
+	p.CreatedTo = val
+}
+func (p *SearchOrdersRequest) SetUpdatedFrom(val *int64) {
+	p.UpdatedFrom = val
+}
+func (p *SearchOrdersRequest) SetUpdatedTo(val *int64) {
+	p.UpdatedTo = val
+}
+func (p *SearchOrdersRequest) SetProductId(val *int64) {
+	p.ProductId = val
+}
+func (p *SearchOrdersRequest) SetSkuId(val *int64) {
+	p.SkuId = val
+}
+func (p *SearchOrdersRequest) SetExt(val map[string]string) {
+	p.Ext = val
+}
+func (p *SearchOrdersRequest) SetSortBy(val SearchSortField) {
+	p.SortBy = val
+}
+func (p *SearchOrdersRequest) SetAscending(val bool) {
+	p.Ascending = val
+}
+func (p *SearchOrdersRequest) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *SearchOrdersRequest) SetLimit(val int32) {
+	p.Limit = val
+}
+func (p *SearchOrdersRequest) SetIncludeOrders(val bool) {
+	p.IncludeOrders = val
+}
+func (p *SearchOrdersRequest) SetFields(val []string) {
+	p.Fields = val
+}
+func (p *SearchOrdersRequest) SetParentId(val *string) {
+	p.ParentId = val
+}
+
+func (p *SearchOrdersRequest) IsSetStatuses() bool {
+	return p.Statuses != nil
+}
+
+func (p *SearchOrdersRequest) IsSetType() bool {
+	return p.Type != nil
+}
+
+func (p *SearchOrdersRequest) IsSetReqUserId() bool {
+	return p.ReqUserId != nil
+}
+
+func (p *SearchOrdersRequest) IsSetRespUserId() bool {
+	return p.RespUserId != nil
+}
+
+func (p *SearchOrdersRequest) IsSetCreatedFrom() bool {
+	return p.CreatedFrom != nil
+}
+
+func (p *SearchOrdersRequest) IsSetCreatedTo() bool {
+	return p.CreatedTo != nil
+}
+
+func (p *SearchOrdersRequest) IsSetUpdatedFrom() bool {
+	return p.UpdatedFrom != nil
+}
+
+func (p *SearchOrdersRequest) IsSetUpdatedTo() bool {
+	return p.UpdatedTo != nil
+}
+
+func (p *SearchOrdersRequest) IsSetProductId() bool {
+	return p.ProductId != nil
+}
+
+func (p *SearchOrdersRequest) IsSetSkuId() bool {
+	return p.SkuId != nil
+}
+
+func (p *SearchOrdersRequest) IsSetExt() bool {
+	return p.Ext != nil
+}
+
+func (p *SearchOrdersRequest) IsSetSortBy() bool {
+	return p.SortBy != SearchOrdersRequest_SortBy_DEFAULT
+}
+
+func (p *SearchOrdersRequest) IsSetAscending() bool {
+	return p.Ascending != SearchOrdersRequest_Ascending_DEFAULT
+}
+
+func (p *SearchOrdersRequest) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *SearchOrdersRequest) IsSetLimit() bool {
+	return p.Limit != SearchOrdersRequest_Limit_DEFAULT
+}
+
+func (p *SearchOrdersRequest) IsSetIncludeOrders() bool {
+	return p.IncludeOrders != SearchOrdersRequest_IncludeOrders_DEFAULT
+}
+
+func (p *SearchOrdersRequest) IsSetFields() bool {
+	return p.Fields != nil
+}
+
+func (p *SearchOrdersRequest) IsSetParentId() bool {
+	return p.ParentId != nil
+}
+
+func (p *SearchOrdersRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchOrdersRequest(%+v)", *p)
+}
+
+var fieldIDToName_SearchOrdersRequest = map[int16]string{
+	1:  "statuses",
+	2:  "type",
+	3:  "req_user_id",
+	4:  "resp_user_id",
+	5:  "created_from",
+	6:  "created_to",
+	7:  "updated_from",
+	8:  "updated_to",
+	9:  "product_id",
+	10: "sku_id",
+	11: "ext",
+	12: "sort_by",
+	13: "ascending",
+	14: "cursor",
+	15: "limit",
+	16: "include_orders",
+	17: "fields",
+	18: "parent_id",
+}
+
+type SearchOrdersResponse struct {
+	BaseResp   *base.BaseResponse `thrift:"baseResp,1" frugal:"1,default,base.BaseResponse" json:"baseResp"`
+	OrderIds   []string           `thrift:"order_ids,2" frugal:"2,default,list<string>" json:"order_ids"`
+	Orders     []*Order           `thrift:"orders,3" frugal:"3,default,list<Order>" json:"orders"`
+	NextCursor string             `thrift:"next_cursor,4" frugal:"4,default,string" json:"next_cursor"`
+}
+
+func NewSearchOrdersResponse() *SearchOrdersResponse {
+	return &SearchOrdersResponse{}
+}
+
+func (p *SearchOrdersResponse) InitDefault() {
+}
+
+var SearchOrdersResponse_BaseResp_DEFAULT *base.BaseResponse
+
+func (p *SearchOrdersResponse) GetBaseResp() (v *base.BaseResponse) {
+	if !p.IsSetBaseResp() {
+		return SearchOrdersResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *SearchOrdersResponse) GetOrderIds() (v []string) {
+	return p.OrderIds
+}
+
+func (p *SearchOrdersResponse) GetOrders() (v []*Order) {
+	return p.Orders
+}
+
+func (p *SearchOrdersResponse) GetNextCursor() (v string) {
+	return p.NextCursor
+}
+func (p *SearchOrdersResponse) SetBaseResp(val *base.BaseResponse) {
+	p.BaseResp = val
+}
+func (p *SearchOrdersResponse) SetOrderIds(val []string) {
+	p.OrderIds = val
+}
+func (p *SearchOrdersResponse) SetOrders(val []*Order) {
+	p.Orders = val
+}
+func (p *SearchOrdersResponse) SetNextCursor(val string) {
+	p.NextCursor = val
+}
+
+func (p *SearchOrdersResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *SearchOrdersResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchOrdersResponse(%+v)", *p)
+}
+
+var fieldIDToName_SearchOrdersResponse = map[int16]string{
+	1: "baseResp",
+	2: "order_ids",
+	3: "orders",
+	4: "next_cursor",
+}
+
+type CreateFromCartRequest struct {
+	Type           int32                 `thrift:"type,1" frugal:"1,default,i32" json:"type"`
+	Status         int32                 `thrift:"status,2" frugal:"2,default,i32" json:"status"`
+	ReqUserId      int64                 `thrift:"req_user_id,3" frugal:"3,default,i64" json:"req_user_id"`
+	Items          []*OrderItemForCreate `thrift:"items,4" frugal:"4,default,list<OrderItemForCreate>" json:"items"`
+	Ext            map[string]string     `thrift:"ext,5" frugal:"5,default,map<string:string>" json:"ext"`
+	IdempotencyKey *string               `thrift:"idempotency_key,6,optional" frugal:"6,optional,string" json:"idempotency_key,omitempty"`
+	AddressId      *int64                `thrift:"address_id,7,optional" frugal:"7,optional,i64" json:"address_id,omitempty"`
+}
+
+func NewCreateFromCartRequest() *CreateFromCartRequest {
+	return &CreateFromCartRequest{}
+}
+
+func (p *CreateFromCartRequest) InitDefault() {
+}
+
+func (p *CreateFromCartRequest) GetType() (v int32) {
+	return p.Type
+}
+
+func (p *CreateFromCartRequest) GetStatus() (v int32) {
+	return p.Status
+}
+
+func (p *CreateFromCartRequest) GetReqUserId() (v int64) {
+	return p.ReqUserId
+}
+
+func (p *CreateFromCartRequest) GetItems() (v []*OrderItemForCreate) {
+	return p.Items
+}
+
+func (p *CreateFromCartRequest) GetExt() (v map[string]string) {
+	return p.Ext
+}
+
+var CreateFromCartRequest_IdempotencyKey_DEFAULT string
+
+func (p *CreateFromCartRequest) GetIdempotencyKey() (v string) {
+	if !p.IsSetIdempotencyKey() {
+		return CreateFromCartRequest_IdempotencyKey_DEFAULT
+	}
+	return *p.IdempotencyKey
+}
+
+var CreateFromCartRequest_AddressId_DEFAULT int64
+
+func (p *CreateFromCartRequest) GetAddressId() (v int64) {
+	if !p.IsSetAddressId() {
+		return CreateFromCartRequest_AddressId_DEFAULT
+	}
+	return *p.AddressId
+}
+func (p *CreateFromCartRequest) SetType(val int32) {
+	p.Type = val
+}
+func (p *CreateFromCartRequest) SetStatus(val int32) {
+	p.Status = val
+}
+func (p *CreateFromCartRequest) SetReqUserId(val int64) {
+	p.ReqUserId = val
+}
+func (p *CreateFromCartRequest) SetItems(val []*OrderItemForCreate) {
+	p.Items = val
+}
+func (p *CreateFromCartRequest) SetExt(val map[string]string) {
+	p.Ext = val
+}
+func (p *CreateFromCartRequest) SetIdempotencyKey(val *string) {
+	p.IdempotencyKey = val
+}
+func (p *CreateFromCartRequest) SetAddressId(val *int64) {
+	p.AddressId = val
+}
+
+func (p *CreateFromCartRequest) IsSetIdempotencyKey() bool {
+	return p.IdempotencyKey != nil
+}
+
+func (p *CreateFromCartRequest) IsSetAddressId() bool {
+	return p.AddressId != nil
+}
+
+func (p *CreateFromCartRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateFromCartRequest(%+v)", *p)
+}
+
+var fieldIDToName_CreateFromCartRequest = map[int16]string{
+	1: "type",
+	2: "status",
+	3: "req_user_id",
+	4: "items",
+	5: "ext",
+	6: "idempotency_key",
+	7: "address_id",
+}
+
+type ParentOrder struct {
+	Id          string   `thrift:"id,1" frugal:"1,default,string" json:"id"`
+	ReqUserId   int64    `thrift:"req_user_id,2" frugal:"2,default,i64" json:"req_user_id"`
+	Status      int32    `thrift:"status,3" frugal:"3,default,i32" json:"status"`
+	TotalAmount int64    `thrift:"total_amount,4" frugal:"4,default,i64" json:"total_amount"`
+	OrderIds    []string `thrift:"order_ids,5" frugal:"5,default,list<string>" json:"order_ids"`
+	CreatedAt   int64    `thrift:"created_at,6" frugal:"6,default,i64" json:"created_at"`
+}
+
+func NewParentOrder() *ParentOrder {
+	return &ParentOrder{}
+}
+
+func (p *ParentOrder) InitDefault() {
+}
+
+func (p *ParentOrder) GetId() (v string) {
+	return p.Id
+}
+
+func (p *ParentOrder) GetReqUserId() (v int64) {
+	return p.ReqUserId
+}
+
+func (p *ParentOrder) GetStatus() (v int32) {
+	return p.Status
+}
+
+func (p *ParentOrder) GetTotalAmount() (v int64) {
+	return p.TotalAmount
+}
+
+func (p *ParentOrder) GetOrderIds() (v []string) {
+	return p.OrderIds
+}
+
+func (p *ParentOrder) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+func (p *ParentOrder) SetId(val string) {
+	p.Id = val
+}
+func (p *ParentOrder) SetReqUserId(val int64) {
+	p.ReqUserId = val
+}
+func (p *ParentOrder) SetStatus(val int32) {
+	p.Status = val
+}
+func (p *ParentOrder) SetTotalAmount(val int64) {
+	p.TotalAmount = val
+}
+func (p *ParentOrder) SetOrderIds(val []string) {
+	p.OrderIds = val
+}
+func (p *ParentOrder) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+
+func (p *ParentOrder) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ParentOrder(%+v)", *p)
+}
+
+var fieldIDToName_ParentOrder = map[int16]string{
+	1: "id",
+	2: "req_user_id",
+	3: "status",
+	4: "total_amount",
+	5: "order_ids",
+	6: "created_at",
+}
+
+type CreateFromCartResponse struct {
+	BaseResp    *base.BaseResponse `thrift:"baseResp,1" frugal:"1,default,base.BaseResponse" json:"baseResp"`
+	ParentOrder *ParentOrder       `thrift:"parent_order,2" frugal:"2,default,ParentOrder" json:"parent_order"`
+	Orders      []*Order           `thrift:"orders,3" frugal:"3,default,list<Order>" json:"orders"`
+}
+
+func NewCreateFromCartResponse() *CreateFromCartResponse {
+	return &CreateFromCartResponse{}
+}
+
+func (p *CreateFromCartResponse) InitDefault() {
+}
+
+var CreateFromCartResponse_BaseResp_DEFAULT *base.BaseResponse
+
+func (p *CreateFromCartResponse) GetBaseResp() (v *base.BaseResponse) {
+	if !p.IsSetBaseResp() {
+		return CreateFromCartResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var CreateFromCartResponse_ParentOrder_DEFAULT *ParentOrder
+
+func (p *CreateFromCartResponse) GetParentOrder() (v *ParentOrder) {
+	if !p.IsSetParentOrder() {
+		return CreateFromCartResponse_ParentOrder_DEFAULT
+	}
+	return p.ParentOrder
+}
+
+func (p *CreateFromCartResponse) GetOrders() (v []*Order) {
+	return p.Orders
+}
+func (p *CreateFromCartResponse) SetBaseResp(val *base.BaseResponse) {
+	p.BaseResp = val
+}
+func (p *CreateFromCartResponse) SetParentOrder(val *ParentOrder) {
+	p.ParentOrder = val
+}
+func (p *CreateFromCartResponse) SetOrders(val []*Order) {
+	p.Orders = val
+}
+
+func (p *CreateFromCartResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CreateFromCartResponse) IsSetParentOrder() bool {
+	return p.ParentOrder != nil
+}
+
+func (p *CreateFromCartResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateFromCartResponse(%+v)", *p)
+}
+
+var fieldIDToName_CreateFromCartResponse = map[int16]string{
+	1: "baseResp",
+	2: "parent_order",
+	3: "orders",
+}
+
+type QueryParentOrderRequest struct {
+	ParentId string `thrift:"parent_id,1" frugal:"1,default,string" json:"parent_id"`
+}
+
+func NewQueryParentOrderRequest() *QueryParentOrderRequest {
+	return &QueryParentOrderRequest{}
+}
+
+func (p *QueryParentOrderRequest) InitDefault() {
+}
+
+func (p *QueryParentOrderRequest) GetParentId() (v string) {
+	return p.ParentId
+}
+func (p *QueryParentOrderRequest) SetParentId(val string) {
+	p.ParentId = val
+}
+
+func (p *QueryParentOrderRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryParentOrderRequest(%+v)", *p)
+}
+
+var fieldIDToName_QueryParentOrderRequest = map[int16]string{
+	1: "parent_id",
+}
+
+type QueryParentOrderResponse struct {
+	BaseResp    *base.BaseResponse `thrift:"baseResp,1" frugal:"1,default,base.BaseResponse" json:"baseResp"`
+	ParentOrder *ParentOrder       `thrift:"parent_order,2" frugal:"2,default,ParentOrder" json:"parent_order"`
+	Orders      []*Order           `thrift:"orders,3" frugal:"3,default,list<Order>" json:"orders"`
+}
+
+func NewQueryParentOrderResponse() *QueryParentOrderResponse {
+	return &QueryParentOrderResponse{}
+}
+
+func (p *QueryParentOrderResponse) InitDefault() {
+}
+
+var QueryParentOrderResponse_BaseResp_DEFAULT *base.BaseResponse
+
+func (p *QueryParentOrderResponse) GetBaseResp() (v *base.BaseResponse) {
+	if !p.IsSetBaseResp() {
+		return QueryParentOrderResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var QueryParentOrderResponse_ParentOrder_DEFAULT *ParentOrder
+
+func (p *QueryParentOrderResponse) GetParentOrder() (v *ParentOrder) {
+	if !p.IsSetParentOrder() {
+		return QueryParentOrderResponse_ParentOrder_DEFAULT
+	}
+	return p.ParentOrder
+}
+
+func (p *QueryParentOrderResponse) GetOrders() (v []*Order) {
+	return p.Orders
+}
+func (p *QueryParentOrderResponse) SetBaseResp(val *base.BaseResponse) {
+	p.BaseResp = val
+}
+func (p *QueryParentOrderResponse) SetParentOrder(val *ParentOrder) {
+	p.ParentOrder = val
+}
+func (p *QueryParentOrderResponse) SetOrders(val []*Order) {
+	p.Orders = val
+}
+
+func (p *QueryParentOrderResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *QueryParentOrderResponse) IsSetParentOrder() bool {
+	return p.ParentOrder != nil
+}
+
+func (p *QueryParentOrderResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryParentOrderResponse(%+v)", *p)
+}
+
+var fieldIDToName_QueryParentOrderResponse = map[int16]string{
+	1: "baseResp",
+	2: "parent_order",
+	3: "orders",
+}
+
+type OrderService interface {
+	Create(ctx context.Context, req *CreateRequest) (r *CreateResponse, err error)
+
+	Update(ctx context.Context, req *UpdateRequest) (r *UpdateResponse, err error)
+
+	QueryOrderInfo(ctx context.Context, req *QueryOrderInfoRequest) (r *QueryOrderInfoResponse, err error)
+
+	BatchQueryOrderInfo(ctx context.Context, req *BatchQueryOrderInfoRequest) (r *BatchQueryOrderInfoResponse, err error)
+
+	QueryOrderId(ctx context.Context, req *QueryOrderIdRequest) (r *QueryOrderIdResponse, err error)
+
+	SearchOrders(ctx context.Context, req *SearchOrdersRequest) (r *SearchOrdersResponse, err error)
+
+	RequestRefund(ctx context.Context, req *RequestRefundRequest) (r *RequestRefundResponse, err error)
+
+	ReviewRefund(ctx context.Context, req *ReviewRefundRequest) (r *ReviewRefundResponse, err error)
+
+	CompleteRefund(ctx context.Context, req *CompleteRefundRequest) (r *CompleteRefundResponse, err error)
+
+	QueryRefunds(ctx context.Context, req *QueryRefundsRequest) (r *QueryRefundsResponse, err error)
+
+	CreateShipment(ctx context.Context, req *CreateShipmentRequest) (r *CreateShipmentResponse, err error)
+
+	AddTrackingEvent(ctx context.Context, req *AddTrackingEventRequest) (r *AddTrackingEventResponse, err error)
+
+	ConfirmDelivery(ctx context.Context, req *ConfirmDeliveryRequest) (r *ConfirmDeliveryResponse, err error)
+
+	DecodeId(ctx context.Context, req *DecodeIdRequest) (r *DecodeIdResponse, err error)
+
+	Report(ctx context.Context, req *ReportRequest) (r *ReportResponse, err error)
+
+	CreateFromCart(ctx context.Context, req *CreateFromCartRequest) (r *CreateFromCartResponse, err error)
+
+	QueryParentOrder(ctx context.Context, req *QueryParentOrderRequest) (r *QueryParentOrderResponse, err error)
+}
+
+type OrderServiceCreateArgs struct {
+	Req *CreateRequest `thrift:"req,1" frugal:"1,default,CreateRequest" json:"req"`
+}
+
+func NewOrderServiceCreateArgs() *OrderServiceCreateArgs {
+	return &OrderServiceCreateArgs{}
+}
+
+func (p *OrderServiceCreateArgs) InitDefault() {
+}
+
+var OrderServiceCreateArgs_Req_DEFAULT *CreateRequest
+
+func (p *OrderServiceCreateArgs) GetReq() (v *CreateRequest) {
+	if !p.IsSetReq() {
+		return OrderServiceCreateArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceCreateArgs) SetReq(val *CreateRequest) {
+	p.Req = val
+}
+
+func (p *OrderServiceCreateArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceCreateArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceCreateArgs(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceCreateArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServiceCreateResult struct {
+	Success *CreateResponse `thrift:"success,0,optional" frugal:"0,optional,CreateResponse" json:"success,omitempty"`
+}
+
+func NewOrderServiceCreateResult() *OrderServiceCreateResult {
+	return &OrderServiceCreateResult{}
+}
+
+func (p *OrderServiceCreateResult) InitDefault() {
+}
+
+var OrderServiceCreateResult_Success_DEFAULT *CreateResponse
+
+func (p *OrderServiceCreateResult) GetSuccess() (v *CreateResponse) {
+	if !p.IsSetSuccess() {
+		return OrderServiceCreateResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceCreateResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateResponse)
+}
+
+func (p *OrderServiceCreateResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceCreateResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceCreateResult(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceCreateResult = map[int16]string{
+	0: "success",
+}
+
+type OrderServiceUpdateArgs struct {
+	Req *UpdateRequest `thrift:"req,1" frugal:"1,default,UpdateRequest" json:"req"`
+}
+
+func NewOrderServiceUpdateArgs() *OrderServiceUpdateArgs {
+	return &OrderServiceUpdateArgs{}
+}
+
+func (p *OrderServiceUpdateArgs) InitDefault() {
+}
+
+var OrderServiceUpdateArgs_Req_DEFAULT *UpdateRequest
+
+func (p *OrderServiceUpdateArgs) GetReq() (v *UpdateRequest) {
+	if !p.IsSetReq() {
+		return OrderServiceUpdateArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceUpdateArgs) SetReq(val *UpdateRequest) {
+	p.Req = val
+}
+
+func (p *OrderServiceUpdateArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceUpdateArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceUpdateArgs(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceUpdateArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServiceUpdateResult struct {
+	Success *UpdateResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateResponse" json:"success,omitempty"`
+}
+
+func NewOrderServiceUpdateResult() *OrderServiceUpdateResult {
+	return &OrderServiceUpdateResult{}
+}
+
+func (p *OrderServiceUpdateResult) InitDefault() {
+}
+
+var OrderServiceUpdateResult_Success_DEFAULT *UpdateResponse
+
+func (p *OrderServiceUpdateResult) GetSuccess() (v *UpdateResponse) {
+	if !p.IsSetSuccess() {
+		return OrderServiceUpdateResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceUpdateResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateResponse)
+}
+
+func (p *OrderServiceUpdateResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceUpdateResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceUpdateResult(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceUpdateResult = map[int16]string{
+	0: "success",
+}
+
+type OrderServiceQueryOrderInfoArgs struct {
+	Req *QueryOrderInfoRequest `thrift:"req,1" frugal:"1,default,QueryOrderInfoRequest" json:"req"`
+}
+
+func NewOrderServiceQueryOrderInfoArgs() *OrderServiceQueryOrderInfoArgs {
+	return &OrderServiceQueryOrderInfoArgs{}
+}
+
+func (p *OrderServiceQueryOrderInfoArgs) InitDefault() {
+}
+
+var OrderServiceQueryOrderInfoArgs_Req_DEFAULT *QueryOrderInfoRequest
+
+func (p *OrderServiceQueryOrderInfoArgs) GetReq() (v *QueryOrderInfoRequest) {
+	if !p.IsSetReq() {
+		return OrderServiceQueryOrderInfoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceQueryOrderInfoArgs) SetReq(val *QueryOrderInfoRequest) {
+	p.Req = val
+}
+
+func (p *OrderServiceQueryOrderInfoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceQueryOrderInfoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceQueryOrderInfoArgs(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceQueryOrderInfoArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServiceQueryOrderInfoResult struct {
+	Success *QueryOrderInfoResponse `thrift:"success,0,optional" frugal:"0,optional,QueryOrderInfoResponse" json:"success,omitempty"`
+}
+
+func NewOrderServiceQueryOrderInfoResult() *OrderServiceQueryOrderInfoResult {
+	return &OrderServiceQueryOrderInfoResult{}
+}
+
+func (p *OrderServiceQueryOrderInfoResult) InitDefault() {
+}
+
+var OrderServiceQueryOrderInfoResult_Success_DEFAULT *QueryOrderInfoResponse
+
+func (p *OrderServiceQueryOrderInfoResult) GetSuccess() (v *QueryOrderInfoResponse) {
+	if !p.IsSetSuccess() {
+		return OrderServiceQueryOrderInfoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceQueryOrderInfoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*QueryOrderInfoResponse)
+}
+
+func (p *OrderServiceQueryOrderInfoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceQueryOrderInfoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceQueryOrderInfoResult(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceQueryOrderInfoResult = map[int16]string{
+	0: "success",
+}
+
+type OrderServiceBatchQueryOrderInfoArgs struct {
+	Req *BatchQueryOrderInfoRequest `thrift:"req,1" frugal:"1,default,BatchQueryOrderInfoRequest" json:"req"`
+}
+
+func NewOrderServiceBatchQueryOrderInfoArgs() *OrderServiceBatchQueryOrderInfoArgs {
+	return &OrderServiceBatchQueryOrderInfoArgs{}
+}
+
+func (p *OrderServiceBatchQueryOrderInfoArgs) InitDefault() {
+}
+
+var OrderServiceBatchQueryOrderInfoArgs_Req_DEFAULT *BatchQueryOrderInfoRequest
+
+func (p *OrderServiceBatchQueryOrderInfoArgs) GetReq() (v *BatchQueryOrderInfoRequest) {
+	if !p.IsSetReq() {
+		return OrderServiceBatchQueryOrderInfoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceBatchQueryOrderInfoArgs) SetReq(val *BatchQueryOrderInfoRequest) {
+	p.Req = val
+}
+
+func (p *OrderServiceBatchQueryOrderInfoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceBatchQueryOrderInfoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceBatchQueryOrderInfoArgs(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceBatchQueryOrderInfoArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServiceBatchQueryOrderInfoResult struct {
+	Success *BatchQueryOrderInfoResponse `thrift:"success,0,optional" frugal:"0,optional,BatchQueryOrderInfoResponse" json:"success,omitempty"`
+}
+
+func NewOrderServiceBatchQueryOrderInfoResult() *OrderServiceBatchQueryOrderInfoResult {
+	return &OrderServiceBatchQueryOrderInfoResult{}
+}
+
+func (p *OrderServiceBatchQueryOrderInfoResult) InitDefault() {
+}
+
+var OrderServiceBatchQueryOrderInfoResult_Success_DEFAULT *BatchQueryOrderInfoResponse
+
+func (p *OrderServiceBatchQueryOrderInfoResult) GetSuccess() (v *BatchQueryOrderInfoResponse) {
+	if !p.IsSetSuccess() {
+		return OrderServiceBatchQueryOrderInfoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceBatchQueryOrderInfoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BatchQueryOrderInfoResponse)
+}
+
+func (p *OrderServiceBatchQueryOrderInfoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceBatchQueryOrderInfoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceBatchQueryOrderInfoResult(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceBatchQueryOrderInfoResult = map[int16]string{
+	0: "success",
+}
+
+type OrderServiceQueryOrderIdArgs struct {
+	Req *QueryOrderIdRequest `thrift:"req,1" frugal:"1,default,QueryOrderIdRequest" json:"req"`
+}
+
+func NewOrderServiceQueryOrderIdArgs() *OrderServiceQueryOrderIdArgs {
+	return &OrderServiceQueryOrderIdArgs{}
+}
+
+func (p *OrderServiceQueryOrderIdArgs) InitDefault() {
+}
+
+var OrderServiceQueryOrderIdArgs_Req_DEFAULT *QueryOrderIdRequest
+
+func (p *OrderServiceQueryOrderIdArgs) GetReq() (v *QueryOrderIdRequest) {
+	if !p.IsSetReq() {
+		return OrderServiceQueryOrderIdArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceQueryOrderIdArgs) SetReq(val *QueryOrderIdRequest) {
+	p.Req = val
+}
+
+func (p *OrderServiceQueryOrderIdArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceQueryOrderIdArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceQueryOrderIdArgs(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceQueryOrderIdArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServiceQueryOrderIdResult struct {
+	Success *QueryOrderIdResponse `thrift:"success,0,optional" frugal:"0,optional,QueryOrderIdResponse" json:"success,omitempty"`
+}
+
+func NewOrderServiceQueryOrderIdResult() *OrderServiceQueryOrderIdResult {
+	return &OrderServiceQueryOrderIdResult{}
+}
+
+func (p *OrderServiceQueryOrderIdResult) InitDefault() {
+}
+
+var OrderServiceQueryOrderIdResult_Success_DEFAULT *QueryOrderIdResponse
+
+func (p *OrderServiceQueryOrderIdResult) GetSuccess() (v *QueryOrderIdResponse) {
+	if !p.IsSetSuccess() {
+		return OrderServiceQueryOrderIdResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceQueryOrderIdResult) SetSuccess(x interface{}) {
+	p.Success = x.(*QueryOrderIdResponse)
+}
+
+func (p *OrderServiceQueryOrderIdResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceQueryOrderIdResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceQueryOrderIdResult(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceQueryOrderIdResult = map[int16]string{
+	0: "success",
+}
+
+type OrderServiceSearchOrdersArgs struct {
+	Req *SearchOrdersRequest `thrift:"req,1" frugal:"1,default,SearchOrdersRequest" json:"req"`
+}
+
+func NewOrderServiceSearchOrdersArgs() *OrderServiceSearchOrdersArgs {
+	return &OrderServiceSearchOrdersArgs{}
+}
+
+func (p *OrderServiceSearchOrdersArgs) InitDefault() {
+}
+
+var OrderServiceSearchOrdersArgs_Req_DEFAULT *SearchOrdersRequest
+
+func (p *OrderServiceSearchOrdersArgs) GetReq() (v *SearchOrdersRequest) {
+	if !p.IsSetReq() {
+		return OrderServiceSearchOrdersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceSearchOrdersArgs) SetReq(val *SearchOrdersRequest) {
+	p.Req = val
+}
+
+func (p *OrderServiceSearchOrdersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceSearchOrdersArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceSearchOrdersArgs(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceSearchOrdersArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServiceSearchOrdersResult struct {
+	Success *SearchOrdersResponse `thrift:"success,0,optional" frugal:"0,optional,SearchOrdersResponse" json:"success,omitempty"`
+}
+
+func NewOrderServiceSearchOrdersResult() *OrderServiceSearchOrdersResult {
+	return &OrderServiceSearchOrdersResult{}
+}
+
+func (p *OrderServiceSearchOrdersResult) InitDefault() {
+}
+
+var OrderServiceSearchOrdersResult_Success_DEFAULT *SearchOrdersResponse
+
+func (p *OrderServiceSearchOrdersResult) GetSuccess() (v *SearchOrdersResponse) {
+	if !p.IsSetSuccess() {
+		return OrderServiceSearchOrdersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceSearchOrdersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SearchOrdersResponse)
+}
+
+func (p *OrderServiceSearchOrdersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceSearchOrdersResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceSearchOrdersResult(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceSearchOrdersResult = map[int16]string{
+	0: "success",
+}
+
+type OrderServiceRequestRefundArgs struct {
+	Req *RequestRefundRequest `thrift:"req,1" frugal:"1,default,RequestRefundRequest" json:"req"`
+}
+
+func NewOrderServiceRequestRefundArgs() *OrderServiceRequestRefundArgs {
+	return &OrderServiceRequestRefundArgs{}
 }
-func (p *CreateRequest) SetReqUserId(val int64) {
-	p.ReqUserId = val
+
+func (p *OrderServiceRequestRefundArgs) InitDefault() {
 }
-func (p *CreateRequest) SetRespUserId(val int64) {
-	p.RespUserId = val
+
+var OrderServiceRequestRefundArgs_Req_DEFAULT *RequestRefundRequest
+
+func (p *OrderServiceRequestRefundArgs) GetReq() (v *RequestRefundRequest) {
+	if !p.IsSetReq() {
+		return OrderServiceRequestRefundArgs_Req_DEFAULT
+	}
+	return p.Req
 }
-func (p *CreateRequest) SetItems(val []*OrderItemForCreate) {
-	p.Items = val
+func (p *OrderServiceRequestRefundArgs) SetReq(val *RequestRefundRequest) {
+	p.Req = val
 }
-func (p *CreateRequest) SetExt(val map[string]string) {
-	p.Ext = val
+
+func (p *OrderServiceRequestRefundArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *CreateRequest) String() string {
+func (p *OrderServiceRequestRefundArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CreateRequest(%+v)", *p)
+	return fmt.Sprintf("OrderServiceRequestRefundArgs(%+v)", *p)
 }
 
-var fieldIDToName_CreateRequest = map[int16]string{
-	1: "type",
-	2: "status",
-	3: "req_user_id",
-	4: "resp_user_id",
-	5: "items",
-	6: "ext",
+var fieldIDToName_OrderServiceRequestRefundArgs = map[int16]string{
+	1: "req",
 }
 
-type CreateResponse struct {
-	BaseResp *base.BaseResponse `thrift:"baseResp,1" frugal:"1,default,base.BaseResponse" json:"baseResp"`
-	OrderId  string             `thrift:"order_id,2" frugal:"2,default,string" json:"order_id"`
+type OrderServiceRequestRefundResult struct {
+	Success *RequestRefundResponse `thrift:"success,0,optional" frugal:"0,optional,RequestRefundResponse" json:"success,omitempty"`
 }
 
-func NewCreateResponse() *CreateResponse {
-	return &CreateResponse{}
+func NewOrderServiceRequestRefundResult() *OrderServiceRequestRefundResult {
+	return &OrderServiceRequestRefundResult{}
 }
 
-func (p *CreateResponse) InitDefault() {
+func (p *OrderServiceRequestRefundResult) InitDefault() {
 }
 
-var CreateResponse_BaseResp_DEFAULT *base.BaseResponse
+var OrderServiceRequestRefundResult_Success_DEFAULT *RequestRefundResponse
 
-func (p *CreateResponse) GetBaseResp() (v *base.BaseResponse) {
-	if !p.IsSetBaseResp() {
-		return CreateResponse_BaseResp_DEFAULT
+func (p *OrderServiceRequestRefundResult) GetSuccess() (v *RequestRefundResponse) {
+	if !p.IsSetSuccess() {
+		return OrderServiceRequestRefundResult_Success_DEFAULT
 	}
-	return p.BaseResp
-}
-
-func (p *CreateResponse) GetOrderId() (v string) {
-	return p.OrderId
-}
-func (p *CreateResponse) SetBaseResp(val *base.BaseResponse) {
-	p.BaseResp = val
+	return p.Success
 }
-func (p *CreateResponse) SetOrderId(val string) {
-	p.OrderId = val
+func (p *OrderServiceRequestRefundResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RequestRefundResponse)
 }
 
-func (p *CreateResponse) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+func (p *OrderServiceRequestRefundResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-func (p *CreateResponse) String() string {
+func (p *OrderServiceRequestRefundResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CreateResponse(%+v)", *p)
-}
-
-var fieldIDToName_CreateResponse = map[int16]string{
-	1: "baseResp",
-	2: "order_id",
+	return fmt.Sprintf("OrderServiceRequestRefundResult(%+v)", *p)
 }
 
-type UpdateRequest struct {
-	Id     string            `thrift:"id,1" frugal:"1,default,string" json:"id"`
-	Status *int32            `thrift:"status,2,optional" frugal:"2,optional,i32" json:"status,omitempty"`
-	Ext    map[string]string `thrift:"ext,3,optional" frugal:"3,optional,map<string:string>" json:"ext,omitempty"`
+var fieldIDToName_OrderServiceRequestRefundResult = map[int16]string{
+	0: "success",
 }
 
-func NewUpdateRequest() *UpdateRequest {
-	return &UpdateRequest{}
+type OrderServiceReviewRefundArgs struct {
+	Req *ReviewRefundRequest `thrift:"req,1" frugal:"1,default,ReviewRefundRequest" json:"req"`
 }
 
-func (p *UpdateRequest) InitDefault() {
+func NewOrderServiceReviewRefundArgs() *OrderServiceReviewRefundArgs {
+	return &OrderServiceReviewRefundArgs{}
 }
 
-func (p *UpdateRequest) GetId() (v string) {
-	return p.Id
+func (p *OrderServiceReviewRefundArgs) InitDefault() {
 }
 
-var UpdateRequest_Status_DEFAULT int32
+var OrderServiceReviewRefundArgs_Req_DEFAULT *ReviewRefundRequest
 
-func (p *UpdateRequest) GetStatus() (v int32) {
-	if !p.IsSetStatus() {
-		return UpdateRequest_Status_DEFAULT
+func (p *OrderServiceReviewRefundArgs) GetReq() (v *ReviewRefundRequest) {
+	if !p.IsSetReq() {
+		return OrderServiceReviewRefundArgs_Req_DEFAULT
 	}
-	return *p.Status
+	return p.Req
+}
+func (p *OrderServiceReviewRefundArgs) SetReq(val *ReviewRefundRequest) {
+	p.Req = val
 }
 
-var UpdateRequest_Ext_DEFAULT map[string]string
+func (p *OrderServiceReviewRefundArgs) IsSetReq() bool {
+	return p.Req != nil
+}
 
-func (p *UpdateRequest) GetExt() (v map[string]string) {
-	if !p.IsSetExt() {
-		return UpdateRequest_Ext_DEFAULT
+func (p *OrderServiceReviewRefundArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return p.Ext
+	return fmt.Sprintf("OrderServiceReviewRefundArgs(%+v)", *p)
 }
-func (p *UpdateRequest) SetId(val string) {
-	p.Id = val
+
+var fieldIDToName_OrderServiceReviewRefundArgs = map[int16]string{
+	1: "req",
 }
-func (p *UpdateRequest) SetStatus(val *int32) {
-	p.Status = val
+
+type OrderServiceReviewRefundResult struct {
+	Success *ReviewRefundResponse `thrift:"success,0,optional" frugal:"0,optional,ReviewRefundResponse" json:"success,omitempty"`
 }
-func (p *UpdateRequest) SetExt(val map[string]string) {
-	p.Ext = val
+
+func NewOrderServiceReviewRefundResult() *OrderServiceReviewRefundResult {
+	return &OrderServiceReviewRefundResult{}
 }
 
-func (p *UpdateRequest) IsSetStatus() bool {
-	return p.Status != nil
+func (p *OrderServiceReviewRefundResult) InitDefault() {
 }
 
-func (p *UpdateRequest) IsSetExt() bool {
-	return p.Ext != nil
+var OrderServiceReviewRefundResult_Success_DEFAULT *ReviewRefundResponse
+
+func (p *OrderServiceReviewRefundResult) GetSuccess() (v *ReviewRefundResponse) {
+	if !p.IsSetSuccess() {
+		return OrderServiceReviewRefundResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceReviewRefundResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ReviewRefundResponse)
 }
 
-func (p *UpdateRequest) String() string {
+func (p *OrderServiceReviewRefundResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceReviewRefundResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateRequest(%+v)", *p)
+	return fmt.Sprintf("OrderServiceReviewRefundResult(%+v)", *p)
 }
 
-var fieldIDToName_UpdateRequest = map[int16]string{
-	1: "id",
-	2: "status",
-	3: "ext",
+var fieldIDToName_OrderServiceReviewRefundResult = map[int16]string{
+	0: "success",
 }
 
-type UpdateResponse struct {
-	BaseResp *base.BaseResponse `thrift:"baseResp,1" frugal:"1,default,base.BaseResponse" json:"baseResp"`
+type OrderServiceCompleteRefundArgs struct {
+	Req *CompleteRefundRequest `thrift:"req,1" frugal:"1,default,CompleteRefundRequest" json:"req"`
 }
 
-func NewUpdateResponse() *UpdateResponse {
-	return &UpdateResponse{}
+func NewOrderServiceCompleteRefundArgs() *OrderServiceCompleteRefundArgs {
+	return &OrderServiceCompleteRefundArgs{}
 }
 
-func (p *UpdateResponse) InitDefault() {
+func (p *OrderServiceCompleteRefundArgs) InitDefault() {
 }
 
-var UpdateResponse_BaseResp_DEFAULT *base.BaseResponse
+var OrderServiceCompleteRefundArgs_Req_DEFAULT *CompleteRefundRequest
 
-func (p *UpdateResponse) GetBaseResp() (v *base.BaseResponse) {
-	if !p.IsSetBaseResp() {
-		return UpdateResponse_BaseResp_DEFAULT
+func (p *OrderServiceCompleteRefundArgs) GetReq() (v *CompleteRefundRequest) {
+	if !p.IsSetReq() {
+		return OrderServiceCompleteRefundArgs_Req_DEFAULT
 	}
-	return p.BaseResp
+	return p.Req
 }
-func (p *UpdateResponse) SetBaseResp(val *base.BaseResponse) {
-	p.BaseResp = val
+func (p *OrderServiceCompleteRefundArgs) SetReq(val *CompleteRefundRequest) {
+	p.Req = val
 }
 
-func (p *UpdateResponse) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+func (p *OrderServiceCompleteRefundArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *UpdateResponse) String() string {
+func (p *OrderServiceCompleteRefundArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateResponse(%+v)", *p)
+	return fmt.Sprintf("OrderServiceCompleteRefundArgs(%+v)", *p)
 }
 
-var fieldIDToName_UpdateResponse = map[int16]string{
-	1: "baseResp",
+var fieldIDToName_OrderServiceCompleteRefundArgs = map[int16]string{
+	1: "req",
 }
 
-type QueryOrderInfoRequest struct {
-	Id string `thrift:"id,1" frugal:"1,default,string" json:"id"`
+type OrderServiceCompleteRefundResult struct {
+	Success *CompleteRefundResponse `thrift:"success,0,optional" frugal:"0,optional,CompleteRefundResponse" json:"success,omitempty"`
 }
 
-func NewQueryOrderInfoRequest() *QueryOrderInfoRequest {
-	return &QueryOrderInfoRequest{}
+func NewOrderServiceCompleteRefundResult() *OrderServiceCompleteRefundResult {
+	return &OrderServiceCompleteRefundResult{}
 }
 
-func (p *QueryOrderInfoRequest) InitDefault() {
+func (p *OrderServiceCompleteRefundResult) InitDefault() {
 }
 
-func (p *QueryOrderInfoRequest) GetId() (v string) {
-	return p.Id
+var OrderServiceCompleteRefundResult_Success_DEFAULT *CompleteRefundResponse
+
+func (p *OrderServiceCompleteRefundResult) GetSuccess() (v *CompleteRefundResponse) {
+	if !p.IsSetSuccess() {
+		return OrderServiceCompleteRefundResult_Success_DEFAULT
+	}
+	return p.Success
 }
-func (p *QueryOrderInfoRequest) SetId(val string) {
-	p.Id = val
+func (p *OrderServiceCompleteRefundResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CompleteRefundResponse)
 }
 
-func (p *QueryOrderInfoRequest) String() string {
+func (p *OrderServiceCompleteRefundResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceCompleteRefundResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("QueryOrderInfoRequest(%+v)", *p)
+	return fmt.Sprintf("OrderServiceCompleteRefundResult(%+v)", *p)
 }
 
-var fieldIDToName_QueryOrderInfoRequest = map[int16]string{
-	1: "id",
+var fieldIDToName_OrderServiceCompleteRefundResult = map[int16]string{
+	0: "success",
 }
 
-type QueryOrderInfoResponse struct {
-	BaseResp *base.BaseResponse `thrift:"baseResp,1" frugal:"1,default,base.BaseResponse" json:"baseResp"`
-	Order    *Order             `thrift:"order,2" frugal:"2,default,Order" json:"order"`
+type OrderServiceQueryRefundsArgs struct {
+	Req *QueryRefundsRequest `thrift:"req,1" frugal:"1,default,QueryRefundsRequest" json:"req"`
 }
 
-func NewQueryOrderInfoResponse() *QueryOrderInfoResponse {
-	return &QueryOrderInfoResponse{}
+func NewOrderServiceQueryRefundsArgs() *OrderServiceQueryRefundsArgs {
+	return &OrderServiceQueryRefundsArgs{}
 }
 
-func (p *QueryOrderInfoResponse) InitDefault() {
+func (p *OrderServiceQueryRefundsArgs) InitDefault() {
 }
 
-var QueryOrderInfoResponse_BaseResp_DEFAULT *base.BaseResponse
+var OrderServiceQueryRefundsArgs_Req_DEFAULT *QueryRefundsRequest
 
-func (p *QueryOrderInfoResponse) GetBaseResp() (v *base.BaseResponse) {
-	if !p.IsSetBaseResp() {
-		return QueryOrderInfoResponse_BaseResp_DEFAULT
+func (p *OrderServiceQueryRefundsArgs) GetReq() (v *QueryRefundsRequest) {
+	if !p.IsSetReq() {
+		return OrderServiceQueryRefundsArgs_Req_DEFAULT
 	}
-	return p.BaseResp
+	return p.Req
+}
+func (p *OrderServiceQueryRefundsArgs) SetReq(val *QueryRefundsRequest) {
+	p.Req = val
 }
 
-var QueryOrderInfoResponse_Order_DEFAULT *Order
+func (p *OrderServiceQueryRefundsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
 
-func (p *QueryOrderInfoResponse) GetOrder() (v *Order) {
-	if !p.IsSetOrder() {
-		return QueryOrderInfoResponse_Order_DEFAULT
+func (p *OrderServiceQueryRefundsArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return p.Order
+	return fmt.Sprintf("OrderServiceQueryRefundsArgs(%+v)", *p)
 }
-func (p *QueryOrderInfoResponse) SetBaseResp(val *base.BaseResponse) {
-	p.BaseResp = val
+
+var fieldIDToName_OrderServiceQueryRefundsArgs = map[int16]string{
+	1: "req",
 }
-func (p *QueryOrderInfoResponse) SetOrder(val *Order) {
-	p.Order = val
+
+type OrderServiceQueryRefundsResult struct {
+	Success *QueryRefundsResponse `thrift:"success,0,optional" frugal:"0,optional,QueryRefundsResponse" json:"success,omitempty"`
 }
 
-func (p *QueryOrderInfoResponse) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+func NewOrderServiceQueryRefundsResult() *OrderServiceQueryRefundsResult {
+	return &OrderServiceQueryRefundsResult{}
 }
 
-func (p *QueryOrderInfoResponse) IsSetOrder() bool {
-	return p.Order != nil
+func (p *OrderServiceQueryRefundsResult) InitDefault() {
 }
 
-func (p *QueryOrderInfoResponse) String() string {
-	if p == nil {
-		return "<nil>"
+var OrderServiceQueryRefundsResult_Success_DEFAULT *QueryRefundsResponse
+
+func (p *OrderServiceQueryRefundsResult) GetSuccess() (v *QueryRefundsResponse) {
+	if !p.IsSetSuccess() {
+		return OrderServiceQueryRefundsResult_Success_DEFAULT
 	}
-	return fmt.Sprintf("QueryOrderInfoResponse(%+v)", *p)
+	return p.Success
 }
-
-var fieldIDToName_QueryOrderInfoResponse = map[int16]string{
-	1: "baseResp",
-	2: "order",
+func (p *OrderServiceQueryRefundsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*QueryRefundsResponse)
 }
 
-type QueryOrderIdRequest struct {
-	Type     QueryOrderIdType `thrift:"type,1" frugal:"1,default,QueryOrderIdType" json:"type"`
-	UserId   *int64           `thrift:"user_id,2,optional" frugal:"2,optional,i64" json:"user_id,omitempty"`
-	ExtKey   *string          `thrift:"ext_key,3,optional" frugal:"3,optional,string" json:"ext_key,omitempty"`
-	ExtVal   *string          `thrift:"ext_val,4,optional" frugal:"4,optional,string" json:"ext_val,omitempty"`
-	Page     int32            `thrift:"page,5,optional" frugal:"5,optional,i32" json:"page,omitempty"`
-	PageSize int32            `thrift:"page_size,6,optional" frugal:"6,optional,i32" json:"page_size,omitempty"`
+func (p *OrderServiceQueryRefundsResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-func NewQueryOrderIdRequest() *QueryOrderIdRequest {
-	return &QueryOrderIdRequest{
-		Page:     1,
-		PageSize: 20,
+func (p *OrderServiceQueryRefundsResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("OrderServiceQueryRefundsResult(%+v)", *p)
 }
 
-func (p *QueryOrderIdRequest) InitDefault() {
-	p.Page = 1
-	p.PageSize = 20
+var fieldIDToName_OrderServiceQueryRefundsResult = map[int16]string{
+	0: "success",
 }
 
-func (p *QueryOrderIdRequest) GetType() (v QueryOrderIdType) {
-	return p.Type
+type OrderServiceCreateShipmentArgs struct {
+	Req *CreateShipmentRequest `thrift:"req,1" frugal:"1,default,CreateShipmentRequest" json:"req"`
 }
 
-var QueryOrderIdRequest_UserId_DEFAULT int64
+func NewOrderServiceCreateShipmentArgs() *OrderServiceCreateShipmentArgs {
+	return &OrderServiceCreateShipmentArgs{}
+}
 
-func (p *QueryOrderIdRequest) GetUserId() (v int64) {
-	if !p.IsSetUserId() {
-		return QueryOrderIdRequest_UserId_DEFAULT
-	}
-	return *p.UserId
+func (p *OrderServiceCreateShipmentArgs) InitDefault() {
 }
 
-var QueryOrderIdRequest_ExtKey_DEFAULT string
+var OrderServiceCreateShipmentArgs_Req_DEFAULT *CreateShipmentRequest
 
-func (p *QueryOrderIdRequest) GetExtKey() (v string) {
-	if !p.IsSetExtKey() {
-		return QueryOrderIdRequest_ExtKey_DEFAULT
+func (p *OrderServiceCreateShipmentArgs) GetReq() (v *CreateShipmentRequest) {
+	if !p.IsSetReq() {
+		return OrderServiceCreateShipmentArgs_Req_DEFAULT
 	}
-	return *p.ExtKey
+	return p.Req
+}
+func (p *OrderServiceCreateShipmentArgs) SetReq(val *CreateShipmentRequest) {
+	p.Req = val
 }
 
-var QueryOrderIdRequest_ExtVal_DEFAULT string
+func (p *OrderServiceCreateShipmentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
 
-func (p *QueryOrderIdRequest) GetExtVal() (v string) {
-	if !p.IsSetExtVal() {
-		return QueryOrderIdRequest_ExtVal_DEFAULT
+func (p *OrderServiceCreateShipmentArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.ExtVal
+	return fmt.Sprintf("OrderServiceCreateShipmentArgs(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceCreateShipmentArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServiceCreateShipmentResult struct {
+	Success *CreateShipmentResponse `thrift:"success,0,optional" frugal:"0,optional,CreateShipmentResponse" json:"success,omitempty"`
 }
 
-var QueryOrderIdRequest_Page_DEFAULT int32 = 1
+func NewOrderServiceCreateShipmentResult() *OrderServiceCreateShipmentResult {
+	return &OrderServiceCreateShipmentResult{}
+}
 
-func (p *QueryOrderIdRequest) GetPage() (v int32) {
-	if !p.IsSetPage() {
-		return QueryOrderIdRequest_Page_DEFAULT
-	}
-	return p.Page
+func (p *OrderServiceCreateShipmentResult) InitDefault() {
 }
 
-var QueryOrderIdRequest_PageSize_DEFAULT int32 = 20
+var OrderServiceCreateShipmentResult_Success_DEFAULT *CreateShipmentResponse
 
-func (p *QueryOrderIdRequest) GetPageSize() (v int32) {
-	if !p.IsSetPageSize() {
-		return QueryOrderIdRequest_PageSize_DEFAULT
+func (p *OrderServiceCreateShipmentResult) GetSuccess() (v *CreateShipmentResponse) {
+	if !p.IsSetSuccess() {
+		return OrderServiceCreateShipmentResult_Success_DEFAULT
 	}
-	return p.PageSize
-}
-func (p *QueryOrderIdRequest) SetType(val QueryOrderIdType) {
-	p.Type = val
-}
-func (p *QueryOrderIdRequest) SetUserId(val *int64) {
-	p.UserId = val
+	return p.Success
 }
-func (p *QueryOrderIdRequest) SetExtKey(val *string) {
-	p.ExtKey = val
+func (p *OrderServiceCreateShipmentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateShipmentResponse)
 }
-func (p *QueryOrderIdRequest) SetExtVal(val *string) {
-	p.ExtVal = val
+
+func (p *OrderServiceCreateShipmentResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
-func (p *QueryOrderIdRequest) SetPage(val int32) {
-	p.Page = val
+
+func (p *OrderServiceCreateShipmentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceCreateShipmentResult(%+v)", *p)
 }
-func (p *QueryOrderIdRequest) SetPageSize(val int32) {
-	p.PageSize = val
+
+var fieldIDToName_OrderServiceCreateShipmentResult = map[int16]string{
+	0: "success",
 }
 
-func (p *QueryOrderIdRequest) IsSetUserId() bool {
-	return p.UserId != nil
+type OrderServiceAddTrackingEventArgs struct {
+	Req *AddTrackingEventRequest `thrift:"req,1" frugal:"1,default,AddTrackingEventRequest" json:"req"`
 }
 
-func (p *QueryOrderIdRequest) IsSetExtKey() bool {
-	return p.ExtKey != nil
+func NewOrderServiceAddTrackingEventArgs() *OrderServiceAddTrackingEventArgs {
+	return &OrderServiceAddTrackingEventArgs{}
 }
 
-func (p *QueryOrderIdRequest) IsSetExtVal() bool {
-	return p.ExtVal != nil
+func (p *OrderServiceAddTrackingEventArgs) InitDefault() {
 }
 
-func (p *QueryOrderIdRequest) IsSetPage() bool {
-	return p.Page != QueryOrderIdRequest_Page_DEFAULT
+var OrderServiceAddTrackingEventArgs_Req_DEFAULT *AddTrackingEventRequest
+
+func (p *OrderServiceAddTrackingEventArgs) GetReq() (v *AddTrackingEventRequest) {
+	if !p.IsSetReq() {
+		return OrderServiceAddTrackingEventArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceAddTrackingEventArgs) SetReq(val *AddTrackingEventRequest) {
+	p.Req = val
 }
 
-func (p *QueryOrderIdRequest) IsSetPageSize() bool {
-	return p.PageSize != QueryOrderIdRequest_PageSize_DEFAULT
+func (p *OrderServiceAddTrackingEventArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *QueryOrderIdRequest) String() string {
+func (p *OrderServiceAddTrackingEventArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("QueryOrderIdRequest(%+v)", *p)
+	return fmt.Sprintf("OrderServiceAddTrackingEventArgs(%+v)", *p)
 }
 
-var fieldIDToName_QueryOrderIdRequest = map[int16]string{
-	1: "type",
-	2: "user_id",
-	3: "ext_key",
-	4: "ext_val",
-	5: "page",
-	6: "page_size",
+var fieldIDToName_OrderServiceAddTrackingEventArgs = map[int16]string{
+	1: "req",
 }
 
-type QueryOrderIdResponse struct {
-	BaseResp *base.BaseResponse `thrift:"baseResp,1" frugal:"1,default,base.BaseResponse" json:"baseResp"`
-	OrderId  []string           `thrift:"order_id,2" frugal:"2,default,list<string>" json:"order_id"`
-	Total    int32              `thrift:"total,3" frugal:"3,default,i32" json:"total"`
-	Page     int32              `thrift:"page,4" frugal:"4,default,i32" json:"page"`
-	PageSize int32              `thrift:"page_size,5" frugal:"5,default,i32" json:"page_size"`
+type OrderServiceAddTrackingEventResult struct {
+	Success *AddTrackingEventResponse `thrift:"success,0,optional" frugal:"0,optional,AddTrackingEventResponse" json:"success,omitempty"`
 }
 
-func NewQueryOrderIdResponse() *QueryOrderIdResponse {
-	return &QueryOrderIdResponse{}
+func NewOrderServiceAddTrackingEventResult() *OrderServiceAddTrackingEventResult {
+	return &OrderServiceAddTrackingEventResult{}
 }
 
-func (p *QueryOrderIdResponse) InitDefault() {
+func (p *OrderServiceAddTrackingEventResult) InitDefault() {
 }
 
-var QueryOrderIdResponse_BaseResp_DEFAULT *base.BaseResponse
+var OrderServiceAddTrackingEventResult_Success_DEFAULT *AddTrackingEventResponse
 
-func (p *QueryOrderIdResponse) GetBaseResp() (v *base.BaseResponse) {
-	if !p.IsSetBaseResp() {
-		return QueryOrderIdResponse_BaseResp_DEFAULT
+func (p *OrderServiceAddTrackingEventResult) GetSuccess() (v *AddTrackingEventResponse) {
+	if !p.IsSetSuccess() {
+		return OrderServiceAddTrackingEventResult_Success_DEFAULT
 	}
-	return p.BaseResp
+	return p.Success
 }
-
-func (p *QueryOrderIdResponse) GetOrderId() (v []string) {
-	return p.OrderId
+func (p *OrderServiceAddTrackingEventResult) SetSuccess(x interface{}) {
+	p.Success = x.(*AddTrackingEventResponse)
 }
 
-func (p *QueryOrderIdResponse) GetTotal() (v int32) {
-	return p.Total
+func (p *OrderServiceAddTrackingEventResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-func (p *QueryOrderIdResponse) GetPage() (v int32) {
-	return p.Page
+func (p *OrderServiceAddTrackingEventResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceAddTrackingEventResult(%+v)", *p)
 }
 
-func (p *QueryOrderIdResponse) GetPageSize() (v int32) {
-	return p.PageSize
+var fieldIDToName_OrderServiceAddTrackingEventResult = map[int16]string{
+	0: "success",
 }
-func (p *QueryOrderIdResponse) SetBaseResp(val *base.BaseResponse) {
-	p.BaseResp = val
+
+type OrderServiceConfirmDeliveryArgs struct {
+	Req *ConfirmDeliveryRequest `thrift:"req,1" frugal:"1,default,ConfirmDeliveryRequest" json:"req"`
 }
-func (p *QueryOrderIdResponse) SetOrderId(val []string) {
-	p.OrderId = val
+
+func NewOrderServiceConfirmDeliveryArgs() *OrderServiceConfirmDeliveryArgs {
+	return &OrderServiceConfirmDeliveryArgs{}
 }
-func (p *QueryOrderIdResponse) SetTotal(val int32) {
-	p.Total = val
+
+func (p *OrderServiceConfirmDeliveryArgs) InitDefault() {
 }
-func (p *QueryOrderIdResponse) SetPage(val int32) {
-	p.Page = val
+
+var OrderServiceConfirmDeliveryArgs_Req_DEFAULT *ConfirmDeliveryRequest
+
+func (p *OrderServiceConfirmDeliveryArgs) GetReq() (v *ConfirmDeliveryRequest) {
+	if !p.IsSetReq() {
+		return OrderServiceConfirmDeliveryArgs_Req_DEFAULT
+	}
+	return p.Req
 }
-func (p *QueryOrderIdResponse) SetPageSize(val int32) {
-	p.PageSize = val
+func (p *OrderServiceConfirmDeliveryArgs) SetReq(val *ConfirmDeliveryRequest) {
+	p.Req = val
 }
 
-func (p *QueryOrderIdResponse) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+func (p *OrderServiceConfirmDeliveryArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *QueryOrderIdResponse) String() string {
+func (p *OrderServiceConfirmDeliveryArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("QueryOrderIdResponse(%+v)", *p)
+	return fmt.Sprintf("OrderServiceConfirmDeliveryArgs(%+v)", *p)
 }
 
-var fieldIDToName_QueryOrderIdResponse = map[int16]string{
-	1: "baseResp",
-	2: "order_id",
-	3: "total",
-	4: "page",
-	5: "page_size",
+var fieldIDToName_OrderServiceConfirmDeliveryArgs = map[int16]string{
+	1: "req",
 }
 
-type OrderService interface {
-	Create(ctx context.Context, req *CreateRequest) (r *CreateResponse, err error)
+type OrderServiceConfirmDeliveryResult struct {
+	Success *ConfirmDeliveryResponse `thrift:"success,0,optional" frugal:"0,optional,ConfirmDeliveryResponse" json:"success,omitempty"`
+}
 
-	Update(ctx context.Context, req *UpdateRequest) (r *UpdateResponse, err error)
+func NewOrderServiceConfirmDeliveryResult() *OrderServiceConfirmDeliveryResult {
+	return &OrderServiceConfirmDeliveryResult{}
+}
 
-	QueryOrderInfo(ctx context.Context, req *QueryOrderInfoRequest) (r *QueryOrderInfoResponse, err error)
+func (p *OrderServiceConfirmDeliveryResult) InitDefault() {
+}
 
-	QueryOrderId(ctx context.Context, req *QueryOrderIdRequest) (r *QueryOrderIdResponse, err error)
+var OrderServiceConfirmDeliveryResult_Success_DEFAULT *ConfirmDeliveryResponse
+
+func (p *OrderServiceConfirmDeliveryResult) GetSuccess() (v *ConfirmDeliveryResponse) {
+	if !p.IsSetSuccess() {
+		return OrderServiceConfirmDeliveryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceConfirmDeliveryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ConfirmDeliveryResponse)
 }
 
-type OrderServiceCreateArgs struct {
-	Req *CreateRequest `thrift:"req,1" frugal:"1,default,CreateRequest" json:"req"`
+func (p *OrderServiceConfirmDeliveryResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-func NewOrderServiceCreateArgs() *OrderServiceCreateArgs {
-	return &OrderServiceCreateArgs{}
+func (p *OrderServiceConfirmDeliveryResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceConfirmDeliveryResult(%+v)", *p)
 }
 
-func (p *OrderServiceCreateArgs) InitDefault() {
+var fieldIDToName_OrderServiceConfirmDeliveryResult = map[int16]string{
+	0: "success",
 }
 
-var OrderServiceCreateArgs_Req_DEFAULT *CreateRequest
+type OrderServiceDecodeIdArgs struct {
+	Req *DecodeIdRequest `thrift:"req,1" frugal:"1,default,DecodeIdRequest" json:"req"`
+}
 
-func (p *OrderServiceCreateArgs) GetReq() (v *CreateRequest) {
+func NewOrderServiceDecodeIdArgs() *OrderServiceDecodeIdArgs {
+	return &OrderServiceDecodeIdArgs{}
+}
+
+func (p *OrderServiceDecodeIdArgs) InitDefault() {
+}
+
+var OrderServiceDecodeIdArgs_Req_DEFAULT *DecodeIdRequest
+
+func (p *OrderServiceDecodeIdArgs) GetReq() (v *DecodeIdRequest) {
 	if !p.IsSetReq() {
-		return OrderServiceCreateArgs_Req_DEFAULT
+		return OrderServiceDecodeIdArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *OrderServiceCreateArgs) SetReq(val *CreateRequest) {
+func (p *OrderServiceDecodeIdArgs) SetReq(val *DecodeIdRequest) {
 	p.Req = val
 }
 
-func (p *OrderServiceCreateArgs) IsSetReq() bool {
+func (p *OrderServiceDecodeIdArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *OrderServiceCreateArgs) String() string {
+func (p *OrderServiceDecodeIdArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceCreateArgs(%+v)", *p)
+	return fmt.Sprintf("OrderServiceDecodeIdArgs(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceCreateArgs = map[int16]string{
+var fieldIDToName_OrderServiceDecodeIdArgs = map[int16]string{
 	1: "req",
 }
 
-type OrderServiceCreateResult struct {
-	Success *CreateResponse `thrift:"success,0,optional" frugal:"0,optional,CreateResponse" json:"success,omitempty"`
+type OrderServiceDecodeIdResult struct {
+	Success *DecodeIdResponse `thrift:"success,0,optional" frugal:"0,optional,DecodeIdResponse" json:"success,omitempty"`
 }
 
-func NewOrderServiceCreateResult() *OrderServiceCreateResult {
-	return &OrderServiceCreateResult{}
+func NewOrderServiceDecodeIdResult() *OrderServiceDecodeIdResult {
+	return &OrderServiceDecodeIdResult{}
 }
 
-func (p *OrderServiceCreateResult) InitDefault() {
+func (p *OrderServiceDecodeIdResult) InitDefault() {
 }
 
-var OrderServiceCreateResult_Success_DEFAULT *CreateResponse
+var OrderServiceDecodeIdResult_Success_DEFAULT *DecodeIdResponse
 
-func (p *OrderServiceCreateResult) GetSuccess() (v *CreateResponse) {
+func (p *OrderServiceDecodeIdResult) GetSuccess() (v *DecodeIdResponse) {
 	if !p.IsSetSuccess() {
-		return OrderServiceCreateResult_Success_DEFAULT
+		return OrderServiceDecodeIdResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *OrderServiceCreateResult) SetSuccess(x interface{}) {
-	p.Success = x.(*CreateResponse)
+func (p *OrderServiceDecodeIdResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DecodeIdResponse)
 }
 
-func (p *OrderServiceCreateResult) IsSetSuccess() bool {
+func (p *OrderServiceDecodeIdResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *OrderServiceCreateResult) String() string {
+func (p *OrderServiceDecodeIdResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceCreateResult(%+v)", *p)
+	return fmt.Sprintf("OrderServiceDecodeIdResult(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceCreateResult = map[int16]string{
+var fieldIDToName_OrderServiceDecodeIdResult = map[int16]string{
 	0: "success",
 }
 
-type OrderServiceUpdateArgs struct {
-	Req *UpdateRequest `thrift:"req,1" frugal:"1,default,UpdateRequest" json:"req"`
+type OrderServiceReportArgs struct {
+	Req *ReportRequest `thrift:"req,1" frugal:"1,default,ReportRequest" json:"req"`
 }
 
-func NewOrderServiceUpdateArgs() *OrderServiceUpdateArgs {
-	return &OrderServiceUpdateArgs{}
+func NewOrderServiceReportArgs() *OrderServiceReportArgs {
+	return &OrderServiceReportArgs{}
 }
 
-func (p *OrderServiceUpdateArgs) InitDefault() {
+func (p *OrderServiceReportArgs) InitDefault() {
 }
 
-var OrderServiceUpdateArgs_Req_DEFAULT *UpdateRequest
+var OrderServiceReportArgs_Req_DEFAULT *ReportRequest
 
-func (p *OrderServiceUpdateArgs) GetReq() (v *UpdateRequest) {
+func (p *OrderServiceReportArgs) GetReq() (v *ReportRequest) {
 	if !p.IsSetReq() {
-		return OrderServiceUpdateArgs_Req_DEFAULT
+		return OrderServiceReportArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *OrderServiceUpdateArgs) SetReq(val *UpdateRequest) {
+func (p *OrderServiceReportArgs) SetReq(val *ReportRequest) {
 	p.Req = val
 }
 
-func (p *OrderServiceUpdateArgs) IsSetReq() bool {
+func (p *OrderServiceReportArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *OrderServiceUpdateArgs) String() string {
+func (p *OrderServiceReportArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceUpdateArgs(%+v)", *p)
+	return fmt.Sprintf("OrderServiceReportArgs(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceUpdateArgs = map[int16]string{
+var fieldIDToName_OrderServiceReportArgs = map[int16]string{
 	1: "req",
 }
 
-type OrderServiceUpdateResult struct {
-	Success *UpdateResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateResponse" json:"success,omitempty"`
+type OrderServiceReportResult struct {
+	Success *ReportResponse `thrift:"success,0,optional" frugal:"0,optional,ReportResponse" json:"success,omitempty"`
 }
 
-func NewOrderServiceUpdateResult() *OrderServiceUpdateResult {
-	return &OrderServiceUpdateResult{}
+func NewOrderServiceReportResult() *OrderServiceReportResult {
+	return &OrderServiceReportResult{}
 }
 
-func (p *OrderServiceUpdateResult) InitDefault() {
+func (p *OrderServiceReportResult) InitDefault() {
 }
 
-var OrderServiceUpdateResult_Success_DEFAULT *UpdateResponse
+var OrderServiceReportResult_Success_DEFAULT *ReportResponse
 
-func (p *OrderServiceUpdateResult) GetSuccess() (v *UpdateResponse) {
+func (p *OrderServiceReportResult) GetSuccess() (v *ReportResponse) {
 	if !p.IsSetSuccess() {
-		return OrderServiceUpdateResult_Success_DEFAULT
+		return OrderServiceReportResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *OrderServiceUpdateResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UpdateResponse)
+func (p *OrderServiceReportResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ReportResponse)
 }
 
-func (p *OrderServiceUpdateResult) IsSetSuccess() bool {
+func (p *OrderServiceReportResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *OrderServiceUpdateResult) String() string {
+func (p *OrderServiceReportResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceUpdateResult(%+v)", *p)
+	return fmt.Sprintf("OrderServiceReportResult(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceUpdateResult = map[int16]string{
+var fieldIDToName_OrderServiceReportResult = map[int16]string{
 	0: "success",
 }
 
-type OrderServiceQueryOrderInfoArgs struct {
-	Req *QueryOrderInfoRequest `thrift:"req,1" frugal:"1,default,QueryOrderInfoRequest" json:"req"`
+type OrderServiceCreateFromCartArgs struct {
+	Req *CreateFromCartRequest `thrift:"req,1" frugal:"1,default,CreateFromCartRequest" json:"req"`
 }
 
-func NewOrderServiceQueryOrderInfoArgs() *OrderServiceQueryOrderInfoArgs {
-	return &OrderServiceQueryOrderInfoArgs{}
+func NewOrderServiceCreateFromCartArgs() *OrderServiceCreateFromCartArgs {
+	return &OrderServiceCreateFromCartArgs{}
 }
 
-func (p *OrderServiceQueryOrderInfoArgs) InitDefault() {
+func (p *OrderServiceCreateFromCartArgs) InitDefault() {
 }
 
-var OrderServiceQueryOrderInfoArgs_Req_DEFAULT *QueryOrderInfoRequest
+var OrderServiceCreateFromCartArgs_Req_DEFAULT *CreateFromCartRequest
 
-func (p *OrderServiceQueryOrderInfoArgs) GetReq() (v *QueryOrderInfoRequest) {
+func (p *OrderServiceCreateFromCartArgs) GetReq() (v *CreateFromCartRequest) {
 	if !p.IsSetReq() {
-		return OrderServiceQueryOrderInfoArgs_Req_DEFAULT
+		return OrderServiceCreateFromCartArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *OrderServiceQueryOrderInfoArgs) SetReq(val *QueryOrderInfoRequest) {
+func (p *OrderServiceCreateFromCartArgs) SetReq(val *CreateFromCartRequest) {
 	p.Req = val
 }
 
-func (p *OrderServiceQueryOrderInfoArgs) IsSetReq() bool {
+func (p *OrderServiceCreateFromCartArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *OrderServiceQueryOrderInfoArgs) String() string {
+func (p *OrderServiceCreateFromCartArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceQueryOrderInfoArgs(%+v)", *p)
+	return fmt.Sprintf("OrderServiceCreateFromCartArgs(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceQueryOrderInfoArgs = map[int16]string{
+var fieldIDToName_OrderServiceCreateFromCartArgs = map[int16]string{
 	1: "req",
 }
 
-type OrderServiceQueryOrderInfoResult struct {
-	Success *QueryOrderInfoResponse `thrift:"success,0,optional" frugal:"0,optional,QueryOrderInfoResponse" json:"success,omitempty"`
+type OrderServiceCreateFromCartResult struct {
+	Success *CreateFromCartResponse `thrift:"success,0,optional" frugal:"0,optional,CreateFromCartResponse" json:"success,omitempty"`
 }
 
-func NewOrderServiceQueryOrderInfoResult() *OrderServiceQueryOrderInfoResult {
-	return &OrderServiceQueryOrderInfoResult{}
+func NewOrderServiceCreateFromCartResult() *OrderServiceCreateFromCartResult {
+	return &OrderServiceCreateFromCartResult{}
 }
 
-func (p *OrderServiceQueryOrderInfoResult) InitDefault() {
+func (p *OrderServiceCreateFromCartResult) InitDefault() {
 }
 
-var OrderServiceQueryOrderInfoResult_Success_DEFAULT *QueryOrderInfoResponse
+var OrderServiceCreateFromCartResult_Success_DEFAULT *CreateFromCartResponse
 
-func (p *OrderServiceQueryOrderInfoResult) GetSuccess() (v *QueryOrderInfoResponse) {
+func (p *OrderServiceCreateFromCartResult) GetSuccess() (v *CreateFromCartResponse) {
 	if !p.IsSetSuccess() {
-		return OrderServiceQueryOrderInfoResult_Success_DEFAULT
+		return OrderServiceCreateFromCartResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *OrderServiceQueryOrderInfoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*QueryOrderInfoResponse)
+func (p *OrderServiceCreateFromCartResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateFromCartResponse)
 }
 
-func (p *OrderServiceQueryOrderInfoResult) IsSetSuccess() bool {
+func (p *OrderServiceCreateFromCartResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *OrderServiceQueryOrderInfoResult) String() string {
+func (p *OrderServiceCreateFromCartResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceQueryOrderInfoResult(%+v)", *p)
+	return fmt.Sprintf("OrderServiceCreateFromCartResult(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceQueryOrderInfoResult = map[int16]string{
+var fieldIDToName_OrderServiceCreateFromCartResult = map[int16]string{
 	0: "success",
 }
 
-type OrderServiceQueryOrderIdArgs struct {
-	Req *QueryOrderIdRequest `thrift:"req,1" frugal:"1,default,QueryOrderIdRequest" json:"req"`
+type OrderServiceQueryParentOrderArgs struct {
+	Req *QueryParentOrderRequest `thrift:"req,1" frugal:"1,default,QueryParentOrderRequest" json:"req"`
 }
 
-func NewOrderServiceQueryOrderIdArgs() *OrderServiceQueryOrderIdArgs {
-	return &OrderServiceQueryOrderIdArgs{}
+func NewOrderServiceQueryParentOrderArgs() *OrderServiceQueryParentOrderArgs {
+	return &OrderServiceQueryParentOrderArgs{}
 }
 
-func (p *OrderServiceQueryOrderIdArgs) InitDefault() {
+func (p *OrderServiceQueryParentOrderArgs) InitDefault() {
 }
 
-var OrderServiceQueryOrderIdArgs_Req_DEFAULT *QueryOrderIdRequest
+var OrderServiceQueryParentOrderArgs_Req_DEFAULT *QueryParentOrderRequest
 
-func (p *OrderServiceQueryOrderIdArgs) GetReq() (v *QueryOrderIdRequest) {
+func (p *OrderServiceQueryParentOrderArgs) GetReq() (v *QueryParentOrderRequest) {
 	if !p.IsSetReq() {
-		return OrderServiceQueryOrderIdArgs_Req_DEFAULT
+		return OrderServiceQueryParentOrderArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *OrderServiceQueryOrderIdArgs) SetReq(val *QueryOrderIdRequest) {
+func (p *OrderServiceQueryParentOrderArgs) SetReq(val *QueryParentOrderRequest) {
 	p.Req = val
 }
 
-func (p *OrderServiceQueryOrderIdArgs) IsSetReq() bool {
+func (p *OrderServiceQueryParentOrderArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *OrderServiceQueryOrderIdArgs) String() string {
+func (p *OrderServiceQueryParentOrderArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceQueryOrderIdArgs(%+v)", *p)
+	return fmt.Sprintf("OrderServiceQueryParentOrderArgs(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceQueryOrderIdArgs = map[int16]string{
+var fieldIDToName_OrderServiceQueryParentOrderArgs = map[int16]string{
 	1: "req",
 }
 
-type OrderServiceQueryOrderIdResult struct {
-	Success *QueryOrderIdResponse `thrift:"success,0,optional" frugal:"0,optional,QueryOrderIdResponse" json:"success,omitempty"`
+type OrderServiceQueryParentOrderResult struct {
+	Success *QueryParentOrderResponse `thrift:"success,0,optional" frugal:"0,optional,QueryParentOrderResponse" json:"success,omitempty"`
 }
 
-func NewOrderServiceQueryOrderIdResult() *OrderServiceQueryOrderIdResult {
-	return &OrderServiceQueryOrderIdResult{}
+func NewOrderServiceQueryParentOrderResult() *OrderServiceQueryParentOrderResult {
+	return &OrderServiceQueryParentOrderResult{}
 }
 
-func (p *OrderServiceQueryOrderIdResult) InitDefault() {
+func (p *OrderServiceQueryParentOrderResult) InitDefault() {
 }
 
-var OrderServiceQueryOrderIdResult_Success_DEFAULT *QueryOrderIdResponse
+var OrderServiceQueryParentOrderResult_Success_DEFAULT *QueryParentOrderResponse
 
-func (p *OrderServiceQueryOrderIdResult) GetSuccess() (v *QueryOrderIdResponse) {
+func (p *OrderServiceQueryParentOrderResult) GetSuccess() (v *QueryParentOrderResponse) {
 	if !p.IsSetSuccess() {
-		return OrderServiceQueryOrderIdResult_Success_DEFAULT
+		return OrderServiceQueryParentOrderResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *OrderServiceQueryOrderIdResult) SetSuccess(x interface{}) {
-	p.Success = x.(*QueryOrderIdResponse)
+func (p *OrderServiceQueryParentOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*QueryParentOrderResponse)
 }
 
-func (p *OrderServiceQueryOrderIdResult) IsSetSuccess() bool {
+func (p *OrderServiceQueryParentOrderResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *OrderServiceQueryOrderIdResult) String() string {
+func (p *OrderServiceQueryParentOrderResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OrderServiceQueryOrderIdResult(%+v)", *p)
+	return fmt.Sprintf("OrderServiceQueryParentOrderResult(%+v)", *p)
 }
 
-var fieldIDToName_OrderServiceQueryOrderIdResult = map[int16]string{
+var fieldIDToName_OrderServiceQueryParentOrderResult = map[int16]string{
 	0: "success",
 }
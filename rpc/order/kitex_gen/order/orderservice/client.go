@@ -14,7 +14,20 @@ type Client interface {
 	Create(ctx context.Context, req *order.CreateRequest, callOptions ...callopt.Option) (r *order.CreateResponse, err error)
 	Update(ctx context.Context, req *order.UpdateRequest, callOptions ...callopt.Option) (r *order.UpdateResponse, err error)
 	QueryOrderInfo(ctx context.Context, req *order.QueryOrderInfoRequest, callOptions ...callopt.Option) (r *order.QueryOrderInfoResponse, err error)
+	BatchQueryOrderInfo(ctx context.Context, req *order.BatchQueryOrderInfoRequest, callOptions ...callopt.Option) (r *order.BatchQueryOrderInfoResponse, err error)
 	QueryOrderId(ctx context.Context, req *order.QueryOrderIdRequest, callOptions ...callopt.Option) (r *order.QueryOrderIdResponse, err error)
+	SearchOrders(ctx context.Context, req *order.SearchOrdersRequest, callOptions ...callopt.Option) (r *order.SearchOrdersResponse, err error)
+	RequestRefund(ctx context.Context, req *order.RequestRefundRequest, callOptions ...callopt.Option) (r *order.RequestRefundResponse, err error)
+	ReviewRefund(ctx context.Context, req *order.ReviewRefundRequest, callOptions ...callopt.Option) (r *order.ReviewRefundResponse, err error)
+	CompleteRefund(ctx context.Context, req *order.CompleteRefundRequest, callOptions ...callopt.Option) (r *order.CompleteRefundResponse, err error)
+	QueryRefunds(ctx context.Context, req *order.QueryRefundsRequest, callOptions ...callopt.Option) (r *order.QueryRefundsResponse, err error)
+	CreateShipment(ctx context.Context, req *order.CreateShipmentRequest, callOptions ...callopt.Option) (r *order.CreateShipmentResponse, err error)
+	AddTrackingEvent(ctx context.Context, req *order.AddTrackingEventRequest, callOptions ...callopt.Option) (r *order.AddTrackingEventResponse, err error)
+	ConfirmDelivery(ctx context.Context, req *order.ConfirmDeliveryRequest, callOptions ...callopt.Option) (r *order.ConfirmDeliveryResponse, err error)
+	DecodeId(ctx context.Context, req *order.DecodeIdRequest, callOptions ...callopt.Option) (r *order.DecodeIdResponse, err error)
+	Report(ctx context.Context, req *order.ReportRequest, callOptions ...callopt.Option) (r *order.ReportResponse, err error)
+	CreateFromCart(ctx context.Context, req *order.CreateFromCartRequest, callOptions ...callopt.Option) (r *order.CreateFromCartResponse, err error)
+	QueryParentOrder(ctx context.Context, req *order.QueryParentOrderRequest, callOptions ...callopt.Option) (r *order.QueryParentOrderResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -61,7 +74,72 @@ func (p *kOrderServiceClient) QueryOrderInfo(ctx context.Context, req *order.Que
 	return p.kClient.QueryOrderInfo(ctx, req)
 }
 
+func (p *kOrderServiceClient) BatchQueryOrderInfo(ctx context.Context, req *order.BatchQueryOrderInfoRequest, callOptions ...callopt.Option) (r *order.BatchQueryOrderInfoResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BatchQueryOrderInfo(ctx, req)
+}
+
 func (p *kOrderServiceClient) QueryOrderId(ctx context.Context, req *order.QueryOrderIdRequest, callOptions ...callopt.Option) (r *order.QueryOrderIdResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.QueryOrderId(ctx, req)
 }
+
+func (p *kOrderServiceClient) SearchOrders(ctx context.Context, req *order.SearchOrdersRequest, callOptions ...callopt.Option) (r *order.SearchOrdersResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SearchOrders(ctx, req)
+}
+
+func (p *kOrderServiceClient) RequestRefund(ctx context.Context, req *order.RequestRefundRequest, callOptions ...callopt.Option) (r *order.RequestRefundResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RequestRefund(ctx, req)
+}
+
+func (p *kOrderServiceClient) ReviewRefund(ctx context.Context, req *order.ReviewRefundRequest, callOptions ...callopt.Option) (r *order.ReviewRefundResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReviewRefund(ctx, req)
+}
+
+func (p *kOrderServiceClient) CompleteRefund(ctx context.Context, req *order.CompleteRefundRequest, callOptions ...callopt.Option) (r *order.CompleteRefundResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CompleteRefund(ctx, req)
+}
+
+func (p *kOrderServiceClient) QueryRefunds(ctx context.Context, req *order.QueryRefundsRequest, callOptions ...callopt.Option) (r *order.QueryRefundsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.QueryRefunds(ctx, req)
+}
+
+func (p *kOrderServiceClient) CreateShipment(ctx context.Context, req *order.CreateShipmentRequest, callOptions ...callopt.Option) (r *order.CreateShipmentResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateShipment(ctx, req)
+}
+
+func (p *kOrderServiceClient) AddTrackingEvent(ctx context.Context, req *order.AddTrackingEventRequest, callOptions ...callopt.Option) (r *order.AddTrackingEventResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AddTrackingEvent(ctx, req)
+}
+
+func (p *kOrderServiceClient) ConfirmDelivery(ctx context.Context, req *order.ConfirmDeliveryRequest, callOptions ...callopt.Option) (r *order.ConfirmDeliveryResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ConfirmDelivery(ctx, req)
+}
+
+func (p *kOrderServiceClient) DecodeId(ctx context.Context, req *order.DecodeIdRequest, callOptions ...callopt.Option) (r *order.DecodeIdResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DecodeId(ctx, req)
+}
+
+func (p *kOrderServiceClient) Report(ctx context.Context, req *order.ReportRequest, callOptions ...callopt.Option) (r *order.ReportResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Report(ctx, req)
+}
+
+func (p *kOrderServiceClient) CreateFromCart(ctx context.Context, req *order.CreateFromCartRequest, callOptions ...callopt.Option) (r *order.CreateFromCartResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateFromCart(ctx, req)
+}
+
+func (p *kOrderServiceClient) QueryParentOrder(ctx context.Context, req *order.QueryParentOrderRequest, callOptions ...callopt.Option) (r *order.QueryParentOrderResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.QueryParentOrder(ctx, req)
+}
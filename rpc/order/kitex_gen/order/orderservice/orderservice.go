@@ -34,6 +34,13 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"BatchQueryOrderInfo": kitex.NewMethodInfo(
+		batchQueryOrderInfoHandler,
+		newOrderServiceBatchQueryOrderInfoArgs,
+		newOrderServiceBatchQueryOrderInfoResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"QueryOrderId": kitex.NewMethodInfo(
 		queryOrderIdHandler,
 		newOrderServiceQueryOrderIdArgs,
@@ -41,6 +48,90 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"SearchOrders": kitex.NewMethodInfo(
+		searchOrdersHandler,
+		newOrderServiceSearchOrdersArgs,
+		newOrderServiceSearchOrdersResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RequestRefund": kitex.NewMethodInfo(
+		requestRefundHandler,
+		newOrderServiceRequestRefundArgs,
+		newOrderServiceRequestRefundResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ReviewRefund": kitex.NewMethodInfo(
+		reviewRefundHandler,
+		newOrderServiceReviewRefundArgs,
+		newOrderServiceReviewRefundResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CompleteRefund": kitex.NewMethodInfo(
+		completeRefundHandler,
+		newOrderServiceCompleteRefundArgs,
+		newOrderServiceCompleteRefundResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"QueryRefunds": kitex.NewMethodInfo(
+		queryRefundsHandler,
+		newOrderServiceQueryRefundsArgs,
+		newOrderServiceQueryRefundsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CreateShipment": kitex.NewMethodInfo(
+		createShipmentHandler,
+		newOrderServiceCreateShipmentArgs,
+		newOrderServiceCreateShipmentResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"AddTrackingEvent": kitex.NewMethodInfo(
+		addTrackingEventHandler,
+		newOrderServiceAddTrackingEventArgs,
+		newOrderServiceAddTrackingEventResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ConfirmDelivery": kitex.NewMethodInfo(
+		confirmDeliveryHandler,
+		newOrderServiceConfirmDeliveryArgs,
+		newOrderServiceConfirmDeliveryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DecodeId": kitex.NewMethodInfo(
+		decodeIdHandler,
+		newOrderServiceDecodeIdArgs,
+		newOrderServiceDecodeIdResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"Report": kitex.NewMethodInfo(
+		reportHandler,
+		newOrderServiceReportArgs,
+		newOrderServiceReportResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CreateFromCart": kitex.NewMethodInfo(
+		createFromCartHandler,
+		newOrderServiceCreateFromCartArgs,
+		newOrderServiceCreateFromCartResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"QueryParentOrder": kitex.NewMethodInfo(
+		queryParentOrderHandler,
+		newOrderServiceQueryParentOrderArgs,
+		newOrderServiceQueryParentOrderResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -161,6 +252,24 @@ func newOrderServiceQueryOrderInfoResult() interface{} {
 	return order.NewOrderServiceQueryOrderInfoResult()
 }
 
+func batchQueryOrderInfoHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceBatchQueryOrderInfoArgs)
+	realResult := result.(*order.OrderServiceBatchQueryOrderInfoResult)
+	success, err := handler.(order.OrderService).BatchQueryOrderInfo(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceBatchQueryOrderInfoArgs() interface{} {
+	return order.NewOrderServiceBatchQueryOrderInfoArgs()
+}
+
+func newOrderServiceBatchQueryOrderInfoResult() interface{} {
+	return order.NewOrderServiceBatchQueryOrderInfoResult()
+}
+
 func queryOrderIdHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*order.OrderServiceQueryOrderIdArgs)
 	realResult := result.(*order.OrderServiceQueryOrderIdResult)
@@ -179,6 +288,222 @@ func newOrderServiceQueryOrderIdResult() interface{} {
 	return order.NewOrderServiceQueryOrderIdResult()
 }
 
+func searchOrdersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceSearchOrdersArgs)
+	realResult := result.(*order.OrderServiceSearchOrdersResult)
+	success, err := handler.(order.OrderService).SearchOrders(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceSearchOrdersArgs() interface{} {
+	return order.NewOrderServiceSearchOrdersArgs()
+}
+
+func newOrderServiceSearchOrdersResult() interface{} {
+	return order.NewOrderServiceSearchOrdersResult()
+}
+
+func requestRefundHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceRequestRefundArgs)
+	realResult := result.(*order.OrderServiceRequestRefundResult)
+	success, err := handler.(order.OrderService).RequestRefund(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceRequestRefundArgs() interface{} {
+	return order.NewOrderServiceRequestRefundArgs()
+}
+
+func newOrderServiceRequestRefundResult() interface{} {
+	return order.NewOrderServiceRequestRefundResult()
+}
+
+func reviewRefundHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceReviewRefundArgs)
+	realResult := result.(*order.OrderServiceReviewRefundResult)
+	success, err := handler.(order.OrderService).ReviewRefund(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceReviewRefundArgs() interface{} {
+	return order.NewOrderServiceReviewRefundArgs()
+}
+
+func newOrderServiceReviewRefundResult() interface{} {
+	return order.NewOrderServiceReviewRefundResult()
+}
+
+func completeRefundHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceCompleteRefundArgs)
+	realResult := result.(*order.OrderServiceCompleteRefundResult)
+	success, err := handler.(order.OrderService).CompleteRefund(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceCompleteRefundArgs() interface{} {
+	return order.NewOrderServiceCompleteRefundArgs()
+}
+
+func newOrderServiceCompleteRefundResult() interface{} {
+	return order.NewOrderServiceCompleteRefundResult()
+}
+
+func queryRefundsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceQueryRefundsArgs)
+	realResult := result.(*order.OrderServiceQueryRefundsResult)
+	success, err := handler.(order.OrderService).QueryRefunds(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceQueryRefundsArgs() interface{} {
+	return order.NewOrderServiceQueryRefundsArgs()
+}
+
+func newOrderServiceQueryRefundsResult() interface{} {
+	return order.NewOrderServiceQueryRefundsResult()
+}
+
+func createShipmentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceCreateShipmentArgs)
+	realResult := result.(*order.OrderServiceCreateShipmentResult)
+	success, err := handler.(order.OrderService).CreateShipment(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceCreateShipmentArgs() interface{} {
+	return order.NewOrderServiceCreateShipmentArgs()
+}
+
+func newOrderServiceCreateShipmentResult() interface{} {
+	return order.NewOrderServiceCreateShipmentResult()
+}
+
+func addTrackingEventHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceAddTrackingEventArgs)
+	realResult := result.(*order.OrderServiceAddTrackingEventResult)
+	success, err := handler.(order.OrderService).AddTrackingEvent(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceAddTrackingEventArgs() interface{} {
+	return order.NewOrderServiceAddTrackingEventArgs()
+}
+
+func newOrderServiceAddTrackingEventResult() interface{} {
+	return order.NewOrderServiceAddTrackingEventResult()
+}
+
+func confirmDeliveryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceConfirmDeliveryArgs)
+	realResult := result.(*order.OrderServiceConfirmDeliveryResult)
+	success, err := handler.(order.OrderService).ConfirmDelivery(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceConfirmDeliveryArgs() interface{} {
+	return order.NewOrderServiceConfirmDeliveryArgs()
+}
+
+func newOrderServiceConfirmDeliveryResult() interface{} {
+	return order.NewOrderServiceConfirmDeliveryResult()
+}
+
+func decodeIdHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceDecodeIdArgs)
+	realResult := result.(*order.OrderServiceDecodeIdResult)
+	success, err := handler.(order.OrderService).DecodeId(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceDecodeIdArgs() interface{} {
+	return order.NewOrderServiceDecodeIdArgs()
+}
+
+func newOrderServiceDecodeIdResult() interface{} {
+	return order.NewOrderServiceDecodeIdResult()
+}
+
+func reportHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceReportArgs)
+	realResult := result.(*order.OrderServiceReportResult)
+	success, err := handler.(order.OrderService).Report(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceReportArgs() interface{} {
+	return order.NewOrderServiceReportArgs()
+}
+
+func newOrderServiceReportResult() interface{} {
+	return order.NewOrderServiceReportResult()
+}
+
+func createFromCartHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceCreateFromCartArgs)
+	realResult := result.(*order.OrderServiceCreateFromCartResult)
+	success, err := handler.(order.OrderService).CreateFromCart(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceCreateFromCartArgs() interface{} {
+	return order.NewOrderServiceCreateFromCartArgs()
+}
+
+func newOrderServiceCreateFromCartResult() interface{} {
+	return order.NewOrderServiceCreateFromCartResult()
+}
+
+func queryParentOrderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceQueryParentOrderArgs)
+	realResult := result.(*order.OrderServiceQueryParentOrderResult)
+	success, err := handler.(order.OrderService).QueryParentOrder(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceQueryParentOrderArgs() interface{} {
+	return order.NewOrderServiceQueryParentOrderArgs()
+}
+
+func newOrderServiceQueryParentOrderResult() interface{} {
+	return order.NewOrderServiceQueryParentOrderResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -219,6 +544,16 @@ func (p *kClient) QueryOrderInfo(ctx context.Context, req *order.QueryOrderInfoR
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) BatchQueryOrderInfo(ctx context.Context, req *order.BatchQueryOrderInfoRequest) (r *order.BatchQueryOrderInfoResponse, err error) {
+	var _args order.OrderServiceBatchQueryOrderInfoArgs
+	_args.Req = req
+	var _result order.OrderServiceBatchQueryOrderInfoResult
+	if err = p.c.Call(ctx, "BatchQueryOrderInfo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) QueryOrderId(ctx context.Context, req *order.QueryOrderIdRequest) (r *order.QueryOrderIdResponse, err error) {
 	var _args order.OrderServiceQueryOrderIdArgs
 	_args.Req = req
@@ -228,3 +563,123 @@ func (p *kClient) QueryOrderId(ctx context.Context, req *order.QueryOrderIdReque
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) SearchOrders(ctx context.Context, req *order.SearchOrdersRequest) (r *order.SearchOrdersResponse, err error) {
+	var _args order.OrderServiceSearchOrdersArgs
+	_args.Req = req
+	var _result order.OrderServiceSearchOrdersResult
+	if err = p.c.Call(ctx, "SearchOrders", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RequestRefund(ctx context.Context, req *order.RequestRefundRequest) (r *order.RequestRefundResponse, err error) {
+	var _args order.OrderServiceRequestRefundArgs
+	_args.Req = req
+	var _result order.OrderServiceRequestRefundResult
+	if err = p.c.Call(ctx, "RequestRefund", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ReviewRefund(ctx context.Context, req *order.ReviewRefundRequest) (r *order.ReviewRefundResponse, err error) {
+	var _args order.OrderServiceReviewRefundArgs
+	_args.Req = req
+	var _result order.OrderServiceReviewRefundResult
+	if err = p.c.Call(ctx, "ReviewRefund", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CompleteRefund(ctx context.Context, req *order.CompleteRefundRequest) (r *order.CompleteRefundResponse, err error) {
+	var _args order.OrderServiceCompleteRefundArgs
+	_args.Req = req
+	var _result order.OrderServiceCompleteRefundResult
+	if err = p.c.Call(ctx, "CompleteRefund", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) QueryRefunds(ctx context.Context, req *order.QueryRefundsRequest) (r *order.QueryRefundsResponse, err error) {
+	var _args order.OrderServiceQueryRefundsArgs
+	_args.Req = req
+	var _result order.OrderServiceQueryRefundsResult
+	if err = p.c.Call(ctx, "QueryRefunds", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateShipment(ctx context.Context, req *order.CreateShipmentRequest) (r *order.CreateShipmentResponse, err error) {
+	var _args order.OrderServiceCreateShipmentArgs
+	_args.Req = req
+	var _result order.OrderServiceCreateShipmentResult
+	if err = p.c.Call(ctx, "CreateShipment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AddTrackingEvent(ctx context.Context, req *order.AddTrackingEventRequest) (r *order.AddTrackingEventResponse, err error) {
+	var _args order.OrderServiceAddTrackingEventArgs
+	_args.Req = req
+	var _result order.OrderServiceAddTrackingEventResult
+	if err = p.c.Call(ctx, "AddTrackingEvent", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ConfirmDelivery(ctx context.Context, req *order.ConfirmDeliveryRequest) (r *order.ConfirmDeliveryResponse, err error) {
+	var _args order.OrderServiceConfirmDeliveryArgs
+	_args.Req = req
+	var _result order.OrderServiceConfirmDeliveryResult
+	if err = p.c.Call(ctx, "ConfirmDelivery", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DecodeId(ctx context.Context, req *order.DecodeIdRequest) (r *order.DecodeIdResponse, err error) {
+	var _args order.OrderServiceDecodeIdArgs
+	_args.Req = req
+	var _result order.OrderServiceDecodeIdResult
+	if err = p.c.Call(ctx, "DecodeId", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Report(ctx context.Context, req *order.ReportRequest) (r *order.ReportResponse, err error) {
+	var _args order.OrderServiceReportArgs
+	_args.Req = req
+	var _result order.OrderServiceReportResult
+	if err = p.c.Call(ctx, "Report", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateFromCart(ctx context.Context, req *order.CreateFromCartRequest) (r *order.CreateFromCartResponse, err error) {
+	var _args order.OrderServiceCreateFromCartArgs
+	_args.Req = req
+	var _result order.OrderServiceCreateFromCartResult
+	if err = p.c.Call(ctx, "CreateFromCart", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) QueryParentOrder(ctx context.Context, req *order.QueryParentOrderRequest) (r *order.QueryParentOrderResponse, err error) {
+	var _args order.OrderServiceQueryParentOrderArgs
+	_args.Req = req
+	var _result order.OrderServiceQueryParentOrderResult
+	if err = p.c.Call(ctx, "QueryParentOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -1,25 +1,113 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
+	"os"
+	"time"
 
 	"github.com/cloudwego/kitex/pkg/klog"
 	"github.com/cloudwego/kitex/server"
 	order "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order/orderservice"
-
-	_ "github.com/youperceive/cloudwego_instance/rpc/order/pkg/mongo"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/address"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/catalog"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/event"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/mongo"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/paytimeout"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/retention"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/snowflake"
 )
 
+// initCollections 连接 Mongo 并初始化各集合，处理请求或运行管理命令之前调用
+func initCollections() {
+	mongo.Init()
+	db := mongo.Cli.Database(mongoNames.Database)
+	Coll = db.Collection(mongoNames.Orders)
+	ParentColl = db.Collection(mongoNames.Parents)
+	ArchiveColl = db.Collection(mongoNames.Archive)
+	OutboxColl = db.Collection(mongoNames.Outbox)
+}
+
 func main() {
+	initCollections()
+
+	if len(os.Args) > 1 {
+		if err := runAdmin(context.Background(), os.Args[1:]); err != nil {
+			klog.Fatal(err.Error())
+		}
+		return
+	}
 
 	addr, err := net.ResolveTCPAddr("tcp", "0.0.0.0:8002")
 	if err != nil {
 		klog.Fatal("监听 0.0.0.0:8002 地址失败，" + err.Error())
 	}
 
+	catalogCli, err := catalog.NewClientFromEnv()
+	if err != nil {
+		klog.Fatal("初始化商品目录客户端失败，" + err.Error())
+	}
+
+	addressCli, err := address.NewClientFromEnv()
+	if err != nil {
+		klog.Fatal("初始化地址簿客户端失败，" + err.Error())
+	}
+
+	payTimeout, err := paytimeout.ConfigFromEnv()
+	if err != nil {
+		klog.Fatal("读取支付期限配置失败，" + err.Error())
+	}
+
+	retentionCfg, err := retention.ConfigFromEnv()
+	if err != nil {
+		klog.Fatal("读取订单保留策略失败，" + err.Error())
+	}
+
+	autoConfirmAfter, err := autoConfirmAfterFromEnv()
+	if err != nil {
+		klog.Fatal("读取自动确认收货配置失败，" + err.Error())
+	}
+
+	extIndexKeys, err := extIndexKeysFromEnv()
+	if err != nil {
+		klog.Fatal("读取 ext 索引配置失败，" + err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if err := ensureIndexes(ctx, extIndexKeys); err != nil {
+		klog.Fatal("创建订单索引失败，" + err.Error())
+	}
+	leases := mongo.Cli.Database(mongoNames.Database).Collection(mongoNames.Leases)
+	if err := snowflake.SetupFromEnv(ctx, leases); err != nil {
+		klog.Fatal("初始化订单项ID生成器失败，" + err.Error())
+	}
+	canceller := newPayTimeoutCanceller(leases, 30*time.Second)
+	go canceller.Run(ctx)
+	go newAutoConfirmer(leases, time.Minute).Run(ctx)
+	if retentionCfg.Enabled() {
+		go newArchiver(leases, retentionCfg).Run(ctx)
+	}
+
+	sink, err := event.SinkFromEnv()
+	if err != nil {
+		klog.Fatal("读取事件投递配置失败，" + err.Error())
+	}
+	if sink != nil {
+		checkpoints := mongo.Cli.Database(mongoNames.Database).Collection(mongoNames.Checkpoints)
+		deadLetters := mongo.Cli.Database(mongoNames.Database).Collection(mongoNames.DeadLetters)
+		go event.NewRelay(OutboxColl, checkpoints, deadLetters, leases, sink).Run(ctx)
+	}
+
 	svr := order.NewServer(
-		new(OrderServiceImpl),
+		&OrderServiceImpl{
+			Catalog:          catalogCli,
+			Address:          addressCli,
+			PayTimeout:       payTimeout,
+			AutoConfirmAfter: autoConfirmAfter,
+		},
 		server.WithServiceAddr(addr),
 	)
 
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/event"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// migrateExt 修复旧版本 Update 写坏的订单文档：
+//   - 旧版本把 ext 写成了文档顶层字段，把它们移入 order.ext（顶层的值是后写入的，同名时覆盖 order.ext 中的值）；
+//   - order.ext 为 null 的文档置为空文档。
+//
+// dryRun 为 true 时只打印将要做的修改。返回需要修复的文档数量。
+func migrateExt(ctx context.Context, dryRun bool) (int, error) {
+	filter := bson.M{"$or": bson.A{
+		bson.M{"order.ext": bson.M{"$not": bson.M{"$type": "object"}}},
+		// 正常文档只有 _id 和 order 两个顶层字段
+		bson.M{"$expr": bson.M{"$gt": bson.A{bson.M{"$size": bson.M{"$objectToArray": "$$ROOT"}}, 2}}},
+	}}
+	cur, err := Coll.Find(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	defer cur.Close(ctx)
+
+	fixed := 0
+	for cur.Next(ctx) {
+		// 用 bson.Raw 逐个读取顶层字段，避免嵌套文档被解码成不同的类型
+		raw := cur.Current
+		objectId, ok := raw.Lookup("_id").ObjectIDOK()
+		if !ok {
+			klog.Warn("migrate-ext: skip document with unexpected _id: ", raw.Lookup("_id").String())
+			continue
+		}
+		elems, err := raw.Elements()
+		if err != nil {
+			return fixed, err
+		}
+
+		extIsObject := raw.Lookup("order", "ext").Type == bson.TypeEmbeddedDocument
+		var keys []string
+		values := make(map[string]bson.RawValue)
+		for _, e := range elems {
+			k := e.Key()
+			if k != "_id" && k != "order" {
+				keys = append(keys, k)
+				values[k] = e.Value()
+			}
+		}
+		sort.Strings(keys)
+
+		set := bson.D{}
+		unset := bson.D{}
+		if !extIsObject {
+			set = append(set, bson.E{Key: "order.ext", Value: bson.M{}})
+		}
+		moved := make(map[string]string, len(keys))
+		for _, k := range keys {
+			moved[k] = extValue(values[k])
+			unset = append(unset, bson.E{Key: k, Value: ""})
+		}
+
+		fixed++
+		if dryRun {
+			klog.Info("migrate-ext (dry run): ", objectId.Hex(), " ext null: ", !extIsObject, " move to order.ext: ", moved)
+			continue
+		}
+
+		extSet := bson.D{}
+		for _, k := range keys {
+			extSet = append(extSet, bson.E{Key: "order.ext." + k, Value: moved[k]})
+		}
+		if err := fixExt(ctx, objectId, set, extSet, unset); err != nil {
+			return fixed, err
+		}
+		klog.Info("migrate-ext: fixed ", objectId.Hex())
+	}
+	return fixed, cur.Err()
+}
+
+// fixExt 在一个事务中修复一个订单并写入 OrderUpdated 事件（reason=migrate_ext），与其他写入一样递增版本号。
+// order.ext 不是文档时无法按 key 写入，分两步：先按 set 置为空文档，再写入 extSet 中的各个 key 并删除 unset 中的顶层字段
+func fixExt(ctx context.Context, objectId bson.ObjectID, set, extSet, unset bson.D) error {
+	return withTx(ctx, func(ctx context.Context) error {
+		if len(set) > 0 {
+			if _, err := Coll.UpdateOne(ctx, bson.M{"_id": objectId}, bson.M{"$set": set}); err != nil {
+				return err
+			}
+		}
+		update := bson.D{
+			{Key: "$set", Value: append(bson.D{{Key: "order.updatedat", Value: time.Now().Unix()}}, extSet...)},
+			{Key: "$inc", Value: bson.D{{Key: "order.version", Value: 1}}},
+		}
+		if len(unset) > 0 {
+			update = append(update, bson.E{Key: "$unset", Value: unset})
+		}
+		var after struct {
+			Order struct {
+				Version int64 `bson:"version"`
+			} `bson:"order"`
+		}
+		opts := options.FindOneAndUpdate().
+			SetReturnDocument(options.After).
+			SetProjection(bson.M{"order.version": 1})
+		if err := Coll.FindOneAndUpdate(ctx, bson.M{"_id": objectId}, update, opts).Decode(&after); err != nil {
+			return err
+		}
+		// 顶层字段不属于订单，事件中只记录 ext 的变化
+		return appendEvent(ctx, event.OrderUpdated, objectId.Hex(), after.Order.Version, map[string]any{
+			"fields": changedFields(append(set[:len(set):len(set)], extSet...), nil),
+			"reason": "migrate_ext",
+		})
+	})
+}
+
+// extValue 字符串原样保留，其他类型（如 key 含 . 被写成的嵌套文档）转为扩展 JSON
+func extValue(v bson.RawValue) string {
+	if str, ok := v.StringValueOK(); ok {
+		return str
+	}
+	return v.String()
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	order "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/event"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/mongo"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	mongoOfficial "go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// 发件箱中的事件保留 7 天，relay 停止超过该时长且断点失效时会漏投
+const outboxRetention = 7 * 24 * time.Hour
+
+var OutboxColl *mongoOfficial.Collection
+
+// outboxIndexes 发件箱集合的索引
+var outboxIndexes = []mongoOfficial.IndexModel{
+	// 同一订单的 seq 不会重复；relay 补投时按此顺序读取
+	{
+		Keys:    bson.D{{Key: "orderid", Value: 1}, {Key: "seq", Value: 1}},
+		Options: options.Index().SetName("orderid_seq").SetUnique(true),
+	},
+	{
+		Keys:    bson.D{{Key: "createdat", Value: 1}},
+		Options: options.Index().SetName("createdat_ttl").SetExpireAfterSeconds(int32(outboxRetention.Seconds())),
+	},
+}
+
+// withTx 在事务中执行 fn，订单和事件的写入要么都成功、要么都不生效。
+// fn 可能因事务冲突被重试多次，必须使用传入的 ctx，且不能在外部保留中间状态。
+func withTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	sess, err := mongo.Cli.StartSession()
+	if err != nil {
+		return err
+	}
+	defer sess.EndSession(ctx)
+	_, err = sess.WithTransaction(ctx, func(ctx context.Context) (any, error) {
+		return nil, fn(ctx)
+	})
+	return err
+}
+
+// appendEvent 把事件写入发件箱，须在 withTx 的 fn 中调用
+func appendEvent(ctx context.Context, typ, orderId string, seq int64, payload map[string]any) error {
+	_, err := OutboxColl.InsertOne(ctx, event.NewRecord(typ, orderId, seq, payload))
+	return err
+}
+
+// createdPayload OrderCreated 事件的 payload
+func createdPayload(o *order.Order) map[string]any {
+	payload := map[string]any{
+		"type":         o.Type,
+		"status":       o.Status,
+		"req_user_id":  o.ReqUserId,
+		"resp_user_id": o.RespUserId,
+		"total_amount": o.TotalAmount,
+	}
+	if o.ParentId != "" {
+		payload["parent_id"] = o.ParentId
+	}
+	return payload
+}
+
+type eventAttrsKey struct{}
+
+// withEventAttrs 为本次状态流转的事件附加字段，如取消原因
+func withEventAttrs(ctx context.Context, attrs map[string]any) context.Context {
+	return context.WithValue(ctx, eventAttrsKey{}, attrs)
+}
+
+func eventAttrs(ctx context.Context) map[string]any {
+	attrs, _ := ctx.Value(eventAttrsKey{}).(map[string]any)
+	return attrs
+}
+
+// changedFields 事件中记录的变更字段名（去掉 order. 前缀），不包含每次都会变的 updatedat
+func changedFields(set, unset bson.D) []string {
+	fields := make([]string, 0, len(set)+len(unset))
+	for _, docs := range []bson.D{set, unset} {
+		for _, e := range docs {
+			name := strings.TrimPrefix(e.Key, "order.")
+			if name == "updatedat" {
+				continue
+			}
+			fields = append(fields, name)
+		}
+	}
+	return fields
+}
@@ -0,0 +1,70 @@
+package address
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/cloudwego/kitex/client"
+	order "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order"
+	"github.com/youperceive/cloudwego_instance/rpc/user_account/kitex_gen/base"
+	"github.com/youperceive/cloudwego_instance/rpc/user_account/kitex_gen/user_account"
+	"github.com/youperceive/cloudwego_instance/rpc/user_account/kitex_gen/user_account/useraccountservice"
+)
+
+// ErrNotFound 地址不存在或不属于该用户
+var ErrNotFound = errors.New("address not found")
+
+// Client 查询用户地址簿中的地址，下单时复制为订单的收货地址快照
+type Client interface {
+	Snapshot(ctx context.Context, userID, addressID int64) (*order.ShippingAddress, error)
+}
+
+// RPCClient 调用 UserAccountService.GetAddress
+type RPCClient struct {
+	cli useraccountservice.Client
+}
+
+// NewClientFromEnv 读取 $USER_ACCOUNT_SERVICE_ADDR（如 user-account-service:8001）
+func NewClientFromEnv() (Client, error) {
+	addr := os.Getenv("USER_ACCOUNT_SERVICE_ADDR")
+	if addr == "" {
+		return nil, errors.New("环境变量 USER_ACCOUNT_SERVICE_ADDR 未配置")
+	}
+	cli, err := useraccountservice.NewClient("user-account-service", client.WithHostPorts(addr))
+	if err != nil {
+		return nil, err
+	}
+	return &RPCClient{cli: cli}, nil
+}
+
+func (c *RPCClient) Snapshot(ctx context.Context, userID, addressID int64) (*order.ShippingAddress, error) {
+	resp, err := c.cli.GetAddress(ctx, &user_account.GetAddressRequest{
+		Id:     addressID,
+		UserId: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case resp.BaseResp == nil:
+		return nil, errors.New("get_address returned empty response")
+	case resp.BaseResp.Code == base.Code_NOT_FOUND:
+		return nil, ErrNotFound
+	case resp.BaseResp.Code != base.Code_SUCCESS:
+		return nil, fmt.Errorf("get_address failed: %s", resp.BaseResp.Msg)
+	case resp.Address == nil:
+		return nil, ErrNotFound
+	}
+	a := resp.Address
+	return &order.ShippingAddress{
+		AddressId:    a.Id,
+		ReceiverName: a.ReceiverName,
+		Phone:        a.Phone,
+		Province:     a.Province,
+		City:         a.City,
+		District:     a.District,
+		Detail:       a.Detail,
+	}, nil
+}
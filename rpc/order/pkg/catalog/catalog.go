@@ -0,0 +1,101 @@
+package catalog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// 与网关 base.Code 保持一致
+const (
+	codeSuccess  = 0
+	codeNotFound = 4
+)
+
+var ErrSkuNotFound = errors.New("sku not found")
+
+// Sku 是订单服务关心的商品目录信息，价格单位为分
+type Sku struct {
+	ID         int64  `json:"id"`
+	ProductID  int64  `json:"product_id"`
+	SkuCode    string `json:"sku_code"`
+	Price      int64  `json:"price"`
+	Stock      int32  `json:"stock"`
+	MerchantID int64  `json:"merchant_id"`
+}
+
+// Client 按 sku_id 查询商品目录，下单时以这里的价格和归属为准
+type Client interface {
+	GetSku(ctx context.Context, skuID int64) (*Sku, error)
+}
+
+// HTTPClient 调用网关的商品接口 POST /get_sku
+type HTTPClient struct {
+	baseURL string
+	cli     *http.Client
+}
+
+func NewHTTPClient(baseURL string) *HTTPClient {
+	return &HTTPClient{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		cli:     &http.Client{Timeout: 3 * time.Second},
+	}
+}
+
+// NewClientFromEnv 读取 $PRODUCT_SERVICE_URL（如 http://api:8888）
+func NewClientFromEnv() (Client, error) {
+	url := os.Getenv("PRODUCT_SERVICE_URL")
+	if url == "" {
+		return nil, errors.New("环境变量 PRODUCT_SERVICE_URL 未配置")
+	}
+	return NewHTTPClient(url), nil
+}
+
+type getSkuResponse struct {
+	BaseResp struct {
+		Code int32  `json:"code"`
+		Msg  string `json:"msg"`
+	} `json:"BaseResp"`
+	Sku *Sku `json:"sku"`
+}
+
+func (c *HTTPClient) GetSku(ctx context.Context, skuID int64) (*Sku, error) {
+	body, err := json.Marshal(map[string]int64{"sku_id": skuID})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/get_sku", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.cli.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("get_sku responded with status %d", resp.StatusCode)
+	}
+
+	var result getSkuResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("decode get_sku response failed: %w", err)
+	}
+	switch {
+	case result.BaseResp.Code == codeNotFound:
+		return nil, ErrSkuNotFound
+	case result.BaseResp.Code != codeSuccess:
+		return nil, fmt.Errorf("get_sku failed: %s", result.BaseResp.Msg)
+	case result.Sku == nil:
+		return nil, ErrSkuNotFound
+	}
+	return result.Sku, nil
+}
@@ -0,0 +1,87 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+const (
+	OrderCreated       = "order.created"
+	OrderStatusChanged = "order.status_changed"
+	OrderUpdated       = "order.updated"
+)
+
+// Record 发件箱中的一条事件，与订单在同一个事务中写入
+type Record struct {
+	ID      bson.ObjectID `bson:"_id"`
+	Type    string        `bson:"type"`
+	OrderID string        `bson:"orderid"`
+	// Seq 为写入后的订单版本号，同一订单的事件按 seq 递增
+	Seq        int64          `bson:"seq"`
+	OccurredAt int64          `bson:"occurredat"`
+	Payload    map[string]any `bson:"payload,omitempty"`
+	// CreatedAt 供 TTL 索引清理过期事件
+	CreatedAt time.Time `bson:"createdat"`
+}
+
+func NewRecord(typ, orderID string, seq int64, payload map[string]any) *Record {
+	now := time.Now()
+	return &Record{
+		ID:         bson.NewObjectID(),
+		Type:       typ,
+		OrderID:    orderID,
+		Seq:        seq,
+		OccurredAt: now.Unix(),
+		Payload:    payload,
+		CreatedAt:  now,
+	}
+}
+
+// Message 是投递给下游的事件格式，下游按 ID 去重（投递语义为 at-least-once），
+// 同一订单的事件按 Seq 顺序投递
+type Message struct {
+	ID         string         `json:"id"`
+	Type       string         `json:"type"`
+	OrderID    string         `json:"order_id"`
+	Seq        int64          `json:"seq"`
+	OccurredAt int64          `json:"occurred_at"`
+	Payload    map[string]any `json:"payload,omitempty"`
+}
+
+func NewMessage(r *Record) *Message {
+	return &Message{
+		ID:         r.ID.Hex(),
+		Type:       r.Type,
+		OrderID:    r.OrderID,
+		Seq:        r.Seq,
+		OccurredAt: r.OccurredAt,
+		Payload:    r.Payload,
+	}
+}
+
+// Sink 是事件的投递目标，返回 nil 即视为投递成功
+type Sink interface {
+	Publish(ctx context.Context, msg *Message) error
+	// Name 标识投递目标，每个目标分别记录投递进度
+	Name() string
+}
+
+// LogSink 把事件写入日志，未接入消息系统时使用
+type LogSink struct{}
+
+func (LogSink) Name() string {
+	return "log"
+}
+
+func (LogSink) Publish(_ context.Context, msg *Message) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	klog.Info("order event: ", string(data))
+	return nil
+}
@@ -0,0 +1,47 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisStreamSink 将事件 XADD 到 Redis Stream，下游用消费组读取
+type RedisStreamSink struct {
+	rdb    *redis.Client
+	stream string
+	maxLen int64
+}
+
+func NewRedisStreamSink(addr, stream string, maxLen int64) *RedisStreamSink {
+	return &RedisStreamSink{
+		rdb:    redis.NewClient(&redis.Options{Addr: addr}),
+		stream: stream,
+		maxLen: maxLen,
+	}
+}
+
+func (s *RedisStreamSink) Name() string {
+	return "redis:" + s.stream
+}
+
+func (s *RedisStreamSink) Publish(ctx context.Context, msg *Message) error {
+	payload, err := json.Marshal(msg.Payload)
+	if err != nil {
+		return err
+	}
+	return s.rdb.XAdd(ctx, &redis.XAddArgs{
+		Stream: s.stream,
+		MaxLen: s.maxLen,
+		Approx: true,
+		Values: map[string]any{
+			"id":          msg.ID,
+			"type":        msg.Type,
+			"order_id":    msg.OrderID,
+			"seq":         msg.Seq,
+			"payload":     string(payload),
+			"occurred_at": msg.OccurredAt,
+		},
+	}).Err()
+}
@@ -0,0 +1,254 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/lease"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	relayLeaseTTL     = 30 * time.Second
+	maxPublishBackoff = 30 * time.Second
+	// 单条事件的最大投递次数，用尽后转入死信集合
+	maxPublishAttempts = 10
+	// 从发件箱补投时向前多取的时间，覆盖各副本之间的时钟偏差
+	replayOverlap = time.Minute
+	// ChangeStreamHistoryLost：断点已超出 oplog 保留的范围
+	codeChangeStreamHistoryLost = 286
+)
+
+// Relay 通过 change stream 按提交顺序读取发件箱并投递到 Sink。
+// 每投递一条就把 resume token 记入断点集合，重启后从断点继续；先投递、后记录断点，
+// 进程在两步之间退出会导致重复投递，但不会丢事件。
+// 投递失败时退避重试同一条事件，保证同一订单的事件按 seq 顺序投递；重试 maxPublishAttempts 次仍失败时
+// 把事件写入死信集合并继续投递后续事件，避免一条无法投递的事件阻塞整个发件箱。
+// 多个副本通过租约保证同一时间只有一个 relay 在投递。
+type Relay struct {
+	outbox      *mongo.Collection
+	checkpoints *mongo.Collection
+	deadLetters *mongo.Collection
+	lease       *lease.Lease
+	sink        Sink
+}
+
+func NewRelay(outbox, checkpoints, deadLetters, leases *mongo.Collection, sink Sink) *Relay {
+	return &Relay{
+		outbox:      outbox,
+		checkpoints: checkpoints,
+		deadLetters: deadLetters,
+		lease:       lease.New(leases, "order_outbox_relay:"+sink.Name(), relayLeaseTTL),
+		sink:        sink,
+	}
+}
+
+// checkpoint 一个投递目标的断点，_id 为 Sink.Name()
+type checkpoint struct {
+	Token bson.Raw `bson:"token,omitempty"`
+	// LastAt 最近投递的事件的写入时间，断点失效时据此从发件箱补投
+	LastAt time.Time `bson:"lastat"`
+}
+
+// Run 阻塞运行直到 ctx 被取消
+func (r *Relay) Run(ctx context.Context) {
+	for {
+		held, err := r.lease.Acquire(ctx)
+		if err != nil {
+			klog.Error("event/relay: acquire lease failed. ", err.Error())
+		}
+		if held {
+			if err := r.runHeld(ctx); err != nil && ctx.Err() == nil {
+				klog.Error("event/relay: ", err.Error())
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			_ = r.lease.Release(context.Background())
+			return
+		case <-time.After(relayLeaseTTL / 3):
+		}
+	}
+}
+
+// runHeld 持有租约期间持续投递，续约失败时停止
+func (r *Relay) runHeld(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		ticker := time.NewTicker(relayLeaseTTL / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			held, err := r.lease.Acquire(ctx)
+			if err != nil || !held {
+				if ctx.Err() == nil {
+					klog.Warn("event/relay: lost lease, stop relaying")
+				}
+				cancel()
+				return
+			}
+		}
+	}()
+	return r.relay(ctx)
+}
+
+func (r *Relay) relay(ctx context.Context) error {
+	cp, err := r.loadCheckpoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	streamOpts := options.ChangeStream()
+	var replayed map[bson.ObjectID]bool
+	if cp.Token != nil {
+		streamOpts.SetResumeAfter(cp.Token)
+	} else {
+		// 没有可用的断点（首次启动或断点已失效）：先从发件箱补投，再从补投之前的时刻打开 change stream，
+		// 两者重叠部分按事件 id 跳过
+		startAt := time.Now().Add(-replayOverlap)
+		replayed, err = r.replay(ctx, cp.LastAt.Add(-replayOverlap), startAt.Add(-replayOverlap))
+		if err != nil {
+			return err
+		}
+		streamOpts.SetStartAtOperationTime(&bson.Timestamp{T: uint32(startAt.Unix())})
+	}
+
+	pipeline := mongo.Pipeline{{{Key: "$match", Value: bson.D{{Key: "operationType", Value: "insert"}}}}}
+	cs, err := r.outbox.Watch(ctx, pipeline, streamOpts)
+	if err != nil {
+		return r.checkHistoryLost(ctx, err)
+	}
+	defer cs.Close(context.Background())
+
+	for cs.Next(ctx) {
+		var change struct {
+			Doc Record `bson:"fullDocument"`
+		}
+		if err := cs.Decode(&change); err != nil {
+			return err
+		}
+		if replayed[change.Doc.ID] {
+			delete(replayed, change.Doc.ID)
+		} else if err := r.publish(ctx, &change.Doc); err != nil {
+			return err
+		}
+		if err := r.saveCheckpoint(ctx, cs.ResumeToken(), change.Doc.CreatedAt); err != nil {
+			return err
+		}
+	}
+	return r.checkHistoryLost(ctx, cs.Err())
+}
+
+// replay 按订单、seq 顺序投递发件箱中 since 之后写入的事件，
+// 返回其中 overlapFrom 之后写入的事件 id，change stream 中再次读到时跳过
+func (r *Relay) replay(ctx context.Context, since, overlapFrom time.Time) (map[bson.ObjectID]bool, error) {
+	findOpts := options.Find().SetSort(bson.D{{Key: "orderid", Value: 1}, {Key: "seq", Value: 1}})
+	cur, err := r.outbox.Find(ctx, bson.M{"createdat": bson.M{"$gte": since}}, findOpts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(context.Background())
+
+	replayed := make(map[bson.ObjectID]bool)
+	n := 0
+	for cur.Next(ctx) {
+		var rec Record
+		if err := cur.Decode(&rec); err != nil {
+			return nil, err
+		}
+		if err := r.publish(ctx, &rec); err != nil {
+			return nil, err
+		}
+		if !rec.CreatedAt.Before(overlapFrom) {
+			replayed[rec.ID] = true
+		}
+		n++
+	}
+	if err := cur.Err(); err != nil {
+		return nil, err
+	}
+	klog.Info("event/relay: replayed ", n, " events from outbox to ", r.sink.Name())
+	return replayed, nil
+}
+
+// publish 投递一条事件，失败时退避重试，共尝试 maxPublishAttempts 次仍失败则转入死信集合
+func (r *Relay) publish(ctx context.Context, rec *Record) error {
+	backoff := time.Second
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = r.sink.Publish(ctx, NewMessage(rec)); err == nil {
+			return nil
+		}
+		klog.Error("event/relay: ", "fail to publish event ", rec.ID.Hex(), ". "+err.Error())
+		if attempt >= maxPublishAttempts {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxPublishBackoff)
+	}
+	return r.deadLetter(ctx, rec, err)
+}
+
+// deadLetter 记录重试用尽的事件，按投递目标区分；同一事件重复写入时覆盖
+func (r *Relay) deadLetter(ctx context.Context, rec *Record, cause error) error {
+	klog.Error("event/relay: move event ", rec.ID.Hex(), " of order ", rec.OrderID, " to dead letter after ",
+		maxPublishAttempts, " attempts")
+	_, err := r.deadLetters.UpdateOne(ctx,
+		bson.M{"_id": bson.M{"sink": r.sink.Name(), "event": rec.ID}},
+		bson.M{"$set": bson.M{
+			"record":    rec,
+			"error":     cause.Error(),
+			"attempts":  maxPublishAttempts,
+			"updatedat": time.Now(),
+		}},
+		options.UpdateOne().SetUpsert(true),
+	)
+	return err
+}
+
+// checkHistoryLost 断点超出 oplog 范围时清除 resume token，下次从发件箱补投
+func (r *Relay) checkHistoryLost(ctx context.Context, err error) error {
+	var serverErr mongo.ServerError
+	if err == nil || !errors.As(err, &serverErr) || !serverErr.HasErrorCode(codeChangeStreamHistoryLost) {
+		return err
+	}
+	klog.Warn("event/relay: checkpoint of ", r.sink.Name(), " is no longer in the oplog, replay from outbox")
+	_, unsetErr := r.checkpoints.UpdateOne(ctx, bson.M{"_id": r.sink.Name()}, bson.M{"$unset": bson.M{"token": ""}})
+	if unsetErr != nil {
+		return unsetErr
+	}
+	return err
+}
+
+func (r *Relay) loadCheckpoint(ctx context.Context) (*checkpoint, error) {
+	var cp checkpoint
+	err := r.checkpoints.FindOne(ctx, bson.M{"_id": r.sink.Name()}).Decode(&cp)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+	return &cp, nil
+}
+
+func (r *Relay) saveCheckpoint(ctx context.Context, token bson.Raw, lastAt time.Time) error {
+	_, err := r.checkpoints.UpdateOne(ctx,
+		bson.M{"_id": r.sink.Name()},
+		bson.M{"$set": bson.M{"token": token, "lastat": lastAt, "updatedat": time.Now()}},
+		options.UpdateOne().SetUpsert(true),
+	)
+	return err
+}
@@ -0,0 +1,81 @@
+package lease
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Lease 是保存在 Mongo 中的一把带过期时间的锁，多个副本中同一时间只有一个持有者。
+// 文档结构：{_id: 名称, owner: 持有者, expiresat: 过期时间（unix 秒）}，释放后 owner 为空、expiresat 为 0
+type Lease struct {
+	coll  *mongo.Collection
+	name  string
+	owner string
+	ttl   time.Duration
+}
+
+func New(coll *mongo.Collection, name string, ttl time.Duration) *Lease {
+	return &Lease{
+		coll:  coll,
+		name:  name,
+		owner: newOwner(),
+		ttl:   ttl,
+	}
+}
+
+// newOwner 主机名 + 随机串，区分同一主机上的多个进程
+func newOwner() string {
+	host, _ := os.Hostname()
+	b := make([]byte, 4)
+	_, _ = rand.Read(b)
+	return fmt.Sprintf("%s-%s", host, hex.EncodeToString(b))
+}
+
+func (l *Lease) Owner() string {
+	return l.owner
+}
+
+// Acquire 获取或续约租约，租约被其他持有者占用且未过期时返回 false
+func (l *Lease) Acquire(ctx context.Context) (bool, error) {
+	now := time.Now()
+	filter := bson.M{
+		"_id": l.name,
+		"$or": bson.A{
+			bson.M{"owner": l.owner},
+			bson.M{"expiresat": bson.M{"$lt": now.Unix()}},
+		},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"owner":     l.owner,
+			"expiresat": now.Add(l.ttl).Unix(),
+		},
+	}
+	// 文档不存在时 upsert 创建；被他人持有时 upsert 撞上 _id 唯一约束
+	_, err := l.coll.UpdateOne(ctx, filter, update, options.UpdateOne().SetUpsert(true))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// Release 主动释放租约，其他副本无需等待过期。
+// 只清空持有者和过期时间、不删除文档，使用方记录在租约文档上的其他字段（如 snowflake 的 lastms）得以保留
+func (l *Lease) Release(ctx context.Context) error {
+	_, err := l.coll.UpdateOne(ctx,
+		bson.M{"_id": l.name, "owner": l.owner},
+		bson.M{"$set": bson.M{"owner": "", "expiresat": int64(0)}},
+	)
+	return err
+}
@@ -13,7 +13,8 @@ import (
 
 var Cli *mongo.Client
 
-func init() {
+// Init 连接 $MONGODB_URI 并设置 Cli，使用 Cli 之前调用一次
+func Init() {
 	var uri string
 	if uri = os.Getenv("MONGODB_URI"); uri == "" {
 		log.Fatal("You must set your 'MONGODB_URI' environment variable. See\n\t https://mongodb.com/zh-cn/docs/drivers/go/current/usage-examples/")
@@ -0,0 +1,38 @@
+package mongo
+
+import "os"
+
+// Names 订单服务使用的数据库和集合名
+type Names struct {
+	Database    string
+	Orders      string // 订单文档
+	Leases      string // 多副本互斥用的租约
+	Outbox      string // 订单事件发件箱
+	Checkpoints string // 各投递目标的事件投递断点
+	DeadLetters string // 重试用尽仍投递失败的事件
+	Archive     string // 超过保留期限的订单
+	Parents     string // CreateFromCart 创建的父订单
+}
+
+// NamesFromEnv 读取 $MONGODB_DATABASE、$MONGODB_ORDER_COLLECTION、$MONGODB_LEASE_COLLECTION、
+// $MONGODB_OUTBOX_COLLECTION、$MONGODB_CHECKPOINT_COLLECTION、$MONGODB_DEADLETTER_COLLECTION、$MONGODB_ARCHIVE_COLLECTION、
+// $MONGODB_PARENT_COLLECTION，未配置时分别使用 order_db、total、lease、order_outbox、outbox_checkpoint、outbox_deadletter、archive、parent_order
+func NamesFromEnv() Names {
+	return Names{
+		Database:    getenv("MONGODB_DATABASE", "order_db"),
+		Orders:      getenv("MONGODB_ORDER_COLLECTION", "total"),
+		Leases:      getenv("MONGODB_LEASE_COLLECTION", "lease"),
+		Outbox:      getenv("MONGODB_OUTBOX_COLLECTION", "order_outbox"),
+		Checkpoints: getenv("MONGODB_CHECKPOINT_COLLECTION", "outbox_checkpoint"),
+		DeadLetters: getenv("MONGODB_DEADLETTER_COLLECTION", "outbox_deadletter"),
+		Archive:     getenv("MONGODB_ARCHIVE_COLLECTION", "archive"),
+		Parents:     getenv("MONGODB_PARENT_COLLECTION", "parent_order"),
+	}
+}
+
+func getenv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,67 @@
+package paytimeout
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/status"
+)
+
+// Config 按订单类型声明待支付订单的支付期限，未声明的类型不会自动取消
+type Config map[int32]time.Duration
+
+// Default 普通订单 30 分钟，秒杀订单 15 分钟，团购订单 24 小时
+func Default() Config {
+	return Config{
+		status.TypeNormal:   30 * time.Minute,
+		status.TypeSeckill:  15 * time.Minute,
+		status.TypeGroupBuy: 24 * time.Hour,
+	}
+}
+
+// ConfigFromEnv 在默认值基础上读取 $ORDER_PAY_TIMEOUT 覆盖，格式为 "类型=时长"，
+// 多个用逗号分隔，如 "1=30m,2=5m"；时长为 0 表示该类型不自动取消。
+func ConfigFromEnv() (Config, error) {
+	cfg := Default()
+	v := os.Getenv("ORDER_PAY_TIMEOUT")
+	if v == "" {
+		return cfg, nil
+	}
+	for _, pair := range strings.Split(v, ",") {
+		k, d, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			return nil, fmt.Errorf("ORDER_PAY_TIMEOUT: %q 格式错误，应为 类型=时长", pair)
+		}
+		orderType, err := strconv.ParseInt(strings.TrimSpace(k), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("ORDER_PAY_TIMEOUT: 订单类型 %q 不合法", k)
+		}
+		timeout, err := time.ParseDuration(strings.TrimSpace(d))
+		if err != nil || timeout < 0 {
+			return nil, fmt.Errorf("ORDER_PAY_TIMEOUT: 时长 %q 不合法", d)
+		}
+		if timeout == 0 {
+			delete(cfg, int32(orderType))
+			continue
+		}
+		cfg[int32(orderType)] = timeout
+	}
+	return cfg, nil
+}
+
+// For 返回该类型订单的支付时长，不自动取消时返回 0
+func (c Config) For(orderType int32) time.Duration {
+	return c[orderType]
+}
+
+// Deadline 返回订单的支付期限（unix 秒），该类型不自动取消时返回 0
+func (c Config) Deadline(orderType int32, createdAt int64) int64 {
+	timeout := c.For(orderType)
+	if timeout == 0 {
+		return 0
+	}
+	return createdAt + int64(timeout/time.Second)
+}
@@ -0,0 +1,66 @@
+package retention
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// 归档方式
+const (
+	ModeCollection = "collection" // 移入归档集合，QueryOrderInfo 仍可查询
+	ModeFile       = "file"       // 导出为 gzip 压缩的 JSON Lines 文件后删除
+)
+
+// Config 订单保留策略。Days 为 0 表示不归档。
+type Config struct {
+	Days int
+	Mode string
+	Dir  string // ModeFile 时文件写入的目录
+}
+
+// ConfigFromEnv 读取 $ORDER_RETENTION_DAYS、$ORDER_ARCHIVE_MODE（collection/file，默认 collection）、
+// $ORDER_ARCHIVE_DIR（默认 archive）
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{Mode: ModeCollection, Dir: "archive"}
+	if v := os.Getenv("ORDER_RETENTION_DAYS"); v != "" {
+		days, err := strconv.Atoi(v)
+		if err != nil || days < 0 {
+			return cfg, fmt.Errorf("ORDER_RETENTION_DAYS: %q 不合法", v)
+		}
+		cfg.Days = days
+	}
+	if v := os.Getenv("ORDER_ARCHIVE_MODE"); v != "" {
+		cfg.Mode = v
+	}
+	if v := os.Getenv("ORDER_ARCHIVE_DIR"); v != "" {
+		cfg.Dir = v
+	}
+	return cfg, cfg.Validate()
+}
+
+func (c Config) Validate() error {
+	if c.Days < 0 {
+		return fmt.Errorf("保留天数 %d 不合法", c.Days)
+	}
+	switch c.Mode {
+	case ModeCollection:
+	case ModeFile:
+		if c.Dir == "" {
+			return fmt.Errorf("file 归档方式需要指定目录")
+		}
+	default:
+		return fmt.Errorf("归档方式 %q 不存在，可选 collection、file", c.Mode)
+	}
+	return nil
+}
+
+func (c Config) Enabled() bool {
+	return c.Days > 0
+}
+
+// Cutoff 早于该时间（unix 秒）最后更新的终态订单可以归档
+func (c Config) Cutoff(now time.Time) int64 {
+	return now.Add(-time.Duration(c.Days) * 24 * time.Hour).Unix()
+}
@@ -0,0 +1,145 @@
+package snowflake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/lease"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	leaseNamePrefix = "snowflake-node-"
+	nodeLeaseTTL    = 30 * time.Second
+)
+
+var ErrNodeLeaseLost = errors.New("snowflake: node lease lost")
+
+// SetupFromEnv 初始化默认生成器：
+//   - 配置了 $ORDER_SNOWFLAKE_NODE（0~1023）时使用该节点 id，由部署保证各副本不同；
+//   - 否则在 leases 集合中租用一个空闲的节点 id，并在后台续约直到 ctx 结束，续约失败时停止生成。
+func SetupFromEnv(ctx context.Context, leases *mongo.Collection) error {
+	if v := os.Getenv("ORDER_SNOWFLAKE_NODE"); v != "" {
+		node, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("ORDER_SNOWFLAKE_NODE=%q: %w", v, err)
+		}
+		g, err := New(node, 0)
+		if err != nil {
+			return err
+		}
+		SetDefault(g)
+		klog.Info("snowflake: using configured node id ", node)
+		return nil
+	}
+
+	n, err := leaseNode(ctx, leases)
+	if err != nil {
+		return err
+	}
+	SetDefault(n.gen)
+	klog.Info("snowflake: leased node id ", n.gen.Node(), " as ", n.lease.Owner())
+	go n.keepAlive(ctx)
+	return nil
+}
+
+// leasedNode 通过租约占用的节点 id。
+// 租约文档额外记录该节点用过的最大时间戳（lastms），下一个持有者据此防止时钟回拨产生重复 id。
+type leasedNode struct {
+	coll  *mongo.Collection
+	lease *lease.Lease
+	name  string
+	gen   *Generator
+}
+
+// leaseNode 从随机位置开始依次尝试各节点 id 的租约，拿到第一个空闲的
+func leaseNode(ctx context.Context, coll *mongo.Collection) (*leasedNode, error) {
+	start := rand.Int63n(MaxNode + 1)
+	for i := int64(0); i <= MaxNode; i++ {
+		node := (start + i) % (MaxNode + 1)
+		name := leaseNamePrefix + strconv.FormatInt(node, 10)
+		l := lease.New(coll, name, nodeLeaseTTL)
+		ok, err := l.Acquire(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("snowflake: acquire %s: %w", name, err)
+		}
+		if !ok {
+			continue
+		}
+
+		var doc struct {
+			LastMs int64 `bson:"lastms"`
+		}
+		findOpts := options.FindOne().SetProjection(bson.M{"lastms": 1})
+		if err := coll.FindOne(ctx, bson.M{"_id": name}, findOpts).Decode(&doc); err != nil {
+			return nil, fmt.Errorf("snowflake: read %s: %w", name, err)
+		}
+		g, err := New(node, doc.LastMs)
+		if err != nil {
+			return nil, err
+		}
+		return &leasedNode{coll: coll, lease: l, name: name, gen: g}, nil
+	}
+	return nil, fmt.Errorf("snowflake: all %d node ids are leased", MaxNode+1)
+}
+
+// keepAlive 每 ttl/3 续约一次并记录 lastms；租约被他人占用或超过 ttl 未能续约时停止生成。
+// ctx 结束时记录 lastms 并释放租约。
+func (n *leasedNode) keepAlive(ctx context.Context) {
+	ticker := time.NewTicker(nodeLeaseTTL / 3)
+	defer ticker.Stop()
+	renewedAt := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			n.gen.Stop(ErrNotReady)
+			releaseCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			if err := n.saveLastMs(releaseCtx); err != nil {
+				klog.Warn("snowflake: save lastms of ", n.name, " failed: ", err.Error())
+			}
+			if err := n.lease.Release(releaseCtx); err != nil {
+				klog.Warn("snowflake: release ", n.name, " failed: ", err.Error())
+			}
+			cancel()
+			return
+		case <-ticker.C:
+		}
+
+		ok, err := n.lease.Acquire(ctx)
+		if err == nil && ok {
+			err = n.saveLastMs(ctx)
+		}
+		switch {
+		case err == nil && ok:
+			renewedAt = time.Now()
+		case err == nil && !ok:
+			klog.Error("snowflake: ", n.name, " is held by another owner, stop generating ids")
+			n.gen.Stop(ErrNodeLeaseLost)
+			return
+		default:
+			klog.Warn("snowflake: renew ", n.name, " failed: ", err.Error())
+			// 留出一个续约周期的余量，租约过期前就停止生成
+			if time.Since(renewedAt) > nodeLeaseTTL-nodeLeaseTTL/3 {
+				klog.Error("snowflake: ", n.name, " may have expired, stop generating ids")
+				n.gen.Stop(ErrNodeLeaseLost)
+				return
+			}
+		}
+	}
+}
+
+func (n *leasedNode) saveLastMs(ctx context.Context) error {
+	_, err := n.coll.UpdateOne(ctx,
+		bson.M{"_id": n.name, "owner": n.lease.Owner()},
+		bson.M{"$max": bson.M{"lastms": n.gen.LastMs()}},
+	)
+	return err
+}
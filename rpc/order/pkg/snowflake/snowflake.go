@@ -1,23 +1,149 @@
 package snowflake
 
 import (
-	"log"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
+)
+
+// 位布局与之前使用的 github.com/bwmarrin/snowflake 默认配置一致：
+// 41 位毫秒时间戳 + 10 位节点 id + 12 位序号，已生成的 id 可以按同样的方式解码
+const (
+	nodeBits  = 10
+	stepBits  = 12
+	MaxNode   = 1<<nodeBits - 1
+	stepMask  = 1<<stepBits - 1
+	timeShift = nodeBits + stepBits
+	nodeShift = stepBits
+)
+
+// Epoch 时间戳起点，不能修改，否则已生成的 id 会解码出错误的时间
+var Epoch = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// maxBackwardsWait 时钟回拨不超过该值时等待时钟追上，超过时拒绝生成
+const maxBackwardsWait = 10 * time.Millisecond
 
-	"github.com/bwmarrin/snowflake"
+var (
+	ErrClockMovedBackwards = errors.New("snowflake: clock moved backwards")
+	ErrNotReady            = errors.New("snowflake: node id not assigned")
 )
 
-var SfNode *snowflake.Node
+// Generator 单个节点的 id 生成器，并发安全
+type Generator struct {
+	mu      sync.Mutex
+	node    int64
+	lastMs  int64 // 最近一次生成使用的时间戳（相对 Epoch 的毫秒数）
+	step    int64
+	stopErr error
+	now     func() int64
+}
+
+// New 创建节点 node 的生成器。lastMs 为该节点上次使用的时间戳（相对 Epoch 的毫秒数，未知时传 0），
+// 当前时钟落后于它时，时钟追上之前不会生成 id，避免重启后时钟回拨产生重复。
+func New(node, lastMs int64) (*Generator, error) {
+	if node < 0 || node > MaxNode {
+		return nil, fmt.Errorf("snowflake: node id %d out of range [0, %d]", node, MaxNode)
+	}
+	return &Generator{
+		node:   node,
+		lastMs: lastMs,
+		step:   stepMask, // 与 lastMs 同一毫秒时直接进入下一毫秒
+		now: func() int64 {
+			return time.Since(Epoch).Milliseconds()
+		},
+	}, nil
+}
+
+func (g *Generator) Node() int64 {
+	return g.node
+}
+
+// LastMs 最近一次生成使用的时间戳（相对 Epoch 的毫秒数）
+func (g *Generator) LastMs() int64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.lastMs
+}
+
+// Stop 停止生成，之后 Generate 都返回 err（如节点租约丢失，节点 id 可能已被其他副本使用）
+func (g *Generator) Stop(err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.stopErr = err
+}
+
+// Generate 生成一个 id。时钟回拨不超过 maxBackwardsWait 时等待，否则返回 ErrClockMovedBackwards。
+func (g *Generator) Generate() (int64, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.stopErr != nil {
+		return 0, g.stopErr
+	}
 
-func init() {
-	// 第一步：先设置Epoch（必须在NewNode之前！）
-	snowflake.Epoch = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC).UnixMilli()
+	now := g.now()
+	if now < g.lastMs {
+		behind := time.Duration(g.lastMs-now) * time.Millisecond
+		if behind > maxBackwardsWait {
+			return 0, fmt.Errorf("%w: %s behind node %d's last timestamp", ErrClockMovedBackwards, behind, g.node)
+		}
+		time.Sleep(behind)
+		now = g.waitAfter(g.lastMs - 1)
+	}
+
+	if now == g.lastMs {
+		g.step = (g.step + 1) & stepMask
+		if g.step == 0 {
+			// 本毫秒的序号用完，等到下一毫秒
+			now = g.waitAfter(g.lastMs)
+		}
+	} else {
+		g.step = 0
+	}
+	g.lastMs = now
+
+	return now<<timeShift | g.node<<nodeShift | g.step, nil
+}
+
+// waitAfter 自旋到时钟超过 ms
+func (g *Generator) waitAfter(ms int64) int64 {
+	now := g.now()
+	for now <= ms {
+		time.Sleep(100 * time.Microsecond)
+		now = g.now()
+	}
+	return now
+}
+
+// Decoded id 的组成部分
+type Decoded struct {
+	Time     time.Time
+	Node     int64
+	Sequence int64
+}
+
+// Decode 拆解 id，不校验 id 是否由本服务生成
+func Decode(id int64) Decoded {
+	return Decoded{
+		Time:     Epoch.Add(time.Duration(id>>timeShift) * time.Millisecond),
+		Node:     id >> nodeShift & MaxNode,
+		Sequence: id & stepMask,
+	}
+}
+
+var defaultGenerator atomic.Pointer[Generator]
+
+// SetDefault 设置 Generate 使用的生成器
+func SetDefault(g *Generator) {
+	defaultGenerator.Store(g)
+}
 
-	// 第二步：再创建节点（此时节点会使用上面的Epoch）
-	nodeID := int64(778)
-	var err error
-	SfNode, err = snowflake.NewNode(nodeID)
-	if err != nil {
-		log.Fatalf("雪花算法初始化失败：%v", err)
+// Generate 用默认生成器生成 id，未初始化时返回 ErrNotReady
+func Generate() (int64, error) {
+	g := defaultGenerator.Load()
+	if g == nil {
+		return 0, ErrNotReady
 	}
+	return g.Generate()
 }
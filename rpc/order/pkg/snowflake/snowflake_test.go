@@ -0,0 +1,79 @@
+package snowflake
+
+import (
+	"errors"
+	"testing"
+)
+
+// fakeClock 返回 ms 中的时间戳，用完后停在最后一个
+func fakeClock(ms ...int64) func() int64 {
+	i := 0
+	return func() int64 {
+		v := ms[i]
+		if i < len(ms)-1 {
+			i++
+		}
+		return v
+	}
+}
+
+// 租约释放后保留 lastms，下一个持有者用它创建生成器，时钟回拨时不会生成重复 id
+func TestNextHolderUsesReleasedLastMs(t *testing.T) {
+	prev, err := New(5, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prev.now = fakeClock(1000)
+	var lastID int64
+	for i := 0; i < 3; i++ {
+		if lastID, err = prev.Generate(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	t.Run("clock far behind", func(t *testing.T) {
+		next, err := New(5, prev.LastMs())
+		if err != nil {
+			t.Fatal(err)
+		}
+		next.now = fakeClock(900)
+		if _, err := next.Generate(); !errors.Is(err, ErrClockMovedBackwards) {
+			t.Fatalf("err = %v, want ErrClockMovedBackwards", err)
+		}
+	})
+
+	t.Run("same millisecond moves to the next one", func(t *testing.T) {
+		next, err := New(5, prev.LastMs())
+		if err != nil {
+			t.Fatal(err)
+		}
+		next.now = fakeClock(1000, 1000, 1001)
+		id, err := next.Generate()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id <= lastID {
+			t.Fatalf("id %d not after previous holder's last id %d", id, lastID)
+		}
+		if d := Decode(id); d.Node != 5 || d.Sequence != 0 {
+			t.Fatalf("decoded = %+v", d)
+		}
+	})
+
+	t.Run("without lastms the same id is reused", func(t *testing.T) {
+		// 租约文档被删除时下一个持有者只能从 0 开始，同一毫秒会生成重复 id
+		next, err := New(5, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		next.now = fakeClock(1000)
+		first, err := next.Generate()
+		if err != nil {
+			t.Fatal(err)
+		}
+		prevFirst := int64(1000)<<timeShift | 5<<nodeShift
+		if first != prevFirst {
+			t.Fatalf("id = %d, want %d", first, prevFirst)
+		}
+	})
+}
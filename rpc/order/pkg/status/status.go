@@ -0,0 +1,75 @@
+package status
+
+// 订单状态，与 IDL Order.status 的注释保持一致
+const (
+	PendingPayment int32 = 1 // 待支付
+	Paid           int32 = 2 // 已支付
+	Cancelled      int32 = 3 // 已取消
+	Completed      int32 = 4 // 已完成
+	Confirmed      int32 = 5 // 已成团（仅团购订单）
+)
+
+// 订单类型，与 IDL Order.type 的注释保持一致
+const (
+	TypeNormal   int32 = 1 // 普通订单
+	TypeSeckill  int32 = 2 // 秒杀订单
+	TypeGroupBuy int32 = 3 // 团购订单
+)
+
+// Machine 描述一种订单类型允许的初始状态和状态流转
+type Machine struct {
+	Initial     []int32
+	Transitions map[int32][]int32
+}
+
+// defaultMachine 待支付 -> 已支付/已取消，已支付 -> 已完成，已取消和已完成为终态
+var defaultMachine = Machine{
+	Initial: []int32{PendingPayment},
+	Transitions: map[int32][]int32{
+		PendingPayment: {Paid, Cancelled},
+		Paid:           {Completed},
+	},
+}
+
+// groupBuyMachine 团购订单支付后等待成团：成团 -> 已成团 -> 已完成；未成团 -> 已取消（已支付的自动退款）
+var groupBuyMachine = Machine{
+	Initial: []int32{PendingPayment},
+	Transitions: map[int32][]int32{
+		PendingPayment: {Paid, Cancelled},
+		Paid:           {Confirmed, Cancelled},
+		Confirmed:      {Completed},
+	},
+}
+
+// machines 按订单类型声明状态机，新增订单类型时在这里登记
+var machines = map[int32]Machine{
+	TypeNormal:   defaultMachine,
+	TypeSeckill:  defaultMachine,
+	TypeGroupBuy: groupBuyMachine,
+}
+
+// Lookup 返回订单类型对应的状态机，类型未登记时 ok 为 false
+func Lookup(orderType int32) (m Machine, ok bool) {
+	m, ok = machines[orderType]
+	return
+}
+
+// CanCreateWith 判断订单能否以 s 作为初始状态创建
+func (m Machine) CanCreateWith(s int32) bool {
+	for _, v := range m.Initial {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// CanTransit 判断订单能否从 from 流转到 to
+func (m Machine) CanTransit(from, to int32) bool {
+	for _, v := range m.Transitions[from] {
+		if v == to {
+			return true
+		}
+	}
+	return false
+}
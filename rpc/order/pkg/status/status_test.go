@@ -0,0 +1,50 @@
+package status
+
+import "testing"
+
+func TestCanCreateWith(t *testing.T) {
+	for _, typ := range []int32{TypeNormal, TypeSeckill, TypeGroupBuy} {
+		m, ok := Lookup(typ)
+		if !ok {
+			t.Fatalf("type %d not registered", typ)
+		}
+		for _, s := range []int32{PendingPayment, Paid, Cancelled, Completed, Confirmed} {
+			if got, want := m.CanCreateWith(s), s == PendingPayment; got != want {
+				t.Errorf("type %d CanCreateWith(%d) = %v, want %v", typ, s, got, want)
+			}
+		}
+	}
+	if _, ok := Lookup(0); ok {
+		t.Error("Lookup(0) should not be registered")
+	}
+}
+
+func TestCanTransit(t *testing.T) {
+	all := []int32{PendingPayment, Paid, Cancelled, Completed, Confirmed}
+	allowed := map[int32]map[[2]int32]bool{
+		TypeNormal: {
+			{PendingPayment, Paid}:      true,
+			{PendingPayment, Cancelled}: true,
+			{Paid, Completed}:           true,
+		},
+		TypeGroupBuy: {
+			{PendingPayment, Paid}:      true,
+			{PendingPayment, Cancelled}: true,
+			{Paid, Confirmed}:           true,
+			{Paid, Cancelled}:           true,
+			{Confirmed, Completed}:      true,
+		},
+	}
+	allowed[TypeSeckill] = allowed[TypeNormal]
+
+	for typ, edges := range allowed {
+		m, _ := Lookup(typ)
+		for _, from := range all {
+			for _, to := range all {
+				if got, want := m.CanTransit(from, to), edges[[2]int32{from, to}]; got != want {
+					t.Errorf("type %d CanTransit(%d, %d) = %v, want %v", typ, from, to, got, want)
+				}
+			}
+		}
+	}
+}
@@ -19,6 +19,7 @@ func OrderItem(orderItemForCreate order.OrderItemForCreate, itemId int64, orderI
 		Count:     orderItemForCreate.Count,
 		Price:     orderItemForCreate.Price,
 		Ext:       orderItemForCreate.Ext,
+		Subtotal:  orderItemForCreate.Price * orderItemForCreate.Count,
 	}
 	return &orderItem
 }
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	base "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/base"
+	order "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/snowflake"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/status"
+)
+
+func TestMain(m *testing.M) {
+	g, err := snowflake.New(1, 0)
+	if err != nil {
+		panic(err)
+	}
+	snowflake.SetDefault(g)
+	os.Exit(m.Run())
+}
+
+// paidOrder 两个订单项：item 1 单价 100 买 2 件，item 2 单价 50 买 1 件
+func paidOrder(typ, st int32) *order.Order {
+	return &order.Order{
+		Type:        typ,
+		Status:      st,
+		ReqUserId:   10,
+		RespUserId:  20,
+		TotalAmount: 250,
+		Items: []*order.OrderItem{
+			{Id: 1, ProductId: 100, SkuId: 1000, Count: 2, Price: 100},
+			{Id: 2, ProductId: 200, SkuId: 2000, Count: 1, Price: 50},
+		},
+	}
+}
+
+func refundReq(items ...*order.RefundItemForRequest) *order.RequestRefundRequest {
+	return &order.RequestRefundRequest{ReqUserId: 10, Items: items, Reason: "broken"}
+}
+
+func wantCode(t *testing.T, err error, code base.Code) {
+	t.Helper()
+	var refundErr *refundError
+	if !errors.As(err, &refundErr) {
+		t.Fatalf("err = %v, want refundError with code %v", err, code)
+	}
+	if refundErr.code != code {
+		t.Fatalf("code = %v, want %v (%s)", refundErr.code, code, refundErr.msg)
+	}
+}
+
+func TestPlanRefund(t *testing.T) {
+	t.Run("full amount by default", func(t *testing.T) {
+		o := paidOrder(status.TypeNormal, status.Paid)
+		r, err := planRefund(o, refundReq(&order.RefundItemForRequest{ItemId: 1, Count: 2}), 1000)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.Amount != 200 || r.Status != order.RefundStatus_REQUESTED || r.CreatedAt != 1000 || r.Id == 0 {
+			t.Fatalf("unexpected refund: %+v", r)
+		}
+		if len(r.Items) != 1 || r.Items[0].SkuId != 1000 || r.Items[0].Count != 2 {
+			t.Fatalf("unexpected items: %+v", r.Items)
+		}
+	})
+
+	t.Run("partial amount", func(t *testing.T) {
+		o := paidOrder(status.TypeNormal, status.Paid)
+		amount := int64(30)
+		req := refundReq(&order.RefundItemForRequest{ItemId: 2, Count: 1})
+		req.Amount = &amount
+		r, err := planRefund(o, req, 1000)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.Amount != 30 {
+			t.Fatalf("amount = %d, want 30", r.Amount)
+		}
+	})
+
+	t.Run("amount above item value", func(t *testing.T) {
+		o := paidOrder(status.TypeNormal, status.Paid)
+		amount := int64(51)
+		req := refundReq(&order.RefundItemForRequest{ItemId: 2, Count: 1})
+		req.Amount = &amount
+		_, err := planRefund(o, req, 1000)
+		wantCode(t, err, base.Code_INVALID_PARAM)
+	})
+
+	t.Run("not the buyer", func(t *testing.T) {
+		o := paidOrder(status.TypeNormal, status.Paid)
+		req := refundReq(&order.RefundItemForRequest{ItemId: 1, Count: 1})
+		req.ReqUserId = 11
+		_, err := planRefund(o, req, 1000)
+		wantCode(t, err, base.Code_INVALID_PARAM)
+	})
+
+	t.Run("unpaid order", func(t *testing.T) {
+		for _, st := range []int32{status.PendingPayment, status.Cancelled} {
+			o := paidOrder(status.TypeNormal, st)
+			_, err := planRefund(o, refundReq(&order.RefundItemForRequest{ItemId: 1, Count: 1}), 1000)
+			wantCode(t, err, base.Code_INVALID_STATUS_TRANSITION)
+		}
+	})
+
+	t.Run("unknown item", func(t *testing.T) {
+		o := paidOrder(status.TypeNormal, status.Paid)
+		_, err := planRefund(o, refundReq(&order.RefundItemForRequest{ItemId: 3, Count: 1}), 1000)
+		wantCode(t, err, base.Code_INVALID_PARAM)
+	})
+
+	t.Run("count counts earlier active refunds only", func(t *testing.T) {
+		o := paidOrder(status.TypeNormal, status.Completed)
+		o.Refunds = []*order.Refund{
+			{Status: order.RefundStatus_COMPLETED, Amount: 100, Items: []*order.RefundItem{{ItemId: 1, Count: 1}}},
+			{Status: order.RefundStatus_REJECTED, Amount: 100, Items: []*order.RefundItem{{ItemId: 1, Count: 1}}},
+		}
+		if _, err := planRefund(o, refundReq(&order.RefundItemForRequest{ItemId: 1, Count: 1}), 1000); err != nil {
+			t.Fatal(err)
+		}
+		_, err := planRefund(o, refundReq(&order.RefundItemForRequest{ItemId: 1, Count: 2}), 1000)
+		wantCode(t, err, base.Code_INVALID_PARAM)
+	})
+
+	t.Run("amount above refundable amount", func(t *testing.T) {
+		o := paidOrder(status.TypeNormal, status.Paid)
+		o.Refunds = []*order.Refund{
+			{Status: order.RefundStatus_APPROVED, Amount: 220, Items: []*order.RefundItem{{ItemId: 2, Count: 1}}},
+		}
+		_, err := planRefund(o, refundReq(&order.RefundItemForRequest{ItemId: 1, Count: 1}), 1000)
+		wantCode(t, err, base.Code_INVALID_PARAM)
+	})
+}
+
+func shipReq(items ...*order.ShipmentItemForRequest) *order.CreateShipmentRequest {
+	return &order.CreateShipmentRequest{RespUserId: 20, Carrier: "sf", TrackingNo: "SF1", Items: items}
+}
+
+func TestPlanShipment(t *testing.T) {
+	t.Run("ships part of the order", func(t *testing.T) {
+		o := paidOrder(status.TypeNormal, status.Paid)
+		s, err := planShipment(o, shipReq(&order.ShipmentItemForRequest{ItemId: 1, Count: 1}), 1000)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.Status != order.ShipmentStatus_SHIPPED || s.ShippedAt != 1000 || s.Id == 0 {
+			t.Fatalf("unexpected shipment: %+v", s)
+		}
+		o.Shipments = append(o.Shipments, s)
+		if fullyShipped(o) {
+			t.Fatal("order should not be fully shipped yet")
+		}
+	})
+
+	t.Run("not the merchant", func(t *testing.T) {
+		o := paidOrder(status.TypeNormal, status.Paid)
+		req := shipReq(&order.ShipmentItemForRequest{ItemId: 1, Count: 1})
+		req.RespUserId = 21
+		_, err := planShipment(o, req, 1000)
+		wantCode(t, err, base.Code_INVALID_PARAM)
+	})
+
+	t.Run("only shippable statuses", func(t *testing.T) {
+		cases := []struct {
+			typ, st int32
+			ok      bool
+		}{
+			{status.TypeNormal, status.PendingPayment, false},
+			{status.TypeNormal, status.Paid, true},
+			{status.TypeSeckill, status.Paid, true},
+			{status.TypeNormal, status.Completed, false},
+			{status.TypeGroupBuy, status.Paid, false},
+			{status.TypeGroupBuy, status.Confirmed, true},
+		}
+		for _, c := range cases {
+			o := paidOrder(c.typ, c.st)
+			_, err := planShipment(o, shipReq(&order.ShipmentItemForRequest{ItemId: 1, Count: 1}), 1000)
+			if c.ok && err != nil {
+				t.Errorf("type %d status %d: %v", c.typ, c.st, err)
+			}
+			if !c.ok {
+				wantCode(t, err, base.Code_INVALID_STATUS_TRANSITION)
+			}
+		}
+	})
+
+	t.Run("cannot ship more than left", func(t *testing.T) {
+		o := paidOrder(status.TypeNormal, status.Paid)
+		o.Shipments = []*order.Shipment{{Items: []*order.ShipmentItem{{ItemId: 1, Count: 1}}}}
+		_, err := planShipment(o, shipReq(&order.ShipmentItemForRequest{ItemId: 1, Count: 2}), 1000)
+		wantCode(t, err, base.Code_INVALID_PARAM)
+	})
+
+	t.Run("refunded items need not ship", func(t *testing.T) {
+		o := paidOrder(status.TypeNormal, status.Paid)
+		o.Shipments = []*order.Shipment{{Items: []*order.ShipmentItem{{ItemId: 1, Count: 2}}}}
+		o.Refunds = []*order.Refund{
+			{Status: order.RefundStatus_REQUESTED, Items: []*order.RefundItem{{ItemId: 2, Count: 1}}},
+		}
+		if !fullyShipped(o) {
+			t.Fatal("refunded item should count as shipped")
+		}
+		_, err := planShipment(o, shipReq(&order.ShipmentItemForRequest{ItemId: 2, Count: 1}), 1000)
+		wantCode(t, err, base.Code_INVALID_PARAM)
+
+		o.Refunds[0].Status = order.RefundStatus_REJECTED
+		if fullyShipped(o) {
+			t.Fatal("rejected refund should not count as shipped")
+		}
+	})
+}
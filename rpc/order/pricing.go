@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	order "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/catalog"
+)
+
+// pricingError 表示订单项与商品目录不符，Error() 可以直接返回给调用方
+type pricingError struct {
+	msg string
+}
+
+func (e *pricingError) Error() string {
+	return e.msg
+}
+
+// priceItems 按商品目录校验订单项归属，并用目录价格覆盖客户端传入的 price，返回订单总金额。
+// 目录服务不可用时返回普通 error，订单项不合法时返回 pricingError。
+func priceItems(ctx context.Context, cli catalog.Client, merchantId int64, items []*order.OrderItemForCreate) (int64, error) {
+	skus, err := lookupSkus(ctx, cli, items)
+	if err != nil {
+		return 0, err
+	}
+	return applyPrices(merchantId, items, skus)
+}
+
+// lookupSkus 查询每个订单项的 SKU，并校验 SKU 属于订单项的商品，返回的 SKU 与 items 一一对应
+func lookupSkus(ctx context.Context, cli catalog.Client, items []*order.OrderItemForCreate) ([]*catalog.Sku, error) {
+	skus := make([]*catalog.Sku, 0, len(items))
+	for i, item := range items {
+		sku, err := cli.GetSku(ctx, item.SkuId)
+		if err != nil {
+			if errors.Is(err, catalog.ErrSkuNotFound) {
+				return nil, &pricingError{msg: fmt.Sprintf("第 %d 个订单项的 SKU %d 不存在.", i+1, item.SkuId)}
+			}
+			return nil, err
+		}
+		if sku.ProductID != item.ProductId {
+			return nil, &pricingError{msg: fmt.Sprintf("第 %d 个订单项的 SKU %d 不属于商品 %d.", i+1, item.SkuId, item.ProductId)}
+		}
+		skus = append(skus, sku)
+	}
+	return skus, nil
+}
+
+// applyPrices 校验 SKU 都属于商户 merchantId，用目录价格覆盖 price，返回订单总金额
+func applyPrices(merchantId int64, items []*order.OrderItemForCreate, skus []*catalog.Sku) (int64, error) {
+	var total int64
+	for i, item := range items {
+		sku := skus[i]
+		if sku.MerchantID != merchantId {
+			return 0, &pricingError{msg: fmt.Sprintf("第 %d 个订单项的 SKU %d 不属于商户 %d.", i+1, item.SkuId, merchantId)}
+		}
+		item.Price = sku.Price
+		total += item.Price * item.Count
+	}
+	return total, nil
+}
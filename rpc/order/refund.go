@@ -0,0 +1,306 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/base"
+	order "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/snowflake"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/status"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/trans"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	mongoOfficial "go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// 并发修改同一订单时，读取-校验-写入最多重试的次数
+const maxRefundAttempts = 3
+
+var errRefundNotFound = errors.New("refund not found")
+
+// refundError 表示退款请求不满足业务规则，Error() 可以直接返回给调用方
+type refundError struct {
+	code base.Code
+	msg  string
+}
+
+func (e *refundError) Error() string {
+	return e.msg
+}
+
+func invalidRefund(format string, args ...any) error {
+	return &refundError{code: base.Code_INVALID_PARAM, msg: fmt.Sprintf(format, args...)}
+}
+
+func invalidRefundTransition(from, to order.RefundStatus) error {
+	return &refundError{
+		code: base.Code_INVALID_STATUS_TRANSITION,
+		msg:  fmt.Sprintf("退款单状态不允许从 %s 变更为 %s.", from, to),
+	}
+}
+
+// refundBaseResp 把退款相关的错误转换为响应码，err 为 nil 时返回成功
+func refundBaseResp(err error) *base.BaseResponse {
+	var refundErr *refundError
+	switch {
+	case err == nil:
+		return &base.BaseResponse{Code: base.Code_SUCCESS, Msg: successMsg}
+	case errors.As(err, &refundErr):
+		return &base.BaseResponse{Code: refundErr.code, Msg: refundErr.msg}
+	case errors.Is(err, errOrderNotFound):
+		return &base.BaseResponse{Code: base.Code_NOT_FOUND, Msg: "订单不存在"}
+	case errors.Is(err, errRefundNotFound):
+		return &base.BaseResponse{Code: base.Code_NOT_FOUND, Msg: "退款单不存在"}
+	case errors.Is(err, errVersionConflict):
+		return &base.BaseResponse{Code: base.Code_VERSION_CONFLICT, Msg: "订单正在被修改，请稍后再试"}
+	default:
+		return &base.BaseResponse{Code: base.Code_DB_ERR, Msg: internalErrMsg}
+	}
+}
+
+func loadOrder(ctx context.Context, objectId primitive.ObjectID) (*order.Order, error) {
+	var doc trans.OrderDoc
+	if err := Coll.FindOne(ctx, bson.M{"_id": objectId}).Decode(&doc); err != nil {
+		if errors.Is(err, mongoOfficial.ErrNoDocuments) {
+			return nil, errOrderNotFound
+		}
+		return nil, err
+	}
+	return &doc.Order, nil
+}
+
+// mutateOrder 读取订单后由 fn 计算要写入的字段，以读到的版本号为条件写入；
+// 期间订单被并发修改时重新读取并重试。fn 返回空的 set 表示无需写入。
+func mutateOrder(ctx context.Context, objectId primitive.ObjectID, fn func(o *order.Order) (bson.D, error)) error {
+	for attempt := 1; ; attempt++ {
+		o, err := loadOrder(ctx, objectId)
+		if err != nil {
+			return err
+		}
+		set, err := fn(o)
+		if err != nil || len(set) == 0 {
+			return err
+		}
+		err = updateFields(ctx, objectId, set, nil, &o.Version)
+		if errors.Is(err, errVersionConflict) && attempt < maxRefundAttempts {
+			continue
+		}
+		return err
+	}
+}
+
+// activeRefund 被拒绝的退款单不占用可退数量和金额
+func activeRefund(r *order.Refund) bool {
+	return r.Status != order.RefundStatus_REJECTED
+}
+
+// refundableAmount 订单还可以申请的退款金额
+func refundableAmount(o *order.Order) int64 {
+	amount := o.TotalAmount
+	for _, r := range o.Refunds {
+		if activeRefund(r) {
+			amount -= r.Amount
+		}
+	}
+	if amount < 0 {
+		return 0
+	}
+	return amount
+}
+
+func findRefund(o *order.Order, refundId int64) (int, *order.Refund, error) {
+	for i, r := range o.Refunds {
+		if r.Id == refundId {
+			return i, r, nil
+		}
+	}
+	return 0, nil, errRefundNotFound
+}
+
+func validateRequestRefundReq(req *order.RequestRefundRequest) error {
+	if req.OrderId == "" {
+		return errors.New("订单ID不能为空")
+	}
+	if req.ReqUserId <= 0 {
+		return errors.New("申请人ID必须大于 0.")
+	}
+	if len(req.Items) == 0 {
+		return errors.New("退款订单项不能为空.")
+	}
+	seen := make(map[int64]bool, len(req.Items))
+	for i, item := range req.Items {
+		if item == nil {
+			return fmt.Errorf("第 %d 个退款订单项为空.", i+1)
+		}
+		if item.Count <= 0 {
+			return fmt.Errorf("第 %d 个退款订单项的数量必须大于 0.", i+1)
+		}
+		if seen[item.ItemId] {
+			return fmt.Errorf("订单项 %d 重复出现.", item.ItemId)
+		}
+		seen[item.ItemId] = true
+	}
+	if req.Amount != nil && *req.Amount <= 0 {
+		return errors.New("退款金额必须大于 0.")
+	}
+	return nil
+}
+
+// planRefund 按订单当前内容校验退款申请并生成退款单
+func planRefund(o *order.Order, req *order.RequestRefundRequest, now int64) (*order.Refund, error) {
+	if o.ReqUserId != req.ReqUserId {
+		return nil, invalidRefund("只有下单用户可以申请退款.")
+	}
+	if o.Status != status.Paid && o.Status != status.Confirmed && o.Status != status.Completed {
+		return nil, &refundError{
+			code: base.Code_INVALID_STATUS_TRANSITION,
+			msg:  fmt.Sprintf("订单状态为 %d，只有已支付、已成团或已完成的订单可以申请退款.", o.Status),
+		}
+	}
+
+	// 同一订单项累计的退货数量不能超过购买数量
+	refunded := make(map[int64]int64)
+	for _, r := range o.Refunds {
+		if !activeRefund(r) {
+			continue
+		}
+		for _, it := range r.Items {
+			refunded[it.ItemId] += it.Count
+		}
+	}
+	items := make(map[int64]*order.OrderItem, len(o.Items))
+	for _, it := range o.Items {
+		items[it.Id] = it
+	}
+
+	refundId, err := snowflake.Generate()
+	if err != nil {
+		return nil, err
+	}
+	refund := &order.Refund{
+		Id:        refundId,
+		Status:    order.RefundStatus_REQUESTED,
+		Reason:    req.Reason,
+		CreatedAt: now,
+	}
+	var maxAmount int64
+	for _, it := range req.Items {
+		item, ok := items[it.ItemId]
+		if !ok {
+			return nil, invalidRefund("订单项 %d 不属于该订单.", it.ItemId)
+		}
+		if left := item.Count - refunded[it.ItemId]; it.Count > left {
+			return nil, invalidRefund("订单项 %d 还可以退 %d 件，申请了 %d 件.", it.ItemId, left, it.Count)
+		}
+		maxAmount += item.Price * it.Count
+		refund.Items = append(refund.Items, &order.RefundItem{
+			ItemId:    item.Id,
+			ProductId: item.ProductId,
+			SkuId:     item.SkuId,
+			Count:     it.Count,
+		})
+	}
+
+	refund.Amount = maxAmount
+	if req.Amount != nil {
+		if *req.Amount > maxAmount {
+			return nil, invalidRefund("退款金额 %d 超过退货商品的支付金额 %d.", *req.Amount, maxAmount)
+		}
+		refund.Amount = *req.Amount
+	}
+	if left := refundableAmount(o); refund.Amount > left {
+		return nil, invalidRefund("退款金额 %d 超过订单剩余可退金额 %d.", refund.Amount, left)
+	}
+	return refund, nil
+}
+
+// requestRefund 追加一张退款单，返回退款单 id
+func requestRefund(ctx context.Context, objectId primitive.ObjectID, req *order.RequestRefundRequest) (int64, error) {
+	var refundId int64
+	err := mutateOrder(ctx, objectId, func(o *order.Order) (bson.D, error) {
+		refund, err := planRefund(o, req, time.Now().Unix())
+		if err != nil {
+			return nil, err
+		}
+		refundId = refund.Id
+		return bson.D{
+			bson.E{Key: "order.refunds", Value: append(o.Refunds, refund)},
+		}, nil
+	})
+	return refundId, err
+}
+
+// reviewRefund 审核退款单。重复审核为同一结果时直接返回当前退款单，调用方可以安全重试。
+func reviewRefund(ctx context.Context, objectId primitive.ObjectID, req *order.ReviewRefundRequest) (*order.Refund, error) {
+	to := order.RefundStatus_REJECTED
+	if req.Approve {
+		to = order.RefundStatus_APPROVED
+	}
+
+	var result *order.Refund
+	err := mutateOrder(ctx, objectId, func(o *order.Order) (bson.D, error) {
+		if o.RespUserId != req.RespUserId {
+			return nil, invalidRefund("只有商户可以审核退款.")
+		}
+		idx, refund, err := findRefund(o, req.RefundId)
+		if err != nil {
+			return nil, err
+		}
+		result = refund
+
+		switch {
+		case refund.Status == to:
+			return nil, nil
+		case to == order.RefundStatus_APPROVED && refund.Status == order.RefundStatus_COMPLETED:
+			return nil, nil
+		case refund.Status != order.RefundStatus_REQUESTED:
+			return nil, invalidRefundTransition(refund.Status, to)
+		}
+
+		reviewed := *refund
+		reviewed.Status = to
+		reviewed.ReviewedAt = time.Now().Unix()
+		if !req.Approve {
+			reviewed.RejectReason = req.GetRejectReason()
+		}
+		result = &reviewed
+		return bson.D{
+			bson.E{Key: fmt.Sprintf("order.refunds.%d", idx), Value: &reviewed},
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// completeRefund 标记退款已到账，并累加到 order.refundedamount
+func completeRefund(ctx context.Context, objectId primitive.ObjectID, req *order.CompleteRefundRequest) error {
+	return mutateOrder(ctx, objectId, func(o *order.Order) (bson.D, error) {
+		if o.RespUserId != req.RespUserId {
+			return nil, invalidRefund("只有商户可以完成退款.")
+		}
+		idx, refund, err := findRefund(o, req.RefundId)
+		if err != nil {
+			return nil, err
+		}
+		switch refund.Status {
+		case order.RefundStatus_COMPLETED:
+			return nil, nil
+		case order.RefundStatus_APPROVED:
+		default:
+			return nil, invalidRefundTransition(refund.Status, order.RefundStatus_COMPLETED)
+		}
+
+		completed := *refund
+		completed.Status = order.RefundStatus_COMPLETED
+		completed.CompletedAt = time.Now().Unix()
+		return bson.D{
+			bson.E{Key: fmt.Sprintf("order.refunds.%d", idx), Value: &completed},
+			bson.E{Key: "order.refundedamount", Value: o.RefundedAmount + completed.Amount},
+		}, nil
+	})
+}
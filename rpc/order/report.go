@@ -0,0 +1,217 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+	_ "time/tzdata" // 容器中可能没有时区数据，校验 IANA 时区名时使用内置的
+
+	order "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/status"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	mongoOfficial "go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+const (
+	maxReportRange = 2 * 366 * 24 * time.Hour
+	maxReportTopN  = 100
+)
+
+// 默认只统计已收款的订单
+var defaultReportStatuses = []int32{status.Paid, status.Confirmed, status.Completed}
+
+var utcOffsetPattern = regexp.MustCompile(`^[+-]\d{2}(:?\d{2})?$`)
+
+// reportUnits 统计粒度对应 $dateTrunc 的 unit 和周期标签格式
+var reportUnits = map[order.ReportGranularity]struct {
+	unit, format string
+}{
+	order.ReportGranularity_DAY:   {unit: "day", format: "%Y-%m-%d"},
+	order.ReportGranularity_WEEK:  {unit: "week", format: "%Y-%m-%d"},
+	order.ReportGranularity_MONTH: {unit: "month", format: "%Y-%m"},
+}
+
+func validateReportReq(req *order.ReportRequest) error {
+	if req.RespUserId <= 0 {
+		return errors.New("商户ID必须大于 0.")
+	}
+	if req.From >= req.To {
+		return errors.New("起始时间必须早于结束时间.")
+	}
+	if time.Duration(req.To-req.From)*time.Second > maxReportRange {
+		return errors.New("统计时间跨度不能超过 2 年.")
+	}
+	if _, ok := reportUnits[req.Granularity]; !ok {
+		return fmt.Errorf("统计粒度 %d 不存在.", req.Granularity)
+	}
+	if tz := req.Timezone; !utcOffsetPattern.MatchString(tz) {
+		if _, err := time.LoadLocation(tz); err != nil || tz == "" || tz == "Local" {
+			return fmt.Errorf("时区 %q 不存在.", tz)
+		}
+	}
+	if req.TopN < 1 || req.TopN > maxReportTopN {
+		return fmt.Errorf("top_n 必须在 1 到 %d 之间.", maxReportTopN)
+	}
+	return nil
+}
+
+// reportPipeline 一次聚合同时算出按周期的统计、总计和两个热销 SKU 排行
+func reportPipeline(req *order.ReportRequest) mongoOfficial.Pipeline {
+	statuses := req.Statuses
+	if len(statuses) == 0 {
+		statuses = defaultReportStatuses
+	}
+	match := bson.D{
+		{Key: "order.respuserid", Value: req.RespUserId},
+		{Key: "order.createdat", Value: bson.D{{Key: "$gte", Value: req.From}, {Key: "$lt", Value: req.To}}},
+		{Key: "order.status", Value: bson.D{{Key: "$in", Value: statuses}}},
+	}
+
+	unit := reportUnits[req.Granularity]
+	// order.createdat 是 unix 秒，先转换为日期再按时区截断
+	createdAt := bson.D{{Key: "$toDate", Value: bson.D{{Key: "$multiply", Value: bson.A{"$order.createdat", 1000}}}}}
+	trunc := bson.D{
+		{Key: "date", Value: createdAt},
+		{Key: "unit", Value: unit.unit},
+		{Key: "timezone", Value: req.Timezone},
+	}
+	if req.Granularity == order.ReportGranularity_WEEK {
+		trunc = append(trunc, bson.E{Key: "startOfWeek", Value: "monday"})
+	}
+
+	orderSums := func(id any) bson.D {
+		return bson.D{
+			{Key: "_id", Value: id},
+			{Key: "ordercount", Value: bson.D{{Key: "$sum", Value: 1}}},
+			{Key: "revenue", Value: bson.D{{Key: "$sum", Value: "$order.totalamount"}}},
+			{Key: "refunded", Value: bson.D{{Key: "$sum", Value: "$order.refundedamount"}}},
+		}
+	}
+	buckets := bson.A{
+		bson.D{{Key: "$group", Value: orderSums(bson.D{{Key: "$dateTrunc", Value: trunc}})}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "_id", Value: 1}}}},
+		bson.D{{Key: "$addFields", Value: bson.D{{Key: "period", Value: bson.D{{Key: "$dateToString", Value: bson.D{
+			{Key: "date", Value: "$_id"},
+			{Key: "format", Value: unit.format},
+			{Key: "timezone", Value: req.Timezone},
+		}}}}}}},
+	}
+	totals := bson.A{
+		bson.D{{Key: "$group", Value: orderSums(nil)}},
+	}
+
+	return mongoOfficial.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$facet", Value: bson.D{
+			{Key: "buckets", Value: buckets},
+			{Key: "totals", Value: totals},
+			{Key: "byquantity", Value: topSkuStages("quantity", req.TopN)},
+			{Key: "byrevenue", Value: topSkuStages("revenue", req.TopN)},
+		}}},
+	}
+}
+
+// topSkuStages 按 SKU 汇总订单项并取 sortField 最大的 n 个。
+// 先按（SKU, 订单）分组，同一订单中重复出现的 SKU 只计一个订单。
+func topSkuStages(sortField string, n int32) bson.A {
+	return bson.A{
+		bson.D{{Key: "$unwind", Value: "$order.items"}},
+		bson.D{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{{Key: "sku", Value: "$order.items.skuid"}, {Key: "order", Value: "$_id"}}},
+			{Key: "productid", Value: bson.D{{Key: "$first", Value: "$order.items.productid"}}},
+			{Key: "quantity", Value: bson.D{{Key: "$sum", Value: "$order.items.count"}}},
+			{Key: "revenue", Value: bson.D{{Key: "$sum", Value: "$order.items.subtotal"}}},
+		}}},
+		bson.D{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$_id.sku"},
+			{Key: "productid", Value: bson.D{{Key: "$first", Value: "$productid"}}},
+			{Key: "quantity", Value: bson.D{{Key: "$sum", Value: "$quantity"}}},
+			{Key: "revenue", Value: bson.D{{Key: "$sum", Value: "$revenue"}}},
+			{Key: "ordercount", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: sortField, Value: -1}, {Key: "_id", Value: 1}}}},
+		bson.D{{Key: "$limit", Value: n}},
+	}
+}
+
+type reportSums struct {
+	OrderCount int64 `bson:"ordercount"`
+	Revenue    int64 `bson:"revenue"`
+	Refunded   int64 `bson:"refunded"`
+}
+
+type reportSku struct {
+	SkuId      int64 `bson:"_id"`
+	ProductId  int64 `bson:"productid"`
+	Quantity   int64 `bson:"quantity"`
+	Revenue    int64 `bson:"revenue"`
+	OrderCount int64 `bson:"ordercount"`
+}
+
+type reportResult struct {
+	Buckets []struct {
+		Start      time.Time `bson:"_id"`
+		Period     string    `bson:"period"`
+		OrderCount int64     `bson:"ordercount"`
+		Revenue    int64     `bson:"revenue"`
+		Refunded   int64     `bson:"refunded"`
+	} `bson:"buckets"`
+	Totals     []reportSums `bson:"totals"`
+	ByQuantity []reportSku  `bson:"byquantity"`
+	ByRevenue  []reportSku  `bson:"byrevenue"`
+}
+
+func toSkuSales(skus []reportSku) []*order.SkuSales {
+	sales := make([]*order.SkuSales, 0, len(skus))
+	for _, s := range skus {
+		sales = append(sales, &order.SkuSales{
+			SkuId:      s.SkuId,
+			ProductId:  s.ProductId,
+			Quantity:   s.Quantity,
+			Revenue:    s.Revenue,
+			OrderCount: s.OrderCount,
+		})
+	}
+	return sales
+}
+
+// buildReport 执行聚合并转换为响应（不含 BaseResp）
+func buildReport(ctx context.Context, req *order.ReportRequest) (*order.ReportResponse, error) {
+	cur, err := Coll.Aggregate(ctx, reportPipeline(req))
+	if err != nil {
+		return nil, err
+	}
+	var results []reportResult
+	if err := cur.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	resp := &order.ReportResponse{
+		Buckets:           []*order.ReportBucket{},
+		TopSkusByQuantity: []*order.SkuSales{},
+		TopSkusByRevenue:  []*order.SkuSales{},
+	}
+	if len(results) == 0 {
+		return resp, nil
+	}
+	r := results[0]
+	for _, b := range r.Buckets {
+		resp.Buckets = append(resp.Buckets, &order.ReportBucket{
+			Period:         b.Period,
+			PeriodStart:    b.Start.Unix(),
+			OrderCount:     b.OrderCount,
+			Revenue:        b.Revenue,
+			RefundedAmount: b.Refunded,
+		})
+	}
+	if len(r.Totals) > 0 {
+		resp.TotalOrderCount = r.Totals[0].OrderCount
+		resp.TotalRevenue = r.Totals[0].Revenue
+		resp.TotalRefundedAmount = r.Totals[0].Refunded
+	}
+	resp.TopSkusByQuantity = toSkuSales(r.ByQuantity)
+	resp.TopSkusByRevenue = toSkuSales(r.ByRevenue)
+	return resp, nil
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	order "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+const maxSearchLimit = 100
+
+// searchSortKeys 排序字段对应的 bson 路径
+var searchSortKeys = map[order.SearchSortField]string{
+	order.SearchSortField_CREATED_AT: "order.createdat",
+	order.SearchSortField_UPDATED_AT: "order.updatedat",
+}
+
+// orderFields 可投影的 Order 字段名（IDL 字段名）到 bson 路径
+var orderFields = map[string]string{
+	"id":               "order.id",
+	"type":             "order.type",
+	"status":           "order.status",
+	"req_user_id":      "order.requserid",
+	"resp_user_id":     "order.respuserid",
+	"items":            "order.items",
+	"created_at":       "order.createdat",
+	"updated_at":       "order.updatedat",
+	"ext":              "order.ext",
+	"status_history":   "order.statushistory",
+	"version":          "order.version",
+	"total_amount":     "order.totalamount",
+	"pay_deadline":     "order.paydeadline",
+	"refunds":          "order.refunds",
+	"refunded_amount":  "order.refundedamount",
+	"idempotency_key":  "order.idempotencykey",
+	"parent_id":        "order.parentid",
+	"shipments":        "order.shipments",
+	"auto_confirm_at":  "order.autoconfirmat",
+	"shipping_address": "order.shippingaddress",
+}
+
+// searchCursor 记录上一页最后一条的排序值和 _id，排序字段和方向变化时游标失效
+type searchCursor struct {
+	SortBy    order.SearchSortField `json:"s"`
+	Ascending bool                  `json:"a"`
+	Value     int64                 `json:"v"`
+	ID        string                `json:"i"`
+}
+
+func encodeSearchCursor(c searchCursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeSearchCursor(s string) (searchCursor, primitive.ObjectID, error) {
+	var c searchCursor
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, primitive.NilObjectID, errors.New("游标格式错误.")
+	}
+	if err := json.Unmarshal(data, &c); err != nil {
+		return c, primitive.NilObjectID, errors.New("游标格式错误.")
+	}
+	id, err := primitive.ObjectIDFromHex(c.ID)
+	if err != nil {
+		return c, primitive.NilObjectID, errors.New("游标格式错误.")
+	}
+	return c, id, nil
+}
+
+func validateSearchOrdersReq(req *order.SearchOrdersRequest) error {
+	if _, ok := searchSortKeys[req.SortBy]; !ok {
+		return errors.New("排序字段不存在.")
+	}
+	if req.Limit <= 0 || req.Limit > maxSearchLimit {
+		return fmt.Errorf("每页条数必须在 1 到 %d 之间.", maxSearchLimit)
+	}
+	for k := range req.Ext {
+		if k == "" || strings.ContainsAny(k, ".$") {
+			return fmt.Errorf("扩展字段 %q 不合法.", k)
+		}
+	}
+	return validateFields(req.Fields)
+}
+
+func validateFields(fields []string) error {
+	for _, f := range fields {
+		if _, ok := orderFields[f]; !ok {
+			return fmt.Errorf("字段 %q 不存在.", f)
+		}
+	}
+	return nil
+}
+
+// fieldsProjection 只取 fields 对应的字段，_id、order.id 和 keep 中的路径总是保留；fields 为空时返回 nil 表示完整文档
+func fieldsProjection(fields []string, keep ...string) bson.M {
+	if len(fields) == 0 {
+		return nil
+	}
+	projection := bson.M{"_id": 1, "order.id": 1}
+	for _, k := range keep {
+		projection[k] = 1
+	}
+	for _, f := range fields {
+		projection[orderFields[f]] = 1
+	}
+	return projection
+}
+
+// timeRange 生成 [from, to) 的范围条件，都未传时返回 nil
+func timeRange(from, to *int64) bson.M {
+	if from == nil && to == nil {
+		return nil
+	}
+	r := bson.M{}
+	if from != nil {
+		r["$gte"] = *from
+	}
+	if to != nil {
+		r["$lt"] = *to
+	}
+	return r
+}
+
+// buildSearchFilter 把请求中的条件组合成一个 $and 过滤器，包括游标条件
+func buildSearchFilter(req *order.SearchOrdersRequest) (bson.M, error) {
+	var conds bson.A
+	if len(req.Statuses) > 0 {
+		conds = append(conds, bson.M{"order.status": bson.M{"$in": req.Statuses}})
+	}
+	if req.Type != nil {
+		conds = append(conds, bson.M{"order.type": *req.Type})
+	}
+	if req.ReqUserId != nil {
+		conds = append(conds, bson.M{"order.requserid": *req.ReqUserId})
+	}
+	if req.RespUserId != nil {
+		conds = append(conds, bson.M{"order.respuserid": *req.RespUserId})
+	}
+	if r := timeRange(req.CreatedFrom, req.CreatedTo); r != nil {
+		conds = append(conds, bson.M{"order.createdat": r})
+	}
+	if r := timeRange(req.UpdatedFrom, req.UpdatedTo); r != nil {
+		conds = append(conds, bson.M{"order.updatedat": r})
+	}
+
+	// 商品和 SKU 同时传时必须命中同一个订单项
+	item := bson.M{}
+	if req.ProductId != nil {
+		item["productid"] = *req.ProductId
+	}
+	if req.SkuId != nil {
+		item["skuid"] = *req.SkuId
+	}
+	if len(item) > 0 {
+		conds = append(conds, bson.M{"order.items": bson.M{"$elemMatch": item}})
+	}
+
+	for k, v := range req.Ext {
+		conds = append(conds, bson.M{"order.ext." + k: v})
+	}
+	if req.ParentId != nil {
+		conds = append(conds, bson.M{"order.parentid": *req.ParentId})
+	}
+
+	if req.Cursor != nil && *req.Cursor != "" {
+		c, id, err := decodeSearchCursor(*req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		if c.SortBy != req.SortBy || c.Ascending != req.Ascending {
+			return nil, errors.New("游标与排序条件不一致.")
+		}
+		op := "$lt"
+		if req.Ascending {
+			op = "$gt"
+		}
+		key := searchSortKeys[req.SortBy]
+		conds = append(conds, bson.M{"$or": bson.A{
+			bson.M{key: bson.M{op: c.Value}},
+			bson.M{key: c.Value, "_id": bson.M{op: id}},
+		}})
+	}
+
+	if len(conds) == 0 {
+		return bson.M{}, nil
+	}
+	return bson.M{"$and": conds}, nil
+}
+
+// searchSort 排序字段相同时按 _id 排序，保证游标翻页稳定
+func searchSort(req *order.SearchOrdersRequest) bson.D {
+	dir := -1
+	if req.Ascending {
+		dir = 1
+	}
+	return bson.D{
+		{Key: searchSortKeys[req.SortBy], Value: dir},
+		{Key: "_id", Value: dir},
+	}
+}
+
+// searchProjection 不返回订单内容时只取 _id 和排序字段；
+// 指定 fields 时只取这些字段，排序字段和 order.id 总是保留
+func searchProjection(req *order.SearchOrdersRequest) bson.M {
+	sortKey := searchSortKeys[req.SortBy]
+	if !req.IncludeOrders {
+		return bson.M{"_id": 1, sortKey: 1}
+	}
+	return fieldsProjection(req.Fields, sortKey)
+}
+
+// sortValue 取出订单的排序字段值，用于生成下一页游标
+func sortValue(o *order.Order, sortBy order.SearchSortField) int64 {
+	if sortBy == order.SearchSortField_UPDATED_AT {
+		return o.UpdatedAt
+	}
+	return o.CreatedAt
+}
@@ -0,0 +1,309 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/base"
+	order "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/snowflake"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/status"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+var errShipmentNotFound = errors.New("shipment not found")
+
+func invalidShipment(format string, args ...any) error {
+	return &refundError{code: base.Code_INVALID_PARAM, msg: fmt.Sprintf(format, args...)}
+}
+
+// shipmentBaseResp 在 refundBaseResp 的基础上处理发货单不存在和状态机拒绝
+func shipmentBaseResp(err error) *base.BaseResponse {
+	var transitionErr *transitionError
+	switch {
+	case errors.Is(err, errShipmentNotFound):
+		return &base.BaseResponse{Code: base.Code_NOT_FOUND, Msg: "发货单不存在"}
+	case errors.As(err, &transitionErr):
+		return &base.BaseResponse{Code: base.Code_INVALID_STATUS_TRANSITION, Msg: transitionErr.Error()}
+	default:
+		return refundBaseResp(err)
+	}
+}
+
+func findShipment(o *order.Order, shipmentId int64) (int, *order.Shipment, error) {
+	for i, s := range o.Shipments {
+		if s.Id == shipmentId {
+			return i, s, nil
+		}
+	}
+	return 0, nil, errShipmentNotFound
+}
+
+// unshippedCounts 每个订单项还需要发出的数量：购买数量减去已发出和有效退款单中的数量
+func unshippedCounts(o *order.Order) map[int64]int64 {
+	left := make(map[int64]int64, len(o.Items))
+	for _, it := range o.Items {
+		left[it.Id] = it.Count
+	}
+	for _, s := range o.Shipments {
+		for _, it := range s.Items {
+			left[it.ItemId] -= it.Count
+		}
+	}
+	for _, r := range o.Refunds {
+		if !activeRefund(r) {
+			continue
+		}
+		for _, it := range r.Items {
+			left[it.ItemId] -= it.Count
+		}
+	}
+	for id, n := range left {
+		if n < 0 {
+			left[id] = 0
+		}
+	}
+	return left
+}
+
+// fullyShipped 订单的所有商品都已发出（或已退款无需发出）
+func fullyShipped(o *order.Order) bool {
+	for _, n := range unshippedCounts(o) {
+		if n > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// shippable 订单可以发货：状态机允许从当前状态迁移到已完成，
+// 即普通、秒杀订单已支付，团购订单已成团
+func shippable(o *order.Order) bool {
+	machine, ok := status.Lookup(o.Type)
+	return ok && machine.CanTransit(o.Status, status.Completed)
+}
+
+func validateCreateShipmentReq(req *order.CreateShipmentRequest) error {
+	if req.OrderId == "" {
+		return errors.New("订单ID不能为空")
+	}
+	if req.RespUserId <= 0 {
+		return errors.New("发货人ID必须大于 0.")
+	}
+	if req.Carrier == "" || req.TrackingNo == "" {
+		return errors.New("承运商和运单号不能为空.")
+	}
+	if len(req.Items) == 0 {
+		return errors.New("发货订单项不能为空.")
+	}
+	seen := make(map[int64]bool, len(req.Items))
+	for i, item := range req.Items {
+		if item == nil {
+			return fmt.Errorf("第 %d 个发货订单项为空.", i+1)
+		}
+		if item.Count <= 0 {
+			return fmt.Errorf("第 %d 个发货订单项的数量必须大于 0.", i+1)
+		}
+		if seen[item.ItemId] {
+			return fmt.Errorf("订单项 %d 重复出现.", item.ItemId)
+		}
+		seen[item.ItemId] = true
+	}
+	return nil
+}
+
+func validateAddTrackingEventReq(req *order.AddTrackingEventRequest) error {
+	if req.OrderId == "" {
+		return errors.New("订单ID不能为空")
+	}
+	if req.ShipmentId <= 0 {
+		return errors.New("发货单ID必须大于 0.")
+	}
+	if req.RespUserId <= 0 {
+		return errors.New("录入人ID必须大于 0.")
+	}
+	if req.Description == "" {
+		return errors.New("轨迹描述不能为空.")
+	}
+	if req.OccurredAt != nil && *req.OccurredAt <= 0 {
+		return errors.New("轨迹发生时间必须大于 0.")
+	}
+	return nil
+}
+
+func validateConfirmDeliveryReq(req *order.ConfirmDeliveryRequest) error {
+	if req.OrderId == "" {
+		return errors.New("订单ID不能为空")
+	}
+	if req.ReqUserId <= 0 {
+		return errors.New("确认人ID必须大于 0.")
+	}
+	return nil
+}
+
+// planShipment 按订单当前内容校验发货请求并生成发货单
+func planShipment(o *order.Order, req *order.CreateShipmentRequest, now int64) (*order.Shipment, error) {
+	if o.RespUserId != req.RespUserId {
+		return nil, invalidShipment("只有商户可以发货.")
+	}
+	if !shippable(o) {
+		return nil, &refundError{
+			code: base.Code_INVALID_STATUS_TRANSITION,
+			msg:  fmt.Sprintf("订单状态为 %d，只有已支付（团购订单为已成团）的订单可以发货.", o.Status),
+		}
+	}
+
+	items := make(map[int64]*order.OrderItem, len(o.Items))
+	for _, it := range o.Items {
+		items[it.Id] = it
+	}
+	left := unshippedCounts(o)
+
+	shipmentId, err := snowflake.Generate()
+	if err != nil {
+		return nil, err
+	}
+	shipment := &order.Shipment{
+		Id:         shipmentId,
+		Carrier:    req.Carrier,
+		TrackingNo: req.TrackingNo,
+		Status:     order.ShipmentStatus_SHIPPED,
+		Events:     []*order.TrackingEvent{},
+		ShippedAt:  now,
+	}
+	for _, it := range req.Items {
+		item, ok := items[it.ItemId]
+		if !ok {
+			return nil, invalidShipment("订单项 %d 不属于该订单.", it.ItemId)
+		}
+		if it.Count > left[it.ItemId] {
+			return nil, invalidShipment("订单项 %d 还需发出 %d 件，本次发出 %d 件.", it.ItemId, left[it.ItemId], it.Count)
+		}
+		shipment.Items = append(shipment.Items, &order.ShipmentItem{
+			ItemId:    item.Id,
+			ProductId: item.ProductId,
+			SkuId:     item.SkuId,
+			Count:     it.Count,
+		})
+	}
+	return shipment, nil
+}
+
+// createShipment 追加一张发货单；发完最后一件时设置自动确认收货时间，autoConfirmAfter 为 0 表示不自动确认
+func createShipment(ctx context.Context, objectId primitive.ObjectID, req *order.CreateShipmentRequest, autoConfirmAfter time.Duration) (*order.Shipment, error) {
+	var result *order.Shipment
+	err := mutateOrder(ctx, objectId, func(o *order.Order) (bson.D, error) {
+		now := time.Now().Unix()
+		shipment, err := planShipment(o, req, now)
+		if err != nil {
+			return nil, err
+		}
+		result = shipment
+
+		o.Shipments = append(o.Shipments, shipment)
+		set := bson.D{
+			bson.E{Key: "order.shipments", Value: o.Shipments},
+		}
+		if autoConfirmAfter > 0 && fullyShipped(o) {
+			set = append(set, bson.E{Key: "order.autoconfirmat", Value: now + int64(autoConfirmAfter/time.Second)})
+		}
+		return set, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// addTrackingEvent 给发货单追加一条物流轨迹，delivered 时发货单同时变为已签收
+func addTrackingEvent(ctx context.Context, objectId primitive.ObjectID, req *order.AddTrackingEventRequest) (*order.Shipment, error) {
+	var result *order.Shipment
+	err := mutateOrder(ctx, objectId, func(o *order.Order) (bson.D, error) {
+		if o.RespUserId != req.RespUserId {
+			return nil, invalidShipment("只有商户可以录入物流轨迹.")
+		}
+		idx, shipment, err := findShipment(o, req.ShipmentId)
+		if err != nil {
+			return nil, err
+		}
+
+		now := time.Now().Unix()
+		occurredAt := now
+		if req.OccurredAt != nil {
+			occurredAt = *req.OccurredAt
+		}
+		updated := *shipment
+		updated.Events = append(append([]*order.TrackingEvent{}, shipment.Events...), &order.TrackingEvent{
+			OccurredAt:  occurredAt,
+			Description: req.Description,
+			Location:    req.GetLocation(),
+			CreatedAt:   now,
+		})
+		if req.GetDelivered() && updated.Status != order.ShipmentStatus_DELIVERED {
+			updated.Status = order.ShipmentStatus_DELIVERED
+			updated.DeliveredAt = occurredAt
+		}
+		result = &updated
+		return bson.D{
+			bson.E{Key: fmt.Sprintf("order.shipments.%d", idx), Value: &updated},
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// completeDelivery 把订单迁移到已完成，尚未签收的发货单在同一次写入中标记为已签收。
+// check 按读到的订单校验是否允许确认；订单已完成时直接返回，调用方可以安全重试。
+func completeDelivery(ctx context.Context, objectId primitive.ObjectID, check func(o *order.Order) error) error {
+	for attempt := 1; ; attempt++ {
+		o, err := loadOrder(ctx, objectId)
+		if err != nil {
+			return err
+		}
+		if err := check(o); err != nil {
+			return err
+		}
+		if o.Status == status.Completed {
+			return nil
+		}
+		if len(o.Shipments) == 0 || !fullyShipped(o) {
+			return invalidShipment("订单还有商品未发出，不能确认收货.")
+		}
+
+		now := time.Now().Unix()
+		shipments := make([]*order.Shipment, 0, len(o.Shipments))
+		for _, s := range o.Shipments {
+			if s.Status != order.ShipmentStatus_DELIVERED {
+				delivered := *s
+				delivered.Status = order.ShipmentStatus_DELIVERED
+				delivered.DeliveredAt = now
+				s = &delivered
+			}
+			shipments = append(shipments, s)
+		}
+		set := bson.D{
+			bson.E{Key: "order.shipments", Value: shipments},
+		}
+		err = transitStatus(ctx, objectId, status.Completed, set, nil, &o.Version)
+		if errors.Is(err, errVersionConflict) && attempt < maxRefundAttempts {
+			continue
+		}
+		return err
+	}
+}
+
+// confirmDelivery 买家确认收货
+func confirmDelivery(ctx context.Context, objectId primitive.ObjectID, req *order.ConfirmDeliveryRequest) error {
+	ctx = withEventAttrs(ctx, map[string]any{"reason": "confirm_delivery"})
+	return completeDelivery(ctx, objectId, func(o *order.Order) error {
+		if o.ReqUserId != req.ReqUserId {
+			return invalidShipment("只有下单用户可以确认收货.")
+		}
+		return nil
+	})
+}
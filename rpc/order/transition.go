@@ -0,0 +1,197 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	order "github.com/youperceive/cloudwego_instance/rpc/order/kitex_gen/order"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/event"
+	"github.com/youperceive/cloudwego_instance/rpc/order/pkg/status"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	mongoOfficial "go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+var (
+	errOrderNotFound   = errors.New("order not found")
+	errVersionConflict = errors.New("order version conflict")
+)
+
+// transitionError 表示状态机拒绝了本次流转，Error() 可以直接返回给调用方
+type transitionError struct {
+	msg string
+}
+
+func (e *transitionError) Error() string {
+	return e.msg
+}
+
+// versionFilter 兼容引入版本号之前写入的文档：没有 order.version 字段视为版本 0
+func versionFilter(version int64) any {
+	if version == 0 {
+		return bson.M{"$in": bson.A{0, nil}}
+	}
+	return version
+}
+
+// updateFields 更新订单的普通字段（set/unset）并递增版本号。
+// expectedVersion 不为 nil 时作为过滤条件，版本不一致返回 errVersionConflict。
+// 更新和 OrderUpdated 事件在同一事务中写入。
+func updateFields(ctx context.Context, objectId primitive.ObjectID, set, unset bson.D, expectedVersion *int64) error {
+	filter := bson.D{
+		bson.E{Key: "_id", Value: objectId},
+	}
+	if expectedVersion != nil {
+		filter = append(filter, bson.E{Key: "order.version", Value: versionFilter(*expectedVersion)})
+	}
+
+	val := append(
+		bson.D{
+			bson.E{Key: "order.updatedat", Value: time.Now().Unix()},
+		},
+		set...,
+	)
+	data := bson.D{
+		bson.E{Key: "$set", Value: val},
+		bson.E{Key: "$inc", Value: bson.D{bson.E{Key: "order.version", Value: 1}}},
+	}
+	if len(unset) > 0 {
+		data = append(data, bson.E{Key: "$unset", Value: unset})
+	}
+
+	matched, err := updateWithEvent(ctx, objectId, filter, data, func() (string, map[string]any) {
+		return event.OrderUpdated, map[string]any{"fields": changedFields(set, unset)}
+	})
+	if err != nil || matched {
+		return err
+	}
+	if expectedVersion == nil {
+		return errOrderNotFound
+	}
+
+	// 带版本号过滤没有命中时，区分订单不存在和版本冲突
+	count, err := Coll.CountDocuments(ctx, bson.M{"_id": objectId})
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return errOrderNotFound
+	}
+	return errVersionConflict
+}
+
+// updateWithEvent 在事务中按 filter 更新订单，命中时把 newEvent 生成的事件写入发件箱，
+// 事件的 seq 为更新后的版本号。没有命中时返回 false。
+func updateWithEvent(ctx context.Context, objectId primitive.ObjectID, filter, update bson.D,
+	newEvent func() (string, map[string]any)) (bool, error) {
+	var matched bool
+	err := withTx(ctx, func(ctx context.Context) error {
+		var after struct {
+			Order struct {
+				Version int64 `bson:"version"`
+			} `bson:"order"`
+		}
+		opts := options.FindOneAndUpdate().
+			SetReturnDocument(options.After).
+			SetProjection(bson.M{"order.version": 1})
+		err := Coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&after)
+		if errors.Is(err, mongoOfficial.ErrNoDocuments) {
+			matched = false
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		matched = true
+		typ, payload := newEvent()
+		return appendEvent(ctx, typ, objectId.Hex(), after.Order.Version, payload)
+	})
+	return matched, err
+}
+
+// transitStatus 按订单类型的状态机把订单迁移到 to 状态。
+// 写入时以读到的状态和版本号作为过滤条件（compare-and-set），期间被并发修改时：
+// 调用方传了 expectedVersion 返回 errVersionConflict，否则返回 transitionError。
+// 成功时把本次流转追加到 order.statushistory，set/unset 中的字段与状态在同一次写入中更新，
+// 并在同一事务中写入 OrderStatusChanged 事件，ctx 中 withEventAttrs 附加的字段一并写入事件。
+func transitStatus(ctx context.Context, objectId primitive.ObjectID, to int32, set, unset bson.D, expectedVersion *int64) error {
+	var current struct {
+		Order struct {
+			Type    int32 `bson:"type"`
+			Status  int32 `bson:"status"`
+			Version int64 `bson:"version"`
+		} `bson:"order"`
+	}
+	findOpts := options.FindOne().SetProjection(bson.M{"order.type": 1, "order.status": 1, "order.version": 1})
+	err := Coll.FindOne(ctx, bson.M{"_id": objectId}, findOpts).Decode(&current)
+	if err != nil {
+		if errors.Is(err, mongoOfficial.ErrNoDocuments) {
+			return errOrderNotFound
+		}
+		return err
+	}
+	if expectedVersion != nil && *expectedVersion != current.Order.Version {
+		return errVersionConflict
+	}
+
+	from := current.Order.Status
+	machine, ok := status.Lookup(current.Order.Type)
+	if !ok {
+		return &transitionError{msg: fmt.Sprintf("订单类型 %d 未声明状态机.", current.Order.Type)}
+	}
+	if !machine.CanTransit(from, to) {
+		return &transitionError{msg: fmt.Sprintf("订单状态不允许从 %d 变更为 %d.", from, to)}
+	}
+
+	now := time.Now().Unix()
+	val := bson.D{
+		bson.E{Key: "order.status", Value: to},
+		bson.E{Key: "order.updatedat", Value: now},
+	}
+	val = append(val, set...)
+	data := bson.D{
+		bson.E{Key: "$set", Value: val},
+		bson.E{Key: "$inc", Value: bson.D{bson.E{Key: "order.version", Value: 1}}},
+		bson.E{
+			Key: "$push",
+			Value: bson.D{
+				bson.E{
+					Key:   "order.statushistory",
+					Value: order.StatusRecord{From: from, To: to, ChangedAt: now},
+				},
+			},
+		},
+	}
+	if len(unset) > 0 {
+		data = append(data, bson.E{Key: "$unset", Value: unset})
+	}
+	filter := bson.D{
+		bson.E{Key: "_id", Value: objectId},
+		bson.E{Key: "order.status", Value: from},
+		bson.E{Key: "order.version", Value: versionFilter(current.Order.Version)},
+	}
+
+	matched, err := updateWithEvent(ctx, objectId, filter, data, func() (string, map[string]any) {
+		payload := map[string]any{"from": from, "to": to}
+		if fields := changedFields(set, unset); len(fields) > 0 {
+			payload["fields"] = fields
+		}
+		for k, v := range eventAttrs(ctx) {
+			payload[k] = v
+		}
+		return event.OrderStatusChanged, payload
+	})
+	if err != nil {
+		return err
+	}
+	if !matched {
+		if expectedVersion != nil {
+			return errVersionConflict
+		}
+		return &transitionError{msg: "订单状态已被并发修改，请重新查询后再试."}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	base "github.com/youperceive/cloudwego_instance/rpc/user_account/kitex_gen/base"
+	user_account "github.com/youperceive/cloudwego_instance/rpc/user_account/kitex_gen/user_account"
+	"github.com/youperceive/cloudwego_instance/rpc/user_account/pkg/dao"
+)
+
+// addressFieldLimits 地址各字段的最大字符数，与 user_address 表的列宽一致
+var addressFieldLimits = []struct {
+	name  string
+	limit int
+}{
+	{"receiver_name", 64},
+	{"phone", 20},
+	{"province", 32},
+	{"city", 32},
+	{"district", 32},
+	{"detail", 255},
+}
+
+// validateAddressFields 校验传入的地址字段，值为 nil 表示未传
+func validateAddressFields(fields map[string]*string) []string {
+	var msg []string
+	for _, f := range addressFieldLimits {
+		v := fields[f.name]
+		if v == nil {
+			continue
+		}
+		if strings.TrimSpace(*v) == "" {
+			msg = append(msg, f.name+" can't be empty.")
+		} else if utf8.RuneCountInString(*v) > f.limit {
+			msg = append(msg, fmt.Sprintf("%s is longer than %d characters.", f.name, f.limit))
+		}
+	}
+	return msg
+}
+
+func validateCreateAddressReq(req *user_account.CreateAddressRequest) error {
+	var msg []string
+	if req.UserId <= 0 {
+		msg = append(msg, "user_id is invalid.")
+	}
+	msg = append(msg, validateAddressFields(map[string]*string{
+		"receiver_name": &req.ReceiverName,
+		"phone":         &req.Phone,
+		"province":      &req.Province,
+		"city":          &req.City,
+		"district":      &req.District,
+		"detail":        &req.Detail,
+	})...)
+	if len(msg) > 0 {
+		return fmt.Errorf("%s", strings.Join(msg, " "))
+	}
+	return nil
+}
+
+func validateUpdateAddressReq(req *user_account.UpdateAddressRequest) error {
+	var msg []string
+	if req.Id <= 0 || req.UserId <= 0 {
+		msg = append(msg, "id or user_id is invalid.")
+	}
+	if req.IsDefault != nil && !*req.IsDefault {
+		msg = append(msg, "is_default can only be set to true.")
+	}
+	msg = append(msg, validateAddressFields(map[string]*string{
+		"receiver_name": req.ReceiverName,
+		"phone":         req.Phone,
+		"province":      req.Province,
+		"city":          req.City,
+		"district":      req.District,
+		"detail":        req.Detail,
+	})...)
+	if len(msg) > 0 {
+		return fmt.Errorf("%s", strings.Join(msg, " "))
+	}
+	return nil
+}
+
+func validateAddressKey(id, userID int64) error {
+	if id <= 0 || userID <= 0 {
+		return errors.New("id or user_id is invalid.")
+	}
+	return nil
+}
+
+// addressUpdates 把请求中传入的字段转换为列名到新值
+func addressUpdates(req *user_account.UpdateAddressRequest) map[string]any {
+	info := make(map[string]any)
+	for col, v := range map[string]*string{
+		"receiver_name": req.ReceiverName,
+		"phone":         req.Phone,
+		"province":      req.Province,
+		"city":          req.City,
+		"district":      req.District,
+		"detail":        req.Detail,
+	} {
+		if v != nil {
+			info[col] = *v
+		}
+	}
+	return info
+}
+
+func toAddress(a *dao.Address) *user_account.Address {
+	return &user_account.Address{
+		Id:           a.ID,
+		UserId:       a.UserID,
+		ReceiverName: a.ReceiverName,
+		Phone:        a.Phone,
+		Province:     a.Province,
+		City:         a.City,
+		District:     a.District,
+		Detail:       a.Detail,
+		IsDefault:    a.IsDefault,
+		CreatedAt:    a.CreatedAt,
+		UpdatedAt:    a.UpdatedAt,
+	}
+}
+
+// addressBaseResp 把地址读写的错误转换为响应码，err 为 nil 时返回成功
+func addressBaseResp(err error) *base.BaseResponse {
+	switch {
+	case err == nil:
+		return &base.BaseResponse{Code: base.Code_SUCCESS, Msg: successMsg}
+	case errors.Is(err, dao.ErrAddressNotFound):
+		return &base.BaseResponse{Code: base.Code_NOT_FOUND, Msg: "address not found."}
+	case errors.Is(err, dao.ErrUserNotFound):
+		return &base.BaseResponse{Code: base.Code_NOT_FOUND, Msg: "user not existed."}
+	case errors.Is(err, dao.ErrAddressLimit):
+		return &base.BaseResponse{
+			Code: base.Code_INVALID_PARAM,
+			Msg:  fmt.Sprintf("at most %d addresses per user.", dao.MaxAddressesPerUser),
+		}
+	default:
+		return &base.BaseResponse{Code: base.Code_DB_ERR, Msg: internalErrMsg}
+	}
+}
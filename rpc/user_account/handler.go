@@ -19,6 +19,8 @@ import (
 // UserAccountServiceImpl implements the last service interface defined in the IDL.
 type UserAccountServiceImpl struct {
 	VerifyCodeClient verifycodeservice.Client
+	Repo             dao.Repository
+	TokenIssuer      *token.Issuer
 }
 
 var (
@@ -124,7 +126,7 @@ func (s *UserAccountServiceImpl) Register(ctx context.Context, req *user_account
 	daoUser.UserType = req.UserType
 	daoUser.RegisterType = int8(req.TargetType)
 
-	userID, err := dao.CreateUser(daoUser)
+	userID, err := s.Repo.CreateUser(daoUser)
 	if err != nil {
 		klogErr("fail to create user." + err.Error())
 		resp = &user_account.RegisterResponse{
@@ -185,7 +187,7 @@ func (s *UserAccountServiceImpl) Login(ctx context.Context, req *user_account.Lo
 		return
 	}
 
-	user, err := dao.QueryUser(req.Target, req.TargetType)
+	user, err := s.Repo.QueryUser(req.Target, req.TargetType)
 	if err != nil {
 		klogErr("fail to query user_id." + err.Error())
 		resp = &user_account.LoginResponse{
@@ -221,7 +223,7 @@ func (s *UserAccountServiceImpl) Login(ctx context.Context, req *user_account.Lo
 		return
 	}
 
-	accessToken, err := token.GenerateToken(user.ID, user.UserType)
+	accessToken, err := s.TokenIssuer.GenerateToken(user.ID, user.UserType)
 	if err != nil {
 		klogErr("fail to generate token." + err.Error())
 		resp = &user_account.LoginResponse{
@@ -234,6 +236,11 @@ func (s *UserAccountServiceImpl) Login(ctx context.Context, req *user_account.Lo
 		return
 	}
 
+	if err := s.Repo.RecordLogin(user.ID); err != nil {
+		// 登录事件仅用于下游分析，写入失败不影响登录
+		klogErr("fail to record login event." + err.Error())
+	}
+
 	resp = &user_account.LoginResponse{
 		BaseResp: &base.BaseResponse{
 			Code: base.Code_SUCCESS,
@@ -245,14 +252,14 @@ func (s *UserAccountServiceImpl) Login(ctx context.Context, req *user_account.Lo
 	return
 }
 
-func validateUpdateReq(req *user_account.UpdateRequest) error {
+func validateUpdateReq(repo dao.Repository, req *user_account.UpdateRequest) error {
 	var errMsgs []string
 	if req.Id == nil {
 		errMsgs = append(errMsgs, "user_id is nil.")
 		return fmt.Errorf("%s", strings.Join(errMsgs, ". "))
 	}
 
-	user, err := dao.QueryUserById(*req.Id)
+	user, err := repo.QueryUserById(*req.Id)
 	if err != nil {
 		klog.Error("method: ", "Update", "msg:", "can't acquire register_type by sql. "+err.Error())
 		errMsgs = append(errMsgs, internalErrMsg)
@@ -300,7 +307,7 @@ func (s *UserAccountServiceImpl) Update(ctx context.Context, req *user_account.U
 		)
 	}
 
-	err = validateUpdateReq(req)
+	err = validateUpdateReq(s.Repo, req)
 	if err != nil {
 		klogErr("fail to validate params. " + err.Error())
 		resp = &user_account.UpdateResponse{
@@ -344,9 +351,9 @@ func (s *UserAccountServiceImpl) Update(ctx context.Context, req *user_account.U
 		info["status"] = *req.Status
 	}
 
-	err = dao.UpdateUser(*req.Id, info)
+	err = s.Repo.UpdateUser(*req.Id, info)
 	if err != nil {
-		klogErr("fail to call dao.UpdateUser. " + err.Error())
+		klogErr("fail to call Repo.UpdateUser. " + err.Error())
 		resp = &user_account.UpdateResponse{
 			BaseResp: &base.BaseResponse{
 				Code: base.Code_DB_ERR,
@@ -365,3 +372,172 @@ func (s *UserAccountServiceImpl) Update(ctx context.Context, req *user_account.U
 
 	return
 }
+
+// CreateAddress implements the UserAccountServiceImpl interface.
+func (s *UserAccountServiceImpl) CreateAddress(ctx context.Context, req *user_account.CreateAddressRequest) (resp *user_account.CreateAddressResponse, err error) {
+	klogErr := func(msg string) {
+		klog.Error(
+			"method", "CreateAddress",
+			"message", msg,
+			"target", req.String(),
+		)
+	}
+
+	if err = validateCreateAddressReq(req); err != nil {
+		klogErr("fail to validate req params. " + err.Error())
+		resp = &user_account.CreateAddressResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  err.Error(),
+			},
+		}
+		return resp, nil
+	}
+
+	addr := &dao.Address{
+		UserID:       req.UserId,
+		ReceiverName: req.ReceiverName,
+		Phone:        req.Phone,
+		Province:     req.Province,
+		City:         req.City,
+		District:     req.District,
+		Detail:       req.Detail,
+		IsDefault:    req.GetIsDefault(),
+	}
+	addressID, err := s.Repo.CreateAddress(addr)
+	if err != nil {
+		klogErr("fail to call Repo.CreateAddress. " + err.Error())
+		return &user_account.CreateAddressResponse{BaseResp: addressBaseResp(err)}, nil
+	}
+
+	return &user_account.CreateAddressResponse{
+		BaseResp:  addressBaseResp(nil),
+		AddressId: addressID,
+	}, nil
+}
+
+// UpdateAddress implements the UserAccountServiceImpl interface.
+func (s *UserAccountServiceImpl) UpdateAddress(ctx context.Context, req *user_account.UpdateAddressRequest) (resp *user_account.UpdateAddressResponse, err error) {
+	klogErr := func(msg string) {
+		klog.Error(
+			"method", "UpdateAddress",
+			"message", msg,
+			"target", req.String(),
+		)
+	}
+
+	if err = validateUpdateAddressReq(req); err != nil {
+		klogErr("fail to validate req params. " + err.Error())
+		resp = &user_account.UpdateAddressResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  err.Error(),
+			},
+		}
+		return resp, nil
+	}
+
+	err = s.Repo.UpdateAddress(req.UserId, req.Id, addressUpdates(req), req.GetIsDefault())
+	if err != nil {
+		klogErr("fail to call Repo.UpdateAddress. " + err.Error())
+	}
+	return &user_account.UpdateAddressResponse{BaseResp: addressBaseResp(err)}, nil
+}
+
+// DeleteAddress implements the UserAccountServiceImpl interface.
+func (s *UserAccountServiceImpl) DeleteAddress(ctx context.Context, req *user_account.DeleteAddressRequest) (resp *user_account.DeleteAddressResponse, err error) {
+	klogErr := func(msg string) {
+		klog.Error(
+			"method", "DeleteAddress",
+			"message", msg,
+			"target", req.String(),
+		)
+	}
+
+	if err = validateAddressKey(req.Id, req.UserId); err != nil {
+		klogErr("fail to validate req params. " + err.Error())
+		resp = &user_account.DeleteAddressResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  err.Error(),
+			},
+		}
+		return resp, nil
+	}
+
+	err = s.Repo.DeleteAddress(req.UserId, req.Id)
+	if err != nil {
+		klogErr("fail to call Repo.DeleteAddress. " + err.Error())
+	}
+	return &user_account.DeleteAddressResponse{BaseResp: addressBaseResp(err)}, nil
+}
+
+// GetAddress implements the UserAccountServiceImpl interface.
+func (s *UserAccountServiceImpl) GetAddress(ctx context.Context, req *user_account.GetAddressRequest) (resp *user_account.GetAddressResponse, err error) {
+	klogErr := func(msg string) {
+		klog.Error(
+			"method", "GetAddress",
+			"message", msg,
+			"target", req.String(),
+		)
+	}
+
+	if err = validateAddressKey(req.Id, req.UserId); err != nil {
+		klogErr("fail to validate req params. " + err.Error())
+		resp = &user_account.GetAddressResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  err.Error(),
+			},
+		}
+		return resp, nil
+	}
+
+	addr, err := s.Repo.GetAddress(req.UserId, req.Id)
+	if err != nil {
+		klogErr("fail to call Repo.GetAddress. " + err.Error())
+		return &user_account.GetAddressResponse{BaseResp: addressBaseResp(err)}, nil
+	}
+
+	return &user_account.GetAddressResponse{
+		BaseResp: addressBaseResp(nil),
+		Address:  toAddress(addr),
+	}, nil
+}
+
+// ListAddresses implements the UserAccountServiceImpl interface.
+func (s *UserAccountServiceImpl) ListAddresses(ctx context.Context, req *user_account.ListAddressesRequest) (resp *user_account.ListAddressesResponse, err error) {
+	klogErr := func(msg string) {
+		klog.Error(
+			"method", "ListAddresses",
+			"message", msg,
+			"target", req.UserId,
+		)
+	}
+
+	if req.UserId <= 0 {
+		klogErr("user_id is invalid.")
+		resp = &user_account.ListAddressesResponse{
+			BaseResp: &base.BaseResponse{
+				Code: base.Code_INVALID_PARAM,
+				Msg:  "user_id is invalid.",
+			},
+		}
+		return resp, nil
+	}
+
+	addrs, err := s.Repo.ListAddresses(req.UserId)
+	if err != nil {
+		klogErr("fail to call Repo.ListAddresses. " + err.Error())
+		return &user_account.ListAddressesResponse{BaseResp: addressBaseResp(err)}, nil
+	}
+
+	addresses := make([]*user_account.Address, 0, len(addrs))
+	for _, a := range addrs {
+		addresses = append(addresses, toAddress(a))
+	}
+	return &user_account.ListAddressesResponse{
+		BaseResp:  addressBaseResp(nil),
+		Addresses: addresses,
+	}, nil
+}
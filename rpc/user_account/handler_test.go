@@ -0,0 +1,321 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cloudwego/kitex/client/callopt"
+	base "github.com/youperceive/cloudwego_instance/rpc/user_account/kitex_gen/base"
+	user_account "github.com/youperceive/cloudwego_instance/rpc/user_account/kitex_gen/user_account"
+	"github.com/youperceive/cloudwego_instance/rpc/user_account/pkg/dao"
+	"github.com/youperceive/cloudwego_instance/rpc/user_account/pkg/hash"
+	"github.com/youperceive/cloudwego_instance/rpc/user_account/pkg/token"
+	"github.com/youperceive/cloudwego_instance/rpc/verify_code/kitex_gen/verify_code"
+	"github.com/youperceive/cloudwego_instance/rpc/verify_code/kitex_gen/verify_code/verifycodeservice"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// fakeVerifyCode 只实现 ValidateCaptcha，其余方法调用时直接 panic
+type fakeVerifyCode struct {
+	verifycodeservice.Client
+	valid bool
+	err   error
+}
+
+func (f *fakeVerifyCode) ValidateCaptcha(ctx context.Context, req *verify_code.ValidateCaptchaRequest, callOptions ...callopt.Option) (*verify_code.ValidateCaptchaResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &verify_code.ValidateCaptchaResponse{Valid: f.valid}, nil
+}
+
+func newTestService(t *testing.T, verify *fakeVerifyCode) (*UserAccountServiceImpl, sqlmock.Sqlmock) {
+	t.Helper()
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New: %v", err)
+	}
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	db, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatalf("gorm.Open: %v", err)
+	}
+
+	return &UserAccountServiceImpl{
+		VerifyCodeClient: verify,
+		Repo:             dao.NewRepository(db),
+		TokenIssuer:      token.NewIssuer(token.Config{SecretKey: "0123456789abcdef0123456789abcdef"}),
+	}, mock
+}
+
+func expectMockDone(t *testing.T, mock sqlmock.Sqlmock) {
+	t.Helper()
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet sql expectations: %v", err)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	ctx := context.Background()
+	req := func() *user_account.RegisterRequest {
+		return &user_account.RegisterRequest{
+			TargetType: base.TargetType_Email,
+			Target:     "a@example.com",
+			Captcha:    "123456",
+			Password:   "secret",
+			UserType:   1,
+		}
+	}
+
+	t.Run("success writes user and registered event in one tx", func(t *testing.T) {
+		s, mock := newTestService(t, &fakeVerifyCode{valid: true})
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `user`").WillReturnResult(sqlmock.NewResult(42, 1))
+		mock.ExpectExec("INSERT INTO `user_event_outbox`").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		resp, err := s.Register(ctx, req())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.BaseResp.Code != base.Code_SUCCESS || resp.UserId == nil || *resp.UserId != 42 {
+			t.Fatalf("unexpected resp: %+v", resp)
+		}
+		expectMockDone(t, mock)
+	})
+
+	t.Run("invalid params", func(t *testing.T) {
+		s, mock := newTestService(t, &fakeVerifyCode{valid: true})
+		r := req()
+		r.Password = ""
+
+		resp, _ := s.Register(ctx, r)
+		if resp.BaseResp.Code != base.Code_INVALID_PARAM {
+			t.Fatalf("code = %v, want INVALID_PARAM", resp.BaseResp.Code)
+		}
+		expectMockDone(t, mock)
+	})
+
+	t.Run("invalid captcha", func(t *testing.T) {
+		s, mock := newTestService(t, &fakeVerifyCode{valid: false})
+
+		resp, _ := s.Register(ctx, req())
+		if resp.BaseResp.Code != base.Code_INVALID_PARAM {
+			t.Fatalf("code = %v, want INVALID_PARAM", resp.BaseResp.Code)
+		}
+		expectMockDone(t, mock)
+	})
+
+	t.Run("verify code service down", func(t *testing.T) {
+		s, mock := newTestService(t, &fakeVerifyCode{err: errors.New("unavailable")})
+
+		resp, _ := s.Register(ctx, req())
+		if resp.BaseResp.Code != base.Code_SERVICE_ERR {
+			t.Fatalf("code = %v, want SERVICE_ERR", resp.BaseResp.Code)
+		}
+		expectMockDone(t, mock)
+	})
+
+	t.Run("duplicate target rolls back", func(t *testing.T) {
+		s, mock := newTestService(t, &fakeVerifyCode{valid: true})
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `user`").WillReturnError(errors.New("Error 1062: Duplicate entry"))
+		mock.ExpectRollback()
+
+		resp, _ := s.Register(ctx, req())
+		if resp.BaseResp.Code != base.Code_DB_ERR || resp.UserId != nil {
+			t.Fatalf("unexpected resp: %+v", resp)
+		}
+		expectMockDone(t, mock)
+	})
+}
+
+func TestLogin(t *testing.T) {
+	ctx := context.Background()
+	hashed, err := hash.BCryptHash("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	userRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "email", "password", "register_type", "user_type"}).
+			AddRow(7, "a@example.com", hashed, int8(base.TargetType_Email), 1)
+	}
+	req := func(password string) *user_account.LoginRequest {
+		return &user_account.LoginRequest{
+			TargetType: base.TargetType_Email,
+			Target:     "a@example.com",
+			Password:   password,
+		}
+	}
+
+	t.Run("success issues token and records login", func(t *testing.T) {
+		s, mock := newTestService(t, &fakeVerifyCode{})
+		mock.ExpectQuery("SELECT \\* FROM `user` WHERE email = \\?").
+			WithArgs("a@example.com", 1).
+			WillReturnRows(userRows())
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `user_event_outbox`").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		resp, err := s.Login(ctx, req("secret"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.BaseResp.Code != base.Code_SUCCESS || resp.Token == "" {
+			t.Fatalf("unexpected resp: %+v", resp)
+		}
+		claims, err := s.TokenIssuer.VerifyToken(resp.Token)
+		if err != nil {
+			t.Fatalf("VerifyToken: %v", err)
+		}
+		if claims.UserID != 7 || claims.UserType != 1 {
+			t.Fatalf("unexpected claims: %+v", claims)
+		}
+		expectMockDone(t, mock)
+	})
+
+	t.Run("incorrect password", func(t *testing.T) {
+		s, mock := newTestService(t, &fakeVerifyCode{})
+		mock.ExpectQuery("SELECT \\* FROM `user` WHERE email = \\?").WillReturnRows(userRows())
+
+		resp, _ := s.Login(ctx, req("wrong"))
+		if resp.BaseResp.Code != base.Code_INVALID_PARAM || resp.Token != "" {
+			t.Fatalf("unexpected resp: %+v", resp)
+		}
+		expectMockDone(t, mock)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		s, mock := newTestService(t, &fakeVerifyCode{})
+		mock.ExpectQuery("SELECT \\* FROM `user` WHERE email = \\?").WillReturnError(gorm.ErrRecordNotFound)
+
+		resp, _ := s.Login(ctx, req("secret"))
+		if resp.BaseResp.Code == base.Code_SUCCESS || resp.Token != "" {
+			t.Fatalf("unexpected resp: %+v", resp)
+		}
+		expectMockDone(t, mock)
+	})
+
+	t.Run("login event failure does not block login", func(t *testing.T) {
+		s, mock := newTestService(t, &fakeVerifyCode{})
+		mock.ExpectQuery("SELECT \\* FROM `user` WHERE email = \\?").WillReturnRows(userRows())
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO `user_event_outbox`").WillReturnError(errors.New("disk full"))
+		mock.ExpectRollback()
+
+		resp, _ := s.Login(ctx, req("secret"))
+		if resp.BaseResp.Code != base.Code_SUCCESS || resp.Token == "" {
+			t.Fatalf("unexpected resp: %+v", resp)
+		}
+		expectMockDone(t, mock)
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	ctx := context.Background()
+	userRows := func(registerType base.TargetType) *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "register_type"}).AddRow(7, int8(registerType))
+	}
+	id := int64(7)
+
+	t.Run("success updates user and writes updated event", func(t *testing.T) {
+		s, mock := newTestService(t, &fakeVerifyCode{})
+		username := "bob"
+		mock.ExpectQuery("SELECT \\* FROM `user` WHERE `user`.`id` = \\?").
+			WithArgs(id, 1).
+			WillReturnRows(userRows(base.TargetType_Email))
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `user` SET").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO `user_event_outbox`").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		resp, err := s.Update(ctx, &user_account.UpdateRequest{Id: &id, Username: &username})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.BaseResp.Code != base.Code_SUCCESS {
+			t.Fatalf("unexpected resp: %+v", resp)
+		}
+		expectMockDone(t, mock)
+	})
+
+	t.Run("disabling writes disabled event only on transition", func(t *testing.T) {
+		for _, c := range []struct {
+			prev       int32
+			insertStmt string
+		}{
+			// 一次批量写入 user.updated 和 user.disabled 两个事件
+			{1, "INSERT INTO `user_event_outbox` .* VALUES \\(.*\\),\\(.*\\)$"},
+			// 已是禁用，只写 user.updated
+			{dao.UserStatusDisabled, "INSERT INTO `user_event_outbox` .* VALUES \\([^()]*\\)$"},
+		} {
+			s, mock := newTestService(t, &fakeVerifyCode{})
+			disabled := dao.UserStatusDisabled
+			mock.ExpectQuery("SELECT \\* FROM `user` WHERE `user`.`id` = \\?").
+				WillReturnRows(userRows(base.TargetType_Email))
+			mock.ExpectBegin()
+			mock.ExpectQuery("SELECT `status` FROM `user` WHERE `user`.`id` = \\? .*FOR UPDATE").
+				WithArgs(id, 1).
+				WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow(c.prev))
+			mock.ExpectExec("UPDATE `user` SET").WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(c.insertStmt).WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+
+			resp, err := s.Update(ctx, &user_account.UpdateRequest{Id: &id, Status: &disabled})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.BaseResp.Code != base.Code_SUCCESS {
+				t.Fatalf("prev status %d: unexpected resp: %+v", c.prev, resp)
+			}
+			expectMockDone(t, mock)
+		}
+	})
+
+	t.Run("missing id", func(t *testing.T) {
+		s, mock := newTestService(t, &fakeVerifyCode{})
+
+		resp, _ := s.Update(ctx, &user_account.UpdateRequest{})
+		if resp.BaseResp.Code != base.Code_INVALID_PARAM {
+			t.Fatalf("code = %v, want INVALID_PARAM", resp.BaseResp.Code)
+		}
+		expectMockDone(t, mock)
+	})
+
+	t.Run("cannot modify register target", func(t *testing.T) {
+		s, mock := newTestService(t, &fakeVerifyCode{})
+		email := "b@example.com"
+		mock.ExpectQuery("SELECT \\* FROM `user` WHERE `user`.`id` = \\?").
+			WillReturnRows(userRows(base.TargetType_Email))
+
+		resp, _ := s.Update(ctx, &user_account.UpdateRequest{Id: &id, Email: &email})
+		if resp.BaseResp.Code != base.Code_INVALID_PARAM {
+			t.Fatalf("code = %v, want INVALID_PARAM", resp.BaseResp.Code)
+		}
+		expectMockDone(t, mock)
+	})
+
+	t.Run("update failure rolls back event", func(t *testing.T) {
+		s, mock := newTestService(t, &fakeVerifyCode{})
+		username := "bob"
+		mock.ExpectQuery("SELECT \\* FROM `user` WHERE `user`.`id` = \\?").
+			WillReturnRows(userRows(base.TargetType_Phone))
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE `user` SET").WillReturnError(errors.New("lock wait timeout"))
+		mock.ExpectRollback()
+
+		resp, _ := s.Update(ctx, &user_account.UpdateRequest{Id: &id, Username: &username})
+		if resp.BaseResp.Code == base.Code_SUCCESS {
+			t.Fatalf("unexpected resp: %+v", resp)
+		}
+		expectMockDone(t, mock)
+	})
+}
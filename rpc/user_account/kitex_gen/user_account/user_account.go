@@ -590,12 +590,737 @@ var fieldIDToName_UpdateResponse = map[int16]string{
 	1: "baseResp",
 }
 
+type Address struct {
+	Id           int64  `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	UserId       int64  `thrift:"user_id,2" frugal:"2,default,i64" json:"user_id"`
+	ReceiverName string `thrift:"receiver_name,3" frugal:"3,default,string" json:"receiver_name"`
+	Phone        string `thrift:"phone,4" frugal:"4,default,string" json:"phone"`
+	Province     string `thrift:"province,5" frugal:"5,default,string" json:"province"`
+	City         string `thrift:"city,6" frugal:"6,default,string" json:"city"`
+	District     string `thrift:"district,7" frugal:"7,default,string" json:"district"`
+	Detail       string `thrift:"detail,8" frugal:"8,default,string" json:"detail"`
+	IsDefault    bool   `thrift:"is_default,9" frugal:"9,default,bool" json:"is_default"`
+	CreatedAt    int64  `thrift:"created_at,10" frugal:"10,default,i64" json:"created_at"`
+	UpdatedAt    int64  `thrift:"updated_at,11" frugal:"11,default,i64" json:"updated_at"`
+}
+
+func NewAddress() *Address {
+	return &Address{}
+}
+
+func (p *Address) InitDefault() {
+}
+
+func (p *Address) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *Address) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *Address) GetReceiverName() (v string) {
+	return p.ReceiverName
+}
+
+func (p *Address) GetPhone() (v string) {
+	return p.Phone
+}
+
+func (p *Address) GetProvince() (v string) {
+	return p.Province
+}
+
+func (p *Address) GetCity() (v string) {
+	return p.City
+}
+
+func (p *Address) GetDistrict() (v string) {
+	return p.District
+}
+
+func (p *Address) GetDetail() (v string) {
+	return p.Detail
+}
+
+func (p *Address) GetIsDefault() (v bool) {
+	return p.IsDefault
+}
+
+func (p *Address) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *Address) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+func (p *Address) SetId(val int64) {
+	p.Id = val
+}
+func (p *Address) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *Address) SetReceiverName(val string) {
+	p.ReceiverName = val
+}
+func (p *Address) SetPhone(val string) {
+	p.Phone = val
+}
+func (p *Address) SetProvince(val string) {
+	p.Province = val
+}
+func (p *Address) SetCity(val string) {
+	p.City = val
+}
+func (p *Address) SetDistrict(val string) {
+	p.District = val
+}
+func (p *Address) SetDetail(val string) {
+	p.Detail = val
+}
+func (p *Address) SetIsDefault(val bool) {
+	p.IsDefault = val
+}
+func (p *Address) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+func (p *Address) SetUpdatedAt(val int64) {
+	p.UpdatedAt = val
+}
+
+func (p *Address) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Address(%+v)", *p)
+}
+
+var fieldIDToName_Address = map[int16]string{
+	1:  "id",
+	2:  "user_id",
+	3:  "receiver_name",
+	4:  "phone",
+	5:  "province",
+	6:  "city",
+	7:  "district",
+	8:  "detail",
+	9:  "is_default",
+	10: "created_at",
+	11: "updated_at",
+}
+
+type CreateAddressRequest struct {
+	UserId       int64  `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	ReceiverName string `thrift:"receiver_name,2" frugal:"2,default,string" json:"receiver_name"`
+	Phone        string `thrift:"phone,3" frugal:"3,default,string" json:"phone"`
+	Province     string `thrift:"province,4" frugal:"4,default,string" json:"province"`
+	City         string `thrift:"city,5" frugal:"5,default,string" json:"city"`
+	District     string `thrift:"district,6" frugal:"6,default,string" json:"district"`
+	Detail       string `thrift:"detail,7" frugal:"7,default,string" json:"detail"`
+	IsDefault    *bool  `thrift:"is_default,8,optional" frugal:"8,optional,bool" json:"is_default,omitempty"`
+}
+
+func NewCreateAddressRequest() *CreateAddressRequest {
+	return &CreateAddressRequest{}
+}
+
+func (p *CreateAddressRequest) InitDefault() {
+}
+
+func (p *CreateAddressRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *CreateAddressRequest) GetReceiverName() (v string) {
+	return p.ReceiverName
+}
+
+func (p *CreateAddressRequest) GetPhone() (v string) {
+	return p.Phone
+}
+
+func (p *CreateAddressRequest) GetProvince() (v string) {
+	return p.Province
+}
+
+func (p *CreateAddressRequest) GetCity() (v string) {
+	return p.City
+}
+
+func (p *CreateAddressRequest) GetDistrict() (v string) {
+	return p.District
+}
+
+func (p *CreateAddressRequest) GetDetail() (v string) {
+	return p.Detail
+}
+
+var CreateAddressRequest_IsDefault_DEFAULT bool
+
+func (p *CreateAddressRequest) GetIsDefault() (v bool) {
+	if !p.IsSetIsDefault() {
+		return CreateAddressRequest_IsDefault_DEFAULT
+	}
+	return *p.IsDefault
+}
+func (p *CreateAddressRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *CreateAddressRequest) SetReceiverName(val string) {
+	p.ReceiverName = val
+}
+func (p *CreateAddressRequest) SetPhone(val string) {
+	p.Phone = val
+}
+func (p *CreateAddressRequest) SetProvince(val string) {
+	p.Province = val
+}
+func (p *CreateAddressRequest) SetCity(val string) {
+	p.City = val
+}
+func (p *CreateAddressRequest) SetDistrict(val string) {
+	p.District = val
+}
+func (p *CreateAddressRequest) SetDetail(val string) {
+	p.Detail = val
+}
+func (p *CreateAddressRequest) SetIsDefault(val *bool) {
+	p.IsDefault = val
+}
+
+func (p *CreateAddressRequest) IsSetIsDefault() bool {
+	return p.IsDefault != nil
+}
+
+func (p *CreateAddressRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateAddressRequest(%+v)", *p)
+}
+
+var fieldIDToName_CreateAddressRequest = map[int16]string{
+	1: "user_id",
+	2: "receiver_name",
+	3: "phone",
+	4: "province",
+	5: "city",
+	6: "district",
+	7: "detail",
+	8: "is_default",
+}
+
+type CreateAddressResponse struct {
+	BaseResp  *base.BaseResponse `thrift:"baseResp,1" frugal:"1,default,base.BaseResponse" json:"baseResp"`
+	AddressId int64              `thrift:"address_id,2" frugal:"2,default,i64" json:"address_id"`
+}
+
+func NewCreateAddressResponse() *CreateAddressResponse {
+	return &CreateAddressResponse{}
+}
+
+func (p *CreateAddressResponse) InitDefault() {
+}
+
+var CreateAddressResponse_BaseResp_DEFAULT *base.BaseResponse
+
+func (p *CreateAddressResponse) GetBaseResp() (v *base.BaseResponse) {
+	if !p.IsSetBaseResp() {
+		return CreateAddressResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *CreateAddressResponse) GetAddressId() (v int64) {
+	return p.AddressId
+}
+func (p *CreateAddressResponse) SetBaseResp(val *base.BaseResponse) {
+	p.BaseResp = val
+}
+func (p *CreateAddressResponse) SetAddressId(val int64) {
+	p.AddressId = val
+}
+
+func (p *CreateAddressResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CreateAddressResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateAddressResponse(%+v)", *p)
+}
+
+var fieldIDToName_CreateAddressResponse = map[int16]string{
+	1: "baseResp",
+	2: "address_id",
+}
+
+type UpdateAddressRequest struct {
+	Id           int64   `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	UserId       int64   `thrift:"user_id,2" frugal:"2,default,i64" json:"user_id"`
+	ReceiverName *string `thrift:"receiver_name,3,optional" frugal:"3,optional,string" json:"receiver_name,omitempty"`
+	Phone        *string `thrift:"phone,4,optional" frugal:"4,optional,string" json:"phone,omitempty"`
+	Province     *string `thrift:"province,5,optional" frugal:"5,optional,string" json:"province,omitempty"`
+	City         *string `thrift:"city,6,optional" frugal:"6,optional,string" json:"city,omitempty"`
+	District     *string `thrift:"district,7,optional" frugal:"7,optional,string" json:"district,omitempty"`
+	Detail       *string `thrift:"detail,8,optional" frugal:"8,optional,string" json:"detail,omitempty"`
+	IsDefault    *bool   `thrift:"is_default,9,optional" frugal:"9,optional,bool" json:"is_default,omitempty"`
+}
+
+func NewUpdateAddressRequest() *UpdateAddressRequest {
+	return &UpdateAddressRequest{}
+}
+
+func (p *UpdateAddressRequest) InitDefault() {
+}
+
+func (p *UpdateAddressRequest) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *UpdateAddressRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+var UpdateAddressRequest_ReceiverName_DEFAULT string
+
+func (p *UpdateAddressRequest) GetReceiverName() (v string) {
+	if !p.IsSetReceiverName() {
+		return UpdateAddressRequest_ReceiverName_DEFAULT
+	}
+	return *p.ReceiverName
+}
+
+var UpdateAddressRequest_Phone_DEFAULT string
+
+func (p *UpdateAddressRequest) GetPhone() (v string) {
+	if !p.IsSetPhone() {
+		return UpdateAddressRequest_Phone_DEFAULT
+	}
+	return *p.Phone
+}
+
+var UpdateAddressRequest_Province_DEFAULT string
+
+func (p *UpdateAddressRequest) GetProvince() (v string) {
+	if !p.IsSetProvince() {
+		return UpdateAddressRequest_Province_DEFAULT
+	}
+	return *p.Province
+}
+
+var UpdateAddressRequest_City_DEFAULT string
+
+func (p *UpdateAddressRequest) GetCity() (v string) {
+	if !p.IsSetCity() {
+		return UpdateAddressRequest_City_DEFAULT
+	}
+	return *p.City
+}
+
+var UpdateAddressRequest_District_DEFAULT string
+
+func (p *UpdateAddressRequest) GetDistrict() (v string) {
+	if !p.IsSetDistrict() {
+		return UpdateAddressRequest_District_DEFAULT
+	}
+	return *p.District
+}
+
+var UpdateAddressRequest_Detail_DEFAULT string
+
+func (p *UpdateAddressRequest) GetDetail() (v string) {
+	if !p.IsSetDetail() {
+		return UpdateAddressRequest_Detail_DEFAULT
+	}
+	return *p.Detail
+}
+
+var UpdateAddressRequest_IsDefault_DEFAULT bool
+
+func (p *UpdateAddressRequest) GetIsDefault() (v bool) {
+	if !p.IsSetIsDefault() {
+		return UpdateAddressRequest_IsDefault_DEFAULT
+	}
+	return *p.IsDefault
+}
+func (p *UpdateAddressRequest) SetId(val int64) {
+	p.Id = val
+}
+func (p *UpdateAddressRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *UpdateAddressRequest) SetReceiverName(val *string) {
+	p.ReceiverName = val
+}
+func (p *UpdateAddressRequest) SetPhone(val *string) {
+	p.Phone = val
+}
+func (p *UpdateAddressRequest) SetProvince(val *string) {
+	p.Province = val
+}
+func (p *UpdateAddressRequest) SetCity(val *string) {
+	p.City = val
+}
+func (p *UpdateAddressRequest) SetDistrict(val *string) {
+	p.District = val
+}
+func (p *UpdateAddressRequest) SetDetail(val *string) {
+	p.Detail = val
+}
+func (p *UpdateAddressRequest) SetIsDefault(val *bool) {
+	p.IsDefault = val
+}
+
+func (p *UpdateAddressRequest) IsSetReceiverName() bool {
+	return p.ReceiverName != nil
+}
+
+func (p *UpdateAddressRequest) IsSetPhone() bool {
+	return p.Phone != nil
+}
+
+func (p *UpdateAddressRequest) IsSetProvince() bool {
+	return p.Province != nil
+}
+
+func (p *UpdateAddressRequest) IsSetCity() bool {
+	return p.City != nil
+}
+
+func (p *UpdateAddressRequest) IsSetDistrict() bool {
+	return p.District != nil
+}
+
+func (p *UpdateAddressRequest) IsSetDetail() bool {
+	return p.Detail != nil
+}
+
+func (p *UpdateAddressRequest) IsSetIsDefault() bool {
+	return p.IsDefault != nil
+}
+
+func (p *UpdateAddressRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateAddressRequest(%+v)", *p)
+}
+
+var fieldIDToName_UpdateAddressRequest = map[int16]string{
+	1: "id",
+	2: "user_id",
+	3: "receiver_name",
+	4: "phone",
+	5: "province",
+	6: "city",
+	7: "district",
+	8: "detail",
+	9: "is_default",
+}
+
+type UpdateAddressResponse struct {
+	BaseResp *base.BaseResponse `thrift:"baseResp,1" frugal:"1,default,base.BaseResponse" json:"baseResp"`
+}
+
+func NewUpdateAddressResponse() *UpdateAddressResponse {
+	return &UpdateAddressResponse{}
+}
+
+func (p *UpdateAddressResponse) InitDefault() {
+}
+
+var UpdateAddressResponse_BaseResp_DEFAULT *base.BaseResponse
+
+func (p *UpdateAddressResponse) GetBaseResp() (v *base.BaseResponse) {
+	if !p.IsSetBaseResp() {
+		return UpdateAddressResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *UpdateAddressResponse) SetBaseResp(val *base.BaseResponse) {
+	p.BaseResp = val
+}
+
+func (p *UpdateAddressResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *UpdateAddressResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateAddressResponse(%+v)", *p)
+}
+
+var fieldIDToName_UpdateAddressResponse = map[int16]string{
+	1: "baseResp",
+}
+
+type DeleteAddressRequest struct {
+	Id     int64 `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	UserId int64 `thrift:"user_id,2" frugal:"2,default,i64" json:"user_id"`
+}
+
+func NewDeleteAddressRequest() *DeleteAddressRequest {
+	return &DeleteAddressRequest{}
+}
+
+func (p *DeleteAddressRequest) InitDefault() {
+}
+
+func (p *DeleteAddressRequest) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *DeleteAddressRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *DeleteAddressRequest) SetId(val int64) {
+	p.Id = val
+}
+func (p *DeleteAddressRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+
+func (p *DeleteAddressRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteAddressRequest(%+v)", *p)
+}
+
+var fieldIDToName_DeleteAddressRequest = map[int16]string{
+	1: "id",
+	2: "user_id",
+}
+
+type DeleteAddressResponse struct {
+	BaseResp *base.BaseResponse `thrift:"baseResp,1" frugal:"1,default,base.BaseResponse" json:"baseResp"`
+}
+
+func NewDeleteAddressResponse() *DeleteAddressResponse {
+	return &DeleteAddressResponse{}
+}
+
+func (p *DeleteAddressResponse) InitDefault() {
+}
+
+var DeleteAddressResponse_BaseResp_DEFAULT *base.BaseResponse
+
+func (p *DeleteAddressResponse) GetBaseResp() (v *base.BaseResponse) {
+	if !p.IsSetBaseResp() {
+		return DeleteAddressResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *DeleteAddressResponse) SetBaseResp(val *base.BaseResponse) {
+	p.BaseResp = val
+}
+
+func (p *DeleteAddressResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *DeleteAddressResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteAddressResponse(%+v)", *p)
+}
+
+var fieldIDToName_DeleteAddressResponse = map[int16]string{
+	1: "baseResp",
+}
+
+type GetAddressRequest struct {
+	Id     int64 `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	UserId int64 `thrift:"user_id,2" frugal:"2,default,i64" json:"user_id"`
+}
+
+func NewGetAddressRequest() *GetAddressRequest {
+	return &GetAddressRequest{}
+}
+
+func (p *GetAddressRequest) InitDefault() {
+}
+
+func (p *GetAddressRequest) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *GetAddressRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *GetAddressRequest) SetId(val int64) {
+	p.Id = val
+}
+func (p *GetAddressRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+
+func (p *GetAddressRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetAddressRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetAddressRequest = map[int16]string{
+	1: "id",
+	2: "user_id",
+}
+
+type GetAddressResponse struct {
+	BaseResp *base.BaseResponse `thrift:"baseResp,1" frugal:"1,default,base.BaseResponse" json:"baseResp"`
+	Address  *Address           `thrift:"address,2" frugal:"2,default,Address" json:"address"`
+}
+
+func NewGetAddressResponse() *GetAddressResponse {
+	return &GetAddressResponse{}
+}
+
+func (p *GetAddressResponse) InitDefault() {
+}
+
+var GetAddressResponse_BaseResp_DEFAULT *base.BaseResponse
+
+func (p *GetAddressResponse) GetBaseResp() (v *base.BaseResponse) {
+	if !p.IsSetBaseResp() {
+		return GetAddressResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var GetAddressResponse_Address_DEFAULT *Address
+
+func (p *GetAddressResponse) GetAddress() (v *Address) {
+	if !p.IsSetAddress() {
+		return GetAddressResponse_Address_DEFAULT
+	}
+	return p.Address
+}
+func (p *GetAddressResponse) SetBaseResp(val *base.BaseResponse) {
+	p.BaseResp = val
+}
+func (p *GetAddressResponse) SetAddress(val *Address) {
+	p.Address = val
+}
+
+func (p *GetAddressResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetAddressResponse) IsSetAddress() bool {
+	return p.Address != nil
+}
+
+func (p *GetAddressResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetAddressResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetAddressResponse = map[int16]string{
+	1: "baseResp",
+	2: "address",
+}
+
+type ListAddressesRequest struct {
+	UserId int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+}
+
+func NewListAddressesRequest() *ListAddressesRequest {
+	return &ListAddressesRequest{}
+}
+
+func (p *ListAddressesRequest) InitDefault() {
+}
+
+func (p *ListAddressesRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *ListAddressesRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+
+func (p *ListAddressesRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListAddressesRequest(%+v)", *p)
+}
+
+var fieldIDToName_ListAddressesRequest = map[int16]string{
+	1: "user_id",
+}
+
+type ListAddressesResponse struct {
+	BaseResp  *base.BaseResponse `thrift:"baseResp,1" frugal:"1,default,base.BaseResponse" json:"baseResp"`
+	Addresses []*Address         `thrift:"addresses,2" frugal:"2,default,list<Address>" json:"addresses"`
+}
+
+func NewListAddressesResponse() *ListAddressesResponse {
+	return &ListAddressesResponse{}
+}
+
+func (p *ListAddressesResponse) InitDefault() {
+}
+
+var ListAddressesResponse_BaseResp_DEFAULT *base.BaseResponse
+
+func (p *ListAddressesResponse) GetBaseResp() (v *base.BaseResponse) {
+	if !p.IsSetBaseResp() {
+		return ListAddressesResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *ListAddressesResponse) GetAddresses() (v []*Address) {
+	return p.Addresses
+}
+func (p *ListAddressesResponse) SetBaseResp(val *base.BaseResponse) {
+	p.BaseResp = val
+}
+func (p *ListAddressesResponse) SetAddresses(val []*Address) {
+	p.Addresses = val
+}
+
+func (p *ListAddressesResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ListAddressesResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListAddressesResponse(%+v)", *p)
+}
+
+var fieldIDToName_ListAddressesResponse = map[int16]string{
+	1: "baseResp",
+	2: "addresses",
+}
+
 type UserAccountService interface {
 	Register(ctx context.Context, req *RegisterRequest) (r *RegisterResponse, err error)
 
 	Login(ctx context.Context, req *LoginRequest) (r *LoginResponse, err error)
 
 	Update(ctx context.Context, req *UpdateRequest) (r *UpdateResponse, err error)
+
+	CreateAddress(ctx context.Context, req *CreateAddressRequest) (r *CreateAddressResponse, err error)
+
+	UpdateAddress(ctx context.Context, req *UpdateAddressRequest) (r *UpdateAddressResponse, err error)
+
+	DeleteAddress(ctx context.Context, req *DeleteAddressRequest) (r *DeleteAddressResponse, err error)
+
+	GetAddress(ctx context.Context, req *GetAddressRequest) (r *GetAddressResponse, err error)
+
+	ListAddresses(ctx context.Context, req *ListAddressesRequest) (r *ListAddressesResponse, err error)
 }
 
 type UserAccountServiceRegisterArgs struct {
@@ -825,3 +1550,383 @@ func (p *UserAccountServiceUpdateResult) String() string {
 var fieldIDToName_UserAccountServiceUpdateResult = map[int16]string{
 	0: "success",
 }
+
+type UserAccountServiceCreateAddressArgs struct {
+	Req *CreateAddressRequest `thrift:"req,1" frugal:"1,default,CreateAddressRequest" json:"req"`
+}
+
+func NewUserAccountServiceCreateAddressArgs() *UserAccountServiceCreateAddressArgs {
+	return &UserAccountServiceCreateAddressArgs{}
+}
+
+func (p *UserAccountServiceCreateAddressArgs) InitDefault() {
+}
+
+var UserAccountServiceCreateAddressArgs_Req_DEFAULT *CreateAddressRequest
+
+func (p *UserAccountServiceCreateAddressArgs) GetReq() (v *CreateAddressRequest) {
+	if !p.IsSetReq() {
+		return UserAccountServiceCreateAddressArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserAccountServiceCreateAddressArgs) SetReq(val *CreateAddressRequest) {
+	p.Req = val
+}
+
+func (p *UserAccountServiceCreateAddressArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserAccountServiceCreateAddressArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAccountServiceCreateAddressArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserAccountServiceCreateAddressArgs = map[int16]string{
+	1: "req",
+}
+
+type UserAccountServiceCreateAddressResult struct {
+	Success *CreateAddressResponse `thrift:"success,0,optional" frugal:"0,optional,CreateAddressResponse" json:"success,omitempty"`
+}
+
+func NewUserAccountServiceCreateAddressResult() *UserAccountServiceCreateAddressResult {
+	return &UserAccountServiceCreateAddressResult{}
+}
+
+func (p *UserAccountServiceCreateAddressResult) InitDefault() {
+}
+
+var UserAccountServiceCreateAddressResult_Success_DEFAULT *CreateAddressResponse
+
+func (p *UserAccountServiceCreateAddressResult) GetSuccess() (v *CreateAddressResponse) {
+	if !p.IsSetSuccess() {
+		return UserAccountServiceCreateAddressResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserAccountServiceCreateAddressResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateAddressResponse)
+}
+
+func (p *UserAccountServiceCreateAddressResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserAccountServiceCreateAddressResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAccountServiceCreateAddressResult(%+v)", *p)
+}
+
+var fieldIDToName_UserAccountServiceCreateAddressResult = map[int16]string{
+	0: "success",
+}
+
+type UserAccountServiceUpdateAddressArgs struct {
+	Req *UpdateAddressRequest `thrift:"req,1" frugal:"1,default,UpdateAddressRequest" json:"req"`
+}
+
+func NewUserAccountServiceUpdateAddressArgs() *UserAccountServiceUpdateAddressArgs {
+	return &UserAccountServiceUpdateAddressArgs{}
+}
+
+func (p *UserAccountServiceUpdateAddressArgs) InitDefault() {
+}
+
+var UserAccountServiceUpdateAddressArgs_Req_DEFAULT *UpdateAddressRequest
+
+func (p *UserAccountServiceUpdateAddressArgs) GetReq() (v *UpdateAddressRequest) {
+	if !p.IsSetReq() {
+		return UserAccountServiceUpdateAddressArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserAccountServiceUpdateAddressArgs) SetReq(val *UpdateAddressRequest) {
+	p.Req = val
+}
+
+func (p *UserAccountServiceUpdateAddressArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserAccountServiceUpdateAddressArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAccountServiceUpdateAddressArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserAccountServiceUpdateAddressArgs = map[int16]string{
+	1: "req",
+}
+
+type UserAccountServiceUpdateAddressResult struct {
+	Success *UpdateAddressResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateAddressResponse" json:"success,omitempty"`
+}
+
+func NewUserAccountServiceUpdateAddressResult() *UserAccountServiceUpdateAddressResult {
+	return &UserAccountServiceUpdateAddressResult{}
+}
+
+func (p *UserAccountServiceUpdateAddressResult) InitDefault() {
+}
+
+var UserAccountServiceUpdateAddressResult_Success_DEFAULT *UpdateAddressResponse
+
+func (p *UserAccountServiceUpdateAddressResult) GetSuccess() (v *UpdateAddressResponse) {
+	if !p.IsSetSuccess() {
+		return UserAccountServiceUpdateAddressResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserAccountServiceUpdateAddressResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateAddressResponse)
+}
+
+func (p *UserAccountServiceUpdateAddressResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserAccountServiceUpdateAddressResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAccountServiceUpdateAddressResult(%+v)", *p)
+}
+
+var fieldIDToName_UserAccountServiceUpdateAddressResult = map[int16]string{
+	0: "success",
+}
+
+type UserAccountServiceDeleteAddressArgs struct {
+	Req *DeleteAddressRequest `thrift:"req,1" frugal:"1,default,DeleteAddressRequest" json:"req"`
+}
+
+func NewUserAccountServiceDeleteAddressArgs() *UserAccountServiceDeleteAddressArgs {
+	return &UserAccountServiceDeleteAddressArgs{}
+}
+
+func (p *UserAccountServiceDeleteAddressArgs) InitDefault() {
+}
+
+var UserAccountServiceDeleteAddressArgs_Req_DEFAULT *DeleteAddressRequest
+
+func (p *UserAccountServiceDeleteAddressArgs) GetReq() (v *DeleteAddressRequest) {
+	if !p.IsSetReq() {
+		return UserAccountServiceDeleteAddressArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserAccountServiceDeleteAddressArgs) SetReq(val *DeleteAddressRequest) {
+	p.Req = val
+}
+
+func (p *UserAccountServiceDeleteAddressArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserAccountServiceDeleteAddressArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAccountServiceDeleteAddressArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserAccountServiceDeleteAddressArgs = map[int16]string{
+	1: "req",
+}
+
+type UserAccountServiceDeleteAddressResult struct {
+	Success *DeleteAddressResponse `thrift:"success,0,optional" frugal:"0,optional,DeleteAddressResponse" json:"success,omitempty"`
+}
+
+func NewUserAccountServiceDeleteAddressResult() *UserAccountServiceDeleteAddressResult {
+	return &UserAccountServiceDeleteAddressResult{}
+}
+
+func (p *UserAccountServiceDeleteAddressResult) InitDefault() {
+}
+
+var UserAccountServiceDeleteAddressResult_Success_DEFAULT *DeleteAddressResponse
+
+func (p *UserAccountServiceDeleteAddressResult) GetSuccess() (v *DeleteAddressResponse) {
+	if !p.IsSetSuccess() {
+		return UserAccountServiceDeleteAddressResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserAccountServiceDeleteAddressResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DeleteAddressResponse)
+}
+
+func (p *UserAccountServiceDeleteAddressResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserAccountServiceDeleteAddressResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAccountServiceDeleteAddressResult(%+v)", *p)
+}
+
+var fieldIDToName_UserAccountServiceDeleteAddressResult = map[int16]string{
+	0: "success",
+}
+
+type UserAccountServiceGetAddressArgs struct {
+	Req *GetAddressRequest `thrift:"req,1" frugal:"1,default,GetAddressRequest" json:"req"`
+}
+
+func NewUserAccountServiceGetAddressArgs() *UserAccountServiceGetAddressArgs {
+	return &UserAccountServiceGetAddressArgs{}
+}
+
+func (p *UserAccountServiceGetAddressArgs) InitDefault() {
+}
+
+var UserAccountServiceGetAddressArgs_Req_DEFAULT *GetAddressRequest
+
+func (p *UserAccountServiceGetAddressArgs) GetReq() (v *GetAddressRequest) {
+	if !p.IsSetReq() {
+		return UserAccountServiceGetAddressArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserAccountServiceGetAddressArgs) SetReq(val *GetAddressRequest) {
+	p.Req = val
+}
+
+func (p *UserAccountServiceGetAddressArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserAccountServiceGetAddressArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAccountServiceGetAddressArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserAccountServiceGetAddressArgs = map[int16]string{
+	1: "req",
+}
+
+type UserAccountServiceGetAddressResult struct {
+	Success *GetAddressResponse `thrift:"success,0,optional" frugal:"0,optional,GetAddressResponse" json:"success,omitempty"`
+}
+
+func NewUserAccountServiceGetAddressResult() *UserAccountServiceGetAddressResult {
+	return &UserAccountServiceGetAddressResult{}
+}
+
+func (p *UserAccountServiceGetAddressResult) InitDefault() {
+}
+
+var UserAccountServiceGetAddressResult_Success_DEFAULT *GetAddressResponse
+
+func (p *UserAccountServiceGetAddressResult) GetSuccess() (v *GetAddressResponse) {
+	if !p.IsSetSuccess() {
+		return UserAccountServiceGetAddressResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserAccountServiceGetAddressResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetAddressResponse)
+}
+
+func (p *UserAccountServiceGetAddressResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserAccountServiceGetAddressResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAccountServiceGetAddressResult(%+v)", *p)
+}
+
+var fieldIDToName_UserAccountServiceGetAddressResult = map[int16]string{
+	0: "success",
+}
+
+type UserAccountServiceListAddressesArgs struct {
+	Req *ListAddressesRequest `thrift:"req,1" frugal:"1,default,ListAddressesRequest" json:"req"`
+}
+
+func NewUserAccountServiceListAddressesArgs() *UserAccountServiceListAddressesArgs {
+	return &UserAccountServiceListAddressesArgs{}
+}
+
+func (p *UserAccountServiceListAddressesArgs) InitDefault() {
+}
+
+var UserAccountServiceListAddressesArgs_Req_DEFAULT *ListAddressesRequest
+
+func (p *UserAccountServiceListAddressesArgs) GetReq() (v *ListAddressesRequest) {
+	if !p.IsSetReq() {
+		return UserAccountServiceListAddressesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserAccountServiceListAddressesArgs) SetReq(val *ListAddressesRequest) {
+	p.Req = val
+}
+
+func (p *UserAccountServiceListAddressesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserAccountServiceListAddressesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAccountServiceListAddressesArgs(%+v)", *p)
+}
+
+var fieldIDToName_UserAccountServiceListAddressesArgs = map[int16]string{
+	1: "req",
+}
+
+type UserAccountServiceListAddressesResult struct {
+	Success *ListAddressesResponse `thrift:"success,0,optional" frugal:"0,optional,ListAddressesResponse" json:"success,omitempty"`
+}
+
+func NewUserAccountServiceListAddressesResult() *UserAccountServiceListAddressesResult {
+	return &UserAccountServiceListAddressesResult{}
+}
+
+func (p *UserAccountServiceListAddressesResult) InitDefault() {
+}
+
+var UserAccountServiceListAddressesResult_Success_DEFAULT *ListAddressesResponse
+
+func (p *UserAccountServiceListAddressesResult) GetSuccess() (v *ListAddressesResponse) {
+	if !p.IsSetSuccess() {
+		return UserAccountServiceListAddressesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserAccountServiceListAddressesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListAddressesResponse)
+}
+
+func (p *UserAccountServiceListAddressesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserAccountServiceListAddressesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAccountServiceListAddressesResult(%+v)", *p)
+}
+
+var fieldIDToName_UserAccountServiceListAddressesResult = map[int16]string{
+	0: "success",
+}
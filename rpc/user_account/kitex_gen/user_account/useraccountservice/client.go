@@ -14,6 +14,11 @@ type Client interface {
 	Register(ctx context.Context, req *user_account.RegisterRequest, callOptions ...callopt.Option) (r *user_account.RegisterResponse, err error)
 	Login(ctx context.Context, req *user_account.LoginRequest, callOptions ...callopt.Option) (r *user_account.LoginResponse, err error)
 	Update(ctx context.Context, req *user_account.UpdateRequest, callOptions ...callopt.Option) (r *user_account.UpdateResponse, err error)
+	CreateAddress(ctx context.Context, req *user_account.CreateAddressRequest, callOptions ...callopt.Option) (r *user_account.CreateAddressResponse, err error)
+	UpdateAddress(ctx context.Context, req *user_account.UpdateAddressRequest, callOptions ...callopt.Option) (r *user_account.UpdateAddressResponse, err error)
+	DeleteAddress(ctx context.Context, req *user_account.DeleteAddressRequest, callOptions ...callopt.Option) (r *user_account.DeleteAddressResponse, err error)
+	GetAddress(ctx context.Context, req *user_account.GetAddressRequest, callOptions ...callopt.Option) (r *user_account.GetAddressResponse, err error)
+	ListAddresses(ctx context.Context, req *user_account.ListAddressesRequest, callOptions ...callopt.Option) (r *user_account.ListAddressesResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -59,3 +64,28 @@ func (p *kUserAccountServiceClient) Update(ctx context.Context, req *user_accoun
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.Update(ctx, req)
 }
+
+func (p *kUserAccountServiceClient) CreateAddress(ctx context.Context, req *user_account.CreateAddressRequest, callOptions ...callopt.Option) (r *user_account.CreateAddressResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateAddress(ctx, req)
+}
+
+func (p *kUserAccountServiceClient) UpdateAddress(ctx context.Context, req *user_account.UpdateAddressRequest, callOptions ...callopt.Option) (r *user_account.UpdateAddressResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateAddress(ctx, req)
+}
+
+func (p *kUserAccountServiceClient) DeleteAddress(ctx context.Context, req *user_account.DeleteAddressRequest, callOptions ...callopt.Option) (r *user_account.DeleteAddressResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteAddress(ctx, req)
+}
+
+func (p *kUserAccountServiceClient) GetAddress(ctx context.Context, req *user_account.GetAddressRequest, callOptions ...callopt.Option) (r *user_account.GetAddressResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetAddress(ctx, req)
+}
+
+func (p *kUserAccountServiceClient) ListAddresses(ctx context.Context, req *user_account.ListAddressesRequest, callOptions ...callopt.Option) (r *user_account.ListAddressesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListAddresses(ctx, req)
+}
@@ -34,6 +34,41 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"CreateAddress": kitex.NewMethodInfo(
+		createAddressHandler,
+		newUserAccountServiceCreateAddressArgs,
+		newUserAccountServiceCreateAddressResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateAddress": kitex.NewMethodInfo(
+		updateAddressHandler,
+		newUserAccountServiceUpdateAddressArgs,
+		newUserAccountServiceUpdateAddressResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DeleteAddress": kitex.NewMethodInfo(
+		deleteAddressHandler,
+		newUserAccountServiceDeleteAddressArgs,
+		newUserAccountServiceDeleteAddressResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetAddress": kitex.NewMethodInfo(
+		getAddressHandler,
+		newUserAccountServiceGetAddressArgs,
+		newUserAccountServiceGetAddressResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListAddresses": kitex.NewMethodInfo(
+		listAddressesHandler,
+		newUserAccountServiceListAddressesArgs,
+		newUserAccountServiceListAddressesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -154,6 +189,96 @@ func newUserAccountServiceUpdateResult() interface{} {
 	return user_account.NewUserAccountServiceUpdateResult()
 }
 
+func createAddressHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user_account.UserAccountServiceCreateAddressArgs)
+	realResult := result.(*user_account.UserAccountServiceCreateAddressResult)
+	success, err := handler.(user_account.UserAccountService).CreateAddress(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserAccountServiceCreateAddressArgs() interface{} {
+	return user_account.NewUserAccountServiceCreateAddressArgs()
+}
+
+func newUserAccountServiceCreateAddressResult() interface{} {
+	return user_account.NewUserAccountServiceCreateAddressResult()
+}
+
+func updateAddressHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user_account.UserAccountServiceUpdateAddressArgs)
+	realResult := result.(*user_account.UserAccountServiceUpdateAddressResult)
+	success, err := handler.(user_account.UserAccountService).UpdateAddress(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserAccountServiceUpdateAddressArgs() interface{} {
+	return user_account.NewUserAccountServiceUpdateAddressArgs()
+}
+
+func newUserAccountServiceUpdateAddressResult() interface{} {
+	return user_account.NewUserAccountServiceUpdateAddressResult()
+}
+
+func deleteAddressHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user_account.UserAccountServiceDeleteAddressArgs)
+	realResult := result.(*user_account.UserAccountServiceDeleteAddressResult)
+	success, err := handler.(user_account.UserAccountService).DeleteAddress(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserAccountServiceDeleteAddressArgs() interface{} {
+	return user_account.NewUserAccountServiceDeleteAddressArgs()
+}
+
+func newUserAccountServiceDeleteAddressResult() interface{} {
+	return user_account.NewUserAccountServiceDeleteAddressResult()
+}
+
+func getAddressHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user_account.UserAccountServiceGetAddressArgs)
+	realResult := result.(*user_account.UserAccountServiceGetAddressResult)
+	success, err := handler.(user_account.UserAccountService).GetAddress(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserAccountServiceGetAddressArgs() interface{} {
+	return user_account.NewUserAccountServiceGetAddressArgs()
+}
+
+func newUserAccountServiceGetAddressResult() interface{} {
+	return user_account.NewUserAccountServiceGetAddressResult()
+}
+
+func listAddressesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user_account.UserAccountServiceListAddressesArgs)
+	realResult := result.(*user_account.UserAccountServiceListAddressesResult)
+	success, err := handler.(user_account.UserAccountService).ListAddresses(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserAccountServiceListAddressesArgs() interface{} {
+	return user_account.NewUserAccountServiceListAddressesArgs()
+}
+
+func newUserAccountServiceListAddressesResult() interface{} {
+	return user_account.NewUserAccountServiceListAddressesResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -193,3 +318,53 @@ func (p *kClient) Update(ctx context.Context, req *user_account.UpdateRequest) (
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) CreateAddress(ctx context.Context, req *user_account.CreateAddressRequest) (r *user_account.CreateAddressResponse, err error) {
+	var _args user_account.UserAccountServiceCreateAddressArgs
+	_args.Req = req
+	var _result user_account.UserAccountServiceCreateAddressResult
+	if err = p.c.Call(ctx, "CreateAddress", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateAddress(ctx context.Context, req *user_account.UpdateAddressRequest) (r *user_account.UpdateAddressResponse, err error) {
+	var _args user_account.UserAccountServiceUpdateAddressArgs
+	_args.Req = req
+	var _result user_account.UserAccountServiceUpdateAddressResult
+	if err = p.c.Call(ctx, "UpdateAddress", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteAddress(ctx context.Context, req *user_account.DeleteAddressRequest) (r *user_account.DeleteAddressResponse, err error) {
+	var _args user_account.UserAccountServiceDeleteAddressArgs
+	_args.Req = req
+	var _result user_account.UserAccountServiceDeleteAddressResult
+	if err = p.c.Call(ctx, "DeleteAddress", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetAddress(ctx context.Context, req *user_account.GetAddressRequest) (r *user_account.GetAddressResponse, err error) {
+	var _args user_account.UserAccountServiceGetAddressArgs
+	_args.Req = req
+	var _result user_account.UserAccountServiceGetAddressResult
+	if err = p.c.Call(ctx, "GetAddress", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListAddresses(ctx context.Context, req *user_account.ListAddressesRequest) (r *user_account.ListAddressesResponse, err error) {
+	var _args user_account.UserAccountServiceListAddressesArgs
+	_args.Req = req
+	var _result user_account.UserAccountServiceListAddressesResult
+	if err = p.c.Call(ctx, "ListAddresses", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
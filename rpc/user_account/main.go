@@ -1,11 +1,16 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 	"os"
 
 	user_account "github.com/youperceive/cloudwego_instance/rpc/user_account/kitex_gen/user_account/useraccountservice"
+	"github.com/youperceive/cloudwego_instance/rpc/user_account/pkg/dao"
+	"github.com/youperceive/cloudwego_instance/rpc/user_account/pkg/event"
+	"github.com/youperceive/cloudwego_instance/rpc/user_account/pkg/mysql"
+	"github.com/youperceive/cloudwego_instance/rpc/user_account/pkg/token"
 	"github.com/youperceive/cloudwego_instance/rpc/verify_code/kitex_gen/verify_code/verifycodeservice"
 
 	"github.com/cloudwego/kitex/client"
@@ -24,7 +29,25 @@ func main() {
 		klog.Fatal("Init stage: ", "env $VERIFY_CODE_SERVICE_ADDR is empty.")
 	}
 
-	userAccountServiceImpl := new(UserAccountServiceImpl)
+	db, err := mysql.Open(mysql.ConfigFromEnv())
+	if err != nil {
+		klog.Fatal("Init stage: ", err.Error())
+	}
+
+	sink, err := event.SinkFromEnv()
+	if err != nil {
+		klog.Fatal("Init stage: ", "fail to init event sink. "+err.Error())
+	}
+	if sink != nil {
+		go event.NewRelay(dao.NewEventStore(db), sink).Run(context.Background())
+	} else {
+		klog.Warn("Init stage: ", "env $EVENT_SINK is empty, events stay in outbox.")
+	}
+
+	userAccountServiceImpl := &UserAccountServiceImpl{
+		Repo:        dao.NewRepository(db),
+		TokenIssuer: token.NewIssuer(token.ConfigFromEnv()),
+	}
 	cli, err := verifycodeservice.NewClient(
 		"verify-code-service",
 		client.WithHostPorts(verifyCodeAddr),
@@ -0,0 +1,190 @@
+package dao
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// MaxAddressesPerUser 每个用户最多保存的收货地址数
+const MaxAddressesPerUser = 20
+
+var (
+	ErrAddressNotFound = errors.New("address not found")
+	ErrAddressLimit    = errors.New("address count exceeds limit")
+)
+
+type Address struct {
+	ID           int64  `gorm:"primarykey;column:id;type:bigint unsigned;autoIncrement"`
+	UserID       int64  `gorm:"column:user_id;type:bigint unsigned;not null;index:idx_user_id"`
+	ReceiverName string `gorm:"column:receiver_name;type:varchar(64);not null"`
+	Phone        string `gorm:"column:phone;type:varchar(20);not null"`
+	Province     string `gorm:"column:province;type:varchar(32);not null"`
+	City         string `gorm:"column:city;type:varchar(32);not null"`
+	District     string `gorm:"column:district;type:varchar(32);not null"`
+	Detail       string `gorm:"column:detail;type:varchar(255);not null"`
+	IsDefault    bool   `gorm:"column:is_default;type:tinyint(1);not null;default:0"`
+	CreatedAt    int64  `gorm:"column:created_at;type:bigint;not null"`
+	UpdatedAt    int64  `gorm:"column:updated_at;type:bigint;not null"`
+}
+
+func (a *Address) TableName() string {
+	return "user_address"
+}
+
+// lockUser 锁住用户行，同一用户的地址写入串行执行，数量上限和唯一默认地址才不会被并发打破
+func lockUser(tx *gorm.DB, userID int64) error {
+	var user User
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&user, userID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrUserNotFound
+	}
+	return err
+}
+
+func findAddress(tx *gorm.DB, userID, id int64) (*Address, error) {
+	addr := &Address{}
+	err := tx.Where("id = ? AND user_id = ?", id, userID).First(addr).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrAddressNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return addr, nil
+}
+
+func clearDefault(tx *gorm.DB, userID int64) error {
+	return tx.Model(&Address{}).
+		Where("user_id = ? AND is_default = ?", userID, true).
+		Update("is_default", false).Error
+}
+
+// addressWriteErr 保留业务错误，其余数据库错误统一为 ErrDBUpdate
+func addressWriteErr(err error) error {
+	if errors.Is(err, ErrUserNotFound) || errors.Is(err, ErrAddressNotFound) || errors.Is(err, ErrAddressLimit) {
+		return err
+	}
+	return ErrDBUpdate
+}
+
+func (r *gormRepository) CreateAddress(addr *Address) (int64, error) {
+	now := time.Now().Unix()
+	addr.CreatedAt = now
+	addr.UpdatedAt = now
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockUser(tx, addr.UserID); err != nil {
+			return err
+		}
+		var count int64
+		if err := tx.Model(&Address{}).Where("user_id = ?", addr.UserID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count >= MaxAddressesPerUser {
+			return ErrAddressLimit
+		}
+		// 第一个地址自动成为默认地址
+		if count == 0 {
+			addr.IsDefault = true
+		}
+		if addr.IsDefault {
+			if err := clearDefault(tx, addr.UserID); err != nil {
+				return err
+			}
+		}
+		return tx.Create(addr).Error
+	})
+	if err != nil {
+		return 0, addressWriteErr(err)
+	}
+	return addr.ID, nil
+}
+
+// UpdateAddress 修改 info 中的字段，makeDefault 为 true 时同时设为默认地址
+func (r *gormRepository) UpdateAddress(userID, id int64, info map[string]any, makeDefault bool) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockUser(tx, userID); err != nil {
+			return err
+		}
+		addr, err := findAddress(tx, userID, id)
+		if err != nil {
+			return err
+		}
+		updates := make(map[string]any, len(info)+2)
+		for k, v := range info {
+			updates[k] = v
+		}
+		if makeDefault && !addr.IsDefault {
+			if err := clearDefault(tx, userID); err != nil {
+				return err
+			}
+			updates["is_default"] = true
+		}
+		if len(updates) == 0 {
+			return nil
+		}
+		updates["updated_at"] = time.Now().Unix()
+		return tx.Model(addr).Updates(updates).Error
+	})
+	if err != nil {
+		return addressWriteErr(err)
+	}
+	return nil
+}
+
+// DeleteAddress 删除地址；删除的是默认地址时，最近更新的另一个地址成为默认地址
+func (r *gormRepository) DeleteAddress(userID, id int64) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockUser(tx, userID); err != nil {
+			return err
+		}
+		addr, err := findAddress(tx, userID, id)
+		if err != nil {
+			return err
+		}
+		if err := tx.Delete(addr).Error; err != nil {
+			return err
+		}
+		if !addr.IsDefault {
+			return nil
+		}
+		next := &Address{}
+		err = tx.Where("user_id = ?", userID).Order("updated_at DESC, id DESC").First(next).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return tx.Model(next).Update("is_default", true).Error
+	})
+	if err != nil {
+		return addressWriteErr(err)
+	}
+	return nil
+}
+
+func (r *gormRepository) GetAddress(userID, id int64) (*Address, error) {
+	addr, err := findAddress(r.db, userID, id)
+	if err != nil {
+		if errors.Is(err, ErrAddressNotFound) {
+			return nil, err
+		}
+		return nil, ErrDBQuery
+	}
+	return addr, nil
+}
+
+func (r *gormRepository) ListAddresses(userID int64) ([]*Address, error) {
+	var addrs []*Address
+	result := r.db.Where("user_id = ?", userID).
+		Order("is_default DESC, updated_at DESC, id DESC").
+		Find(&addrs)
+	if result.Error != nil {
+		return nil, ErrDBQuery
+	}
+	return addrs, nil
+}
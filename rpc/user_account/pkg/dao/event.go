@@ -0,0 +1,181 @@
+package dao
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// EventSchemaVersion 是 payload 结构的版本号，payload 字段有不兼容变更时递增
+const EventSchemaVersion = 1
+
+const (
+	EventUserRegistered = "user.registered"
+	EventUserLoggedIn   = "user.logged_in"
+	EventUserUpdated    = "user.updated"
+	EventUserDisabled   = "user.disabled"
+)
+
+// UserStatusDisabled 对应 IDL User.status 的 2-禁用
+const UserStatusDisabled int32 = 2
+
+var ErrDBEvent = errors.New("mysql write event failed")
+
+// UserEvent 是事件发件箱（outbox）中的一行，与业务数据在同一事务中写入，
+// 由 relay 异步投递，投递成功后回写 published_at；失败次数达到上限后回写 dead_at，不再投递。
+type UserEvent struct {
+	ID            int64           `gorm:"primarykey;column:id;type:bigint unsigned;autoIncrement"`
+	EventID       string          `gorm:"column:event_id;type:char(32);not null;uniqueIndex:uk_event_id"`
+	EventType     string          `gorm:"column:event_type;type:varchar(32);not null"`
+	SchemaVersion int32           `gorm:"column:schema_version;type:int;not null"`
+	UserID        int64           `gorm:"column:user_id;type:bigint unsigned;not null"`
+	Payload       json.RawMessage `gorm:"column:payload;type:json"`
+	Attempts      int32           `gorm:"column:attempts;type:int;not null;default:0"`
+	CreatedAt     int64           `gorm:"column:created_at;type:bigint;not null"`
+	PublishedAt   int64           `gorm:"column:published_at;type:bigint;not null;default:0"`
+	DeadAt        int64           `gorm:"column:dead_at;type:bigint;not null;default:0"`
+}
+
+func (e *UserEvent) TableName() string {
+	return "user_event_outbox"
+}
+
+// EventStore 供 relay 读取、确认发件箱中的事件
+type EventStore interface {
+	FetchPendingEvents(limit int) ([]*UserEvent, error)
+	MarkEventPublished(id int64) error
+	MarkEventFailed(id int64) error
+	// MarkEventDead 记一次失败并转入死信，之后不再被 FetchPendingEvents 返回
+	MarkEventDead(id int64) error
+}
+
+func NewEventStore(db *gorm.DB) EventStore {
+	return &gormRepository{db: db}
+}
+
+func newEventID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func newUserEvent(eventType string, userID int64, payload any) (*UserEvent, error) {
+	eventID, err := newEventID()
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return &UserEvent{
+		EventID:       eventID,
+		EventType:     eventType,
+		SchemaVersion: EventSchemaVersion,
+		UserID:        userID,
+		Payload:       data,
+		CreatedAt:     time.Now().Unix(),
+	}, nil
+}
+
+func registeredEvent(user *User) (*UserEvent, error) {
+	return newUserEvent(EventUserRegistered, user.ID, map[string]any{
+		"user_id":       user.ID,
+		"username":      user.Username,
+		"email":         user.Email,
+		"phone":         user.Phone,
+		"user_type":     user.UserType,
+		"register_type": user.RegisterType,
+	})
+}
+
+// updatedEvents 只记录被修改的字段名，不携带字段值，避免密码等敏感信息流出。
+// prevStatus 为修改前的状态，状态由其他值变为禁用时才追加 user.disabled
+func updatedEvents(userID int64, info map[string]any, prevStatus int32) ([]*UserEvent, error) {
+	fields := make([]string, 0, len(info))
+	for k := range info {
+		fields = append(fields, k)
+	}
+	sort.Strings(fields)
+
+	updated, err := newUserEvent(EventUserUpdated, userID, map[string]any{
+		"user_id": userID,
+		"fields":  fields,
+	})
+	if err != nil {
+		return nil, err
+	}
+	events := []*UserEvent{updated}
+
+	if status, ok := info["status"].(int32); ok && status == UserStatusDisabled && prevStatus != UserStatusDisabled {
+		disabled, err := newUserEvent(EventUserDisabled, userID, map[string]any{
+			"user_id": userID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, disabled)
+	}
+	return events, nil
+}
+
+func (r *gormRepository) RecordLogin(userID int64) error {
+	event, err := newUserEvent(EventUserLoggedIn, userID, map[string]any{
+		"user_id":  userID,
+		"login_at": time.Now().Unix(),
+	})
+	if err != nil {
+		return err
+	}
+	if err := r.db.Create(event).Error; err != nil {
+		return ErrDBEvent
+	}
+	return nil
+}
+
+func (r *gormRepository) FetchPendingEvents(limit int) ([]*UserEvent, error) {
+	var events []*UserEvent
+	result := r.db.Model(&UserEvent{}).
+		Where("published_at = 0 AND dead_at = 0").
+		Order("id ASC").
+		Limit(limit).
+		Find(&events)
+	if result.Error != nil {
+		return nil, ErrDBQuery
+	}
+	return events, nil
+}
+
+func (r *gormRepository) MarkEventPublished(id int64) error {
+	result := r.db.Model(&UserEvent{}).Where("id = ?", id).Update("published_at", time.Now().Unix())
+	if result.Error != nil {
+		return ErrDBUpdate
+	}
+	return nil
+}
+
+func (r *gormRepository) MarkEventFailed(id int64) error {
+	result := r.db.Model(&UserEvent{}).Where("id = ?", id).Update("attempts", gorm.Expr("attempts + 1"))
+	if result.Error != nil {
+		return ErrDBUpdate
+	}
+	return nil
+}
+
+func (r *gormRepository) MarkEventDead(id int64) error {
+	result := r.db.Model(&UserEvent{}).Where("id = ?", id).Updates(map[string]any{
+		"attempts": gorm.Expr("attempts + 1"),
+		"dead_at":  time.Now().Unix(),
+	})
+	if result.Error != nil {
+		return ErrDBUpdate
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+USE user_account_db;
+
+CREATE TABLE `user_address` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT COMMENT '地址ID',
+  `user_id` bigint unsigned NOT NULL COMMENT '所属用户ID',
+  `receiver_name` varchar(64) NOT NULL COMMENT '收货人',
+  `phone` varchar(20) NOT NULL COMMENT '收货人手机号',
+  `province` varchar(32) NOT NULL COMMENT '省',
+  `city` varchar(32) NOT NULL COMMENT '市',
+  `district` varchar(32) NOT NULL COMMENT '区/县',
+  `detail` varchar(255) NOT NULL COMMENT '详细地址',
+  `is_default` tinyint(1) NOT NULL DEFAULT 0 COMMENT '是否默认地址，每个用户至多一个',
+  `created_at` bigint NOT NULL COMMENT '创建时间戳（秒级）',
+  `updated_at` bigint NOT NULL COMMENT '更新时间戳（秒级）',
+  PRIMARY KEY (`id`),
+  KEY `idx_user_id` (`user_id`, `is_default`) COMMENT '按用户列出地址'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='用户收货地址表';
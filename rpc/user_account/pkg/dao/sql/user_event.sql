@@ -0,0 +1,17 @@
+USE user_account_db;
+
+CREATE TABLE `user_event_outbox` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT COMMENT '自增序号（relay 按此顺序投递）',
+  `event_id` char(32) NOT NULL COMMENT '事件唯一ID（下游按此去重）',
+  `event_type` varchar(32) NOT NULL COMMENT '事件类型：user.registered/user.logged_in/user.updated/user.disabled',
+  `schema_version` int NOT NULL COMMENT 'payload 结构版本',
+  `user_id` bigint unsigned NOT NULL COMMENT '事件所属用户ID',
+  `payload` json COMMENT '事件内容',
+  `attempts` int NOT NULL DEFAULT 0 COMMENT '投递失败次数',
+  `created_at` bigint NOT NULL COMMENT '事件产生时间戳（秒级）',
+  `published_at` bigint NOT NULL DEFAULT 0 COMMENT '投递成功时间戳（秒级），0 表示未投递',
+  `dead_at` bigint NOT NULL DEFAULT 0 COMMENT '失败次数达到上限、转入死信的时间戳（秒级），0 表示未转入',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_event_id` (`event_id`) COMMENT '事件ID唯一',
+  KEY `idx_published_at` (`published_at`, `dead_at`, `id`) COMMENT '便于 relay 拉取未投递事件'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='用户事件发件箱';
@@ -7,8 +7,8 @@ import (
 	"time"
 
 	"github.com/youperceive/cloudwego_instance/rpc/user_account/kitex_gen/base"
-	"github.com/youperceive/cloudwego_instance/rpc/user_account/pkg/mysql"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var (
@@ -35,7 +35,30 @@ func (u *User) TableName() string {
 	return "user"
 }
 
-func CreateUser(user *User) (int64, error) {
+// Repository 是 handler 依赖的用户存储，测试时可替换为内存或 sqlmock 实现
+type Repository interface {
+	CreateUser(user *User) (int64, error)
+	QueryUser(target string, targetType base.TargetType) (*User, error)
+	QueryUserById(id int64) (*User, error)
+	UpdateUser(userId int64, info map[string]any) error
+	RecordLogin(userID int64) error
+
+	CreateAddress(addr *Address) (int64, error)
+	UpdateAddress(userID, id int64, info map[string]any, makeDefault bool) error
+	DeleteAddress(userID, id int64) error
+	GetAddress(userID, id int64) (*Address, error)
+	ListAddresses(userID int64) ([]*Address, error)
+}
+
+type gormRepository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &gormRepository{db: db}
+}
+
+func (r *gormRepository) CreateUser(user *User) (int64, error) {
 	now := time.Now().Unix()
 	user.CreatedAt = now
 	user.UpdatedAt = now
@@ -43,7 +66,18 @@ func CreateUser(user *User) (int64, error) {
 		user.Ext = json.RawMessage("{}")
 	}
 
-	if err := mysql.DB.Create(user).Error; err != nil {
+	// 用户与 registered 事件在同一事务中写入，保证事件不丢不多
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(user).Error; err != nil {
+			return err
+		}
+		event, err := registeredEvent(user)
+		if err != nil {
+			return err
+		}
+		return tx.Create(event).Error
+	})
+	if err != nil {
 		log.Println(err)
 		return 0, err
 	}
@@ -51,7 +85,7 @@ func CreateUser(user *User) (int64, error) {
 	return user.ID, nil
 }
 
-func QueryUser(target string, targetType base.TargetType) (*User, error) {
+func (r *gormRepository) QueryUser(target string, targetType base.TargetType) (*User, error) {
 	if target == "" {
 		return nil, errors.New("query user failed: target is empty")
 	}
@@ -64,9 +98,9 @@ func QueryUser(target string, targetType base.TargetType) (*User, error) {
 
 	switch targetType {
 	case base.TargetType_Phone:
-		result = mysql.DB.Model(&User{}).Where("phone = ?", target).First(user)
+		result = r.db.Model(&User{}).Where("phone = ?", target).First(user)
 	case base.TargetType_Email:
-		result = mysql.DB.Model(&User{}).Where("email = ?", target).First(user)
+		result = r.db.Model(&User{}).Where("email = ?", target).First(user)
 	}
 
 	if result.Error != nil {
@@ -79,10 +113,10 @@ func QueryUser(target string, targetType base.TargetType) (*User, error) {
 	return user, nil
 }
 
-func QueryUserById(id int64) (*User, error) {
+func (r *gormRepository) QueryUserById(id int64) (*User, error) {
 	user := &User{}
 
-	result := mysql.DB.First(user, id)
+	result := r.db.First(user, id)
 
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
@@ -94,17 +128,37 @@ func QueryUserById(id int64) (*User, error) {
 	return user, nil
 }
 
-func UpdateUser(userId int64, info map[string]any) error {
+func (r *gormRepository) UpdateUser(userId int64, info map[string]any) error {
 	if len(info) == 0 {
 		return nil
 	}
 
-	result := mysql.DB.Model(&User{}).Where("id = ?", userId).Updates(info)
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// 修改状态时锁住用户行读出原状态，只有真正变为禁用时才写 user.disabled
+		var prev User
+		if _, ok := info["status"]; ok {
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("status").Take(&prev, userId).Error; err != nil {
+				return err
+			}
+		}
+		events, err := updatedEvents(userId, info, int32(prev.Status))
+		if err != nil {
+			return ErrDBEvent
+		}
 
-	if result.Error != nil {
-		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		if err := tx.Model(&User{}).Where("id = ?", userId).Updates(info).Error; err != nil {
+			return err
+		}
+		return tx.Create(events).Error
+	})
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return ErrUserNotFound
 		}
+		if errors.Is(err, ErrDBEvent) {
+			return ErrDBEvent
+		}
 		return ErrDBUpdate
 	}
 
@@ -0,0 +1,50 @@
+package event
+
+import (
+	"fmt"
+	"os"
+)
+
+const (
+	defaultRedisStream = "user_account_events"
+	defaultStreamLen   = 100000
+	defaultFilePath    = "user_account_events.jsonl"
+)
+
+// SinkFromEnv 根据 $EVENT_SINK 构造投递目标：
+//
+//	redis   -> $EVENT_REDIS_ADDR, $EVENT_REDIS_STREAM
+//	webhook -> $EVENT_WEBHOOK_URL
+//	file    -> $EVENT_FILE_PATH
+//
+// 未配置 $EVENT_SINK 时返回 nil，事件只写入发件箱、不投递。
+func SinkFromEnv() (Sink, error) {
+	switch kind := os.Getenv("EVENT_SINK"); kind {
+	case "":
+		return nil, nil
+	case "redis":
+		addr := os.Getenv("EVENT_REDIS_ADDR")
+		if addr == "" {
+			return nil, fmt.Errorf("env $EVENT_REDIS_ADDR is empty")
+		}
+		stream := os.Getenv("EVENT_REDIS_STREAM")
+		if stream == "" {
+			stream = defaultRedisStream
+		}
+		return NewRedisStreamSink(addr, stream, defaultStreamLen), nil
+	case "webhook":
+		url := os.Getenv("EVENT_WEBHOOK_URL")
+		if url == "" {
+			return nil, fmt.Errorf("env $EVENT_WEBHOOK_URL is empty")
+		}
+		return NewWebhookSink(url), nil
+	case "file":
+		path := os.Getenv("EVENT_FILE_PATH")
+		if path == "" {
+			path = defaultFilePath
+		}
+		return NewFileSink(path), nil
+	default:
+		return nil, fmt.Errorf("unknown $EVENT_SINK: %s", kind)
+	}
+}
@@ -0,0 +1,34 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/youperceive/cloudwego_instance/rpc/user_account/pkg/dao"
+)
+
+// Message 是投递给下游的事件格式，下游按 ID 去重（投递语义为 at-least-once）
+type Message struct {
+	ID            string          `json:"id"`
+	Type          string          `json:"type"`
+	SchemaVersion int32           `json:"schema_version"`
+	UserID        int64           `json:"user_id"`
+	Payload       json.RawMessage `json:"payload"`
+	OccurredAt    int64           `json:"occurred_at"`
+}
+
+func NewMessage(e *dao.UserEvent) *Message {
+	return &Message{
+		ID:            e.EventID,
+		Type:          e.EventType,
+		SchemaVersion: e.SchemaVersion,
+		UserID:        e.UserID,
+		Payload:       e.Payload,
+		OccurredAt:    e.CreatedAt,
+	}
+}
+
+// Sink 是事件的投递目标，返回 nil 即视为投递成功
+type Sink interface {
+	Publish(ctx context.Context, msg *Message) error
+}
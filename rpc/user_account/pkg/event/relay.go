@@ -0,0 +1,89 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/youperceive/cloudwego_instance/rpc/user_account/pkg/dao"
+)
+
+const (
+	defaultBatchSize    = 100
+	defaultPollInterval = time.Second
+	// defaultMaxAttempts 一条事件最多投递的次数，达到后转入死信，避免一条坏事件一直阻塞后续事件
+	defaultMaxAttempts = 10
+)
+
+// Relay 轮询发件箱并投递到 Sink。
+// 先投递、后回写 published_at，进程在两步之间退出会导致重复投递，但不会丢事件。
+// 某条事件投递失败时停止本批次，保证同一发件箱内事件按写入顺序投递；
+// 失败次数达到 maxAttempts 的事件转入死信（dead_at），跳过它继续投递后续事件。
+type Relay struct {
+	store       dao.EventStore
+	sink        Sink
+	batch       int
+	interval    time.Duration
+	maxAttempts int32
+}
+
+func NewRelay(store dao.EventStore, sink Sink) *Relay {
+	return &Relay{
+		store:       store,
+		sink:        sink,
+		batch:       defaultBatchSize,
+		interval:    defaultPollInterval,
+		maxAttempts: defaultMaxAttempts,
+	}
+}
+
+// Run 阻塞运行直到 ctx 被取消
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// 拉满一批说明可能还有积压，继续拉取而不等待下一个 tick
+		for r.relayOnce(ctx) == r.batch {
+		}
+	}
+}
+
+// relayOnce 返回本次处理完的事件数（投递成功或转入死信）
+func (r *Relay) relayOnce(ctx context.Context) int {
+	events, err := r.store.FetchPendingEvents(r.batch)
+	if err != nil {
+		klog.Error("event/relay: ", "fail to fetch pending events. "+err.Error())
+		return 0
+	}
+
+	for i, e := range events {
+		if err := r.sink.Publish(ctx, NewMessage(e)); err != nil {
+			klog.Error("event/relay: ", "fail to publish event ", e.EventID, ". "+err.Error())
+			if e.Attempts+1 >= r.maxAttempts {
+				if err := r.store.MarkEventDead(e.ID); err != nil {
+					klog.Error("event/relay: ", "fail to mark event dead ", e.EventID, ". "+err.Error())
+					return i
+				}
+				klog.Error("event/relay: ", "event ", e.EventID, " moved to dead letter after ", e.Attempts+1, " attempts")
+				continue
+			}
+			if err := r.store.MarkEventFailed(e.ID); err != nil {
+				klog.Error("event/relay: ", "fail to mark event failed ", e.EventID, ". "+err.Error())
+			}
+			return i
+		}
+		if err := r.store.MarkEventPublished(e.ID); err != nil {
+			// 下一轮会重复投递该事件，下游按 id 去重
+			klog.Error("event/relay: ", "fail to mark event published ", e.EventID, ". "+err.Error())
+			return i
+		}
+	}
+	return len(events)
+}
@@ -0,0 +1,144 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/youperceive/cloudwego_instance/rpc/user_account/pkg/dao"
+)
+
+// fakeStore 在内存中模拟发件箱
+type fakeStore struct {
+	events []*dao.UserEvent
+}
+
+func (s *fakeStore) FetchPendingEvents(limit int) ([]*dao.UserEvent, error) {
+	var pending []*dao.UserEvent
+	for _, e := range s.events {
+		if e.PublishedAt == 0 && e.DeadAt == 0 && len(pending) < limit {
+			copied := *e
+			pending = append(pending, &copied)
+		}
+	}
+	return pending, nil
+}
+
+func (s *fakeStore) find(id int64) *dao.UserEvent {
+	for _, e := range s.events {
+		if e.ID == id {
+			return e
+		}
+	}
+	return nil
+}
+
+func (s *fakeStore) MarkEventPublished(id int64) error {
+	s.find(id).PublishedAt = 1
+	return nil
+}
+
+func (s *fakeStore) MarkEventFailed(id int64) error {
+	s.find(id).Attempts++
+	return nil
+}
+
+func (s *fakeStore) MarkEventDead(id int64) error {
+	e := s.find(id)
+	e.Attempts++
+	e.DeadAt = 1
+	return nil
+}
+
+// fakeSink 对 failing 中的事件返回错误
+type fakeSink struct {
+	failing   map[string]bool
+	published []string
+}
+
+func (s *fakeSink) Publish(ctx context.Context, msg *Message) error {
+	if s.failing[msg.ID] {
+		return errors.New("sink unavailable")
+	}
+	s.published = append(s.published, msg.ID)
+	return nil
+}
+
+func newTestRelay(events ...*dao.UserEvent) (*Relay, *fakeStore, *fakeSink) {
+	store := &fakeStore{events: events}
+	sink := &fakeSink{failing: map[string]bool{}}
+	return NewRelay(store, sink), store, sink
+}
+
+func TestRelayOncePublishesInOrder(t *testing.T) {
+	r, store, sink := newTestRelay(
+		&dao.UserEvent{ID: 1, EventID: "a"},
+		&dao.UserEvent{ID: 2, EventID: "b"},
+	)
+
+	if n := r.relayOnce(context.Background()); n != 2 {
+		t.Fatalf("relayOnce = %d, want 2", n)
+	}
+	if len(sink.published) != 2 || sink.published[0] != "a" || sink.published[1] != "b" {
+		t.Fatalf("published = %v", sink.published)
+	}
+	for _, e := range store.events {
+		if e.PublishedAt == 0 {
+			t.Fatalf("event %s not marked published", e.EventID)
+		}
+	}
+}
+
+func TestRelayOnceRetriesFailedEvent(t *testing.T) {
+	r, store, sink := newTestRelay(
+		&dao.UserEvent{ID: 1, EventID: "a"},
+		&dao.UserEvent{ID: 2, EventID: "b"},
+	)
+	sink.failing["a"] = true
+
+	// 失败的事件阻塞后续事件，直到达到重试上限
+	for i := int32(1); i < r.maxAttempts; i++ {
+		if n := r.relayOnce(context.Background()); n != 0 {
+			t.Fatalf("attempt %d: relayOnce = %d, want 0", i, n)
+		}
+		if got := store.find(1).Attempts; got != i {
+			t.Fatalf("attempts = %d, want %d", got, i)
+		}
+	}
+	if len(sink.published) != 0 {
+		t.Fatalf("published = %v, want none", sink.published)
+	}
+
+	// 恢复后按原顺序投递
+	sink.failing["a"] = false
+	if n := r.relayOnce(context.Background()); n != 2 {
+		t.Fatalf("relayOnce = %d, want 2", n)
+	}
+	if store.find(1).DeadAt != 0 {
+		t.Fatal("recovered event moved to dead letter")
+	}
+}
+
+func TestRelayOnceMovesPoisonEventToDeadLetter(t *testing.T) {
+	r, store, sink := newTestRelay(
+		&dao.UserEvent{ID: 1, EventID: "a", Attempts: defaultMaxAttempts - 1},
+		&dao.UserEvent{ID: 2, EventID: "b"},
+	)
+	sink.failing["a"] = true
+
+	if n := r.relayOnce(context.Background()); n != 2 {
+		t.Fatalf("relayOnce = %d, want 2", n)
+	}
+	poison := store.find(1)
+	if poison.DeadAt == 0 || poison.Attempts != defaultMaxAttempts {
+		t.Fatalf("poison event = %+v, want dead after %d attempts", poison, defaultMaxAttempts)
+	}
+	if len(sink.published) != 1 || sink.published[0] != "b" {
+		t.Fatalf("published = %v, want [b]", sink.published)
+	}
+
+	// 死信不再被拉取
+	if n := r.relayOnce(context.Background()); n != 0 {
+		t.Fatalf("relayOnce = %d, want 0", n)
+	}
+}
@@ -1,6 +1,7 @@
 package mysql
 
 import (
+	"fmt"
 	"log"
 	"os"
 	"time"
@@ -10,17 +11,31 @@ import (
 	"gorm.io/gorm/logger"
 )
 
-var DB *gorm.DB
+const defaultDSN = "user_service:user123456@tcp(mysql:3306)/user_account_db?charset=utf8mb4&parseTime=True&loc=Local"
 
-func init() {
+type Config struct {
+	DSN string
+	// Debug 为 true 时打印全部 SQL
+	Debug bool
+}
+
+// ConfigFromEnv 读取 $MYSQL_DSN 和 $ENV，未配置 DSN 时使用默认配置
+func ConfigFromEnv() Config {
 	dsn := os.Getenv("MYSQL_DSN")
 	if dsn == "" {
 		log.Println("ERROR: 环境变量 MYSQL_DSN 未配置，使用默认配置")
-		dsn = "user_service:user123456@tcp(mysql:3306)/user_account_db?charset=utf8mb4&parseTime=True&loc=Local"
+		dsn = defaultDSN
 	}
+	return Config{
+		DSN:   dsn,
+		Debug: os.Getenv("ENV") == "dev",
+	}
+}
 
+// Open 连接 MySQL 并完成健康检查，由调用方决定失败时如何处理
+func Open(cfg Config) (*gorm.DB, error) {
 	logLevel := logger.Silent
-	if os.Getenv("ENV") == "dev" {
+	if cfg.Debug {
 		logLevel = logger.Info
 	}
 	gormLogger := logger.New(
@@ -32,16 +47,16 @@ func init() {
 		},
 	)
 
-	rdb, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+	rdb, err := gorm.Open(mysql.Open(cfg.DSN), &gorm.Config{
 		Logger: gormLogger,
 	})
 	if err != nil {
-		log.Fatalf("ERROR: 连接MySQL失败: %v", err)
+		return nil, fmt.Errorf("连接MySQL失败: %w", err)
 	}
 
 	sqlDB, err := rdb.DB()
 	if err != nil {
-		log.Fatalf("ERROR: 获取SQL连接池失败: %v", err)
+		return nil, fmt.Errorf("获取SQL连接池失败: %w", err)
 	}
 	sqlDB.SetMaxOpenConns(10)
 	sqlDB.SetMaxIdleConns(20)
@@ -49,9 +64,9 @@ func init() {
 	sqlDB.SetConnMaxIdleTime(time.Minute)
 
 	if err := sqlDB.Ping(); err != nil {
-		log.Fatalf("ERROR: MySQL连接健康检查失败: %v", err)
+		return nil, fmt.Errorf("MySQL连接健康检查失败: %w", err)
 	}
 
-	DB = rdb
 	log.Println("INFO: MySQL连接初始化成功")
+	return rdb, nil
 }
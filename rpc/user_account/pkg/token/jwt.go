@@ -9,21 +9,46 @@ import (
 	"github.com/golang-jwt/jwt/v4"
 )
 
-var (
-	secretKey      = getSecretKey()
-	expireDuration = 2 * time.Hour
+const (
+	issuerName            = "user_account_service"
+	defaultExpireDuration = 2 * time.Hour
 )
 
-func getSecretKey() string {
+type Config struct {
+	SecretKey      string
+	ExpireDuration time.Duration
+}
+
+// ConfigFromEnv 读取 $JWT_SECRETKEY，未配置时使用临时密钥
+func ConfigFromEnv() Config {
 	key := os.Getenv("JWT_SECRETKEY")
 	if key == "" {
 		klog.Warn("token/jwt:", "not find env param $JWT_SECRETKEY, has been replaced by 'temprory key'")
 		key = "temprory key"
 	}
-	if len(key) < 32 {
+	return Config{
+		SecretKey:      key,
+		ExpireDuration: defaultExpireDuration,
+	}
+}
+
+// Issuer 负责签发和校验 jwt，网关只需校验时也可以复用
+type Issuer struct {
+	secretKey      []byte
+	expireDuration time.Duration
+}
+
+func NewIssuer(cfg Config) *Issuer {
+	if len(cfg.SecretKey) < 32 {
 		klog.Warn("token/jwt:", "length of secret_key is less than 32, suggested more than 32")
 	}
-	return key
+	if cfg.ExpireDuration <= 0 {
+		cfg.ExpireDuration = defaultExpireDuration
+	}
+	return &Issuer{
+		secretKey:      []byte(cfg.SecretKey),
+		expireDuration: cfg.ExpireDuration,
+	}
 }
 
 type CustomClaims struct {
@@ -32,26 +57,26 @@ type CustomClaims struct {
 	jwt.RegisteredClaims
 }
 
-func GenerateToken(userID int64, userType int8) (string, error) {
+func (i *Issuer) GenerateToken(userID int64, userType int8) (string, error) {
 	claims := CustomClaims{
 		UserID:   userID,
 		UserType: userType,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expireDuration)),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(i.expireDuration)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			Issuer:    "user_account_service",
+			Issuer:    issuerName,
 			Subject:   "login_token",
 		},
 	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	signedToken, err := token.SignedString([]byte(secretKey))
+	signedToken, err := token.SignedString(i.secretKey)
 	if err != nil {
 		return "", errors.New("generate jwt token failed: " + err.Error())
 	}
 	return signedToken, nil
 }
 
-func VerifyToken(tokenString string) (*CustomClaims, error) {
+func (i *Issuer) VerifyToken(tokenString string) (*CustomClaims, error) {
 	token, err := jwt.ParseWithClaims(
 		tokenString,
 		&CustomClaims{},
@@ -63,7 +88,7 @@ func VerifyToken(tokenString string) (*CustomClaims, error) {
 			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 				return nil, errors.New("unexpected signing method: " + alg)
 			}
-			return []byte(secretKey), nil
+			return i.secretKey, nil
 		},
 	)
 	if err != nil {
@@ -81,7 +106,7 @@ func VerifyToken(tokenString string) (*CustomClaims, error) {
 	}
 
 	if claims, ok := token.Claims.(*CustomClaims); ok && token.Valid {
-		if claims.Issuer != "user_account_service" {
+		if claims.Issuer != issuerName {
 			return nil, errors.New("invalid jwt issuer: not from user_account_service")
 		}
 		return claims, nil